@@ -24,11 +24,15 @@ const MAX_BODY_SIZE = 5 * 1024 * 1024
 type queryRequest struct {
 	Bytes     string `json:"bytes"`
 	Signature string `json:"signature"`
+	// DecodeSysvars requests that well-known Solana sysvar accounts in the response also be returned in decoded form.
+	DecodeSysvars bool `json:"decodeSysvars,omitempty"`
 }
 
 type queryResponse struct {
 	Bytes      string   `json:"bytes"`
 	Signatures []string `json:"signatures"`
+	// Sysvars is only populated if requested. It is not covered by the signatures.
+	Sysvars []*query.SolanaSysvar `json:"sysvars,omitempty"`
 }
 
 type httpServer struct {
@@ -191,10 +195,19 @@ func (s *httpServer) handleQuery(w http.ResponseWriter, r *http.Request) {
 			signature := fmt.Sprintf("%s%02x", s.Signature, uint8(s.Index))
 			signatures = append(signatures, signature)
 		}
+		var sysvars []*query.SolanaSysvar
+		if q.DecodeSysvars {
+			sysvars, err = query.DecodeSolanaSysvars(queryReq, res.Response.PerChainResponses)
+			if err != nil {
+				// The decoding is a convenience, so still return the signed response.
+				s.logger.Warn("failed to decode sysvars", zap.String("userId", permEntry.userName), zap.String("requestId", requestId), zap.Error(err))
+			}
+		}
 		w.Header().Add("Content-Type", "application/json")
 		err = json.NewEncoder(w).Encode(&queryResponse{
 			Signatures: signatures,
 			Bytes:      hex.EncodeToString(resBytes),
+			Sysvars:    sysvars,
 		})
 		if err != nil {
 			s.logger.Error("failed to encode response", zap.String("userId", permEntry.userName), zap.String("requestId", requestId), zap.Error(err))
@@ -38,7 +38,7 @@ type P2PSub struct {
 	host       host.Host
 }
 
-func runP2P(ctx context.Context, priv crypto.PrivKey, port uint, networkID, bootstrapPeers, ethRpcUrl, ethCoreAddr string, pendingResponses *PendingResponses, logger *zap.Logger, monitorPeers bool, loggingMap *LoggingMap) (*P2PSub, error) {
+func runP2P(ctx context.Context, priv crypto.PrivKey, port uint, networkID, bootstrapPeers, ethRpcUrl, ethCoreAddr string, pendingResponses *PendingResponses, logger *zap.Logger, monitorPeers bool, loggingMap *LoggingMap, maxResponseAge time.Duration) (*P2PSub, error) {
 	// p2p setup
 	components := p2p.DefaultComponents()
 	components.Port = port
@@ -155,6 +155,7 @@ func runP2P(ctx context.Context, priv crypto.PrivKey, port uint, networkID, boot
 				inboundP2pError.WithLabelValues("failed_to_unmarshal_gossip_msg").Inc()
 				continue
 			}
+			p2p.CountGossipMessageReceived(&msg)
 			switch m := msg.Message.(type) {
 			case *gossipv1.GossipMessage_SignedQueryResponse:
 				logger.Debug("query response received", zap.Any("response", m.SignedQueryResponse))
@@ -192,6 +193,11 @@ func runP2P(ctx context.Context, priv crypto.PrivKey, port uint, networkID, boot
 					!bytes.Equal(queryResponse.Request.Signature, pendingResponse.req.Signature) {
 					continue
 				}
+				if err := queryResponse.ValidateResultFreshness(maxResponseAge); err != nil {
+					logger.Warn("rejecting stale query response", zap.String("peerId", peerId), zap.Any("requestId", requestSignature), zap.Error(err))
+					inboundP2pError.WithLabelValues("stale_response").Inc()
+					continue
+				}
 				digest := query.GetQueryResponseDigestFromBytes(m.SignedQueryResponse.QueryResponse)
 				signerBytes, err := ethCrypto.Ecrecover(digest.Bytes(), m.SignedQueryResponse.Signature)
 				if err != nil {
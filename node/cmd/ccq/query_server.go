@@ -45,6 +45,7 @@ var (
 	shutdownDelay1    *uint
 	shutdownDelay2    *uint
 	monitorPeers      *bool
+	maxResponseAge    *time.Duration
 )
 
 const DEV_NETWORK_ID = "/wormhole/dev"
@@ -66,6 +67,7 @@ func init() {
 	statusAddr = QueryServerCmd.Flags().String("statusAddr", "[::]:6060", "Listen address for status server (disabled if blank)")
 	promRemoteURL = QueryServerCmd.Flags().String("promRemoteURL", "", "Prometheus remote write URL (Grafana)")
 	monitorPeers = QueryServerCmd.Flags().Bool("monitorPeers", false, "Should monitor bootstrap peers and attempt to reconnect")
+	maxResponseAge = QueryServerCmd.Flags().Duration("maxResponseAge", 0, "Reject Solana query responses whose block time is older than this, even if they are validly signed (disabled if zero)")
 
 	// The default health check monitoring is every five seconds, with a five second timeout, and you have to miss two, for 20 seconds total.
 	shutdownDelay1 = QueryServerCmd.Flags().Uint("shutdownDelay1", 25, "Seconds to delay after disabling health check on shutdown")
@@ -172,7 +174,7 @@ func runQueryServer(cmd *cobra.Command, args []string) {
 
 	// Run p2p
 	pendingResponses := NewPendingResponses(logger)
-	p2p, err := runP2P(ctx, priv, *p2pPort, networkID, *p2pBootstrap, *ethRPC, *ethContract, pendingResponses, logger, *monitorPeers, loggingMap)
+	p2p, err := runP2P(ctx, priv, *p2pPort, networkID, *p2pBootstrap, *ethRPC, *ethContract, pendingResponses, logger, *monitorPeers, loggingMap, *maxResponseAge)
 	if err != nil {
 		logger.Fatal("Failed to start p2p", zap.Error(err))
 	}
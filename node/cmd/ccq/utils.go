@@ -45,10 +45,7 @@ func FetchCurrentGuardianSet(rpcUrl, coreAddr string) (*common.GuardianSet, erro
 	if err != nil {
 		return nil, fmt.Errorf("error requesting current guardian set value: %w", err)
 	}
-	return &common.GuardianSet{
-		Keys:  gs.Keys,
-		Index: currentIndex,
-	}, nil
+	return common.NewGuardianSet(gs.Keys, currentIndex), nil
 }
 
 // validateRequest verifies that this API key is allowed to do all of the calls in this request. In the case of an error, it returns the HTTP status.
@@ -9,6 +9,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"golang.org/x/crypto/sha3"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/wormhole-foundation/wormhole/sdk"
@@ -38,9 +40,17 @@ import (
 )
 
 var (
-	clientSocketPath *string
-	shouldBackfill   *bool
-	unsafeDevnetMode *bool
+	clientSocketPath    *string
+	shouldBackfill      *bool
+	backfillConcurrency *uint32
+	compactMissing      *bool
+	unsafeDevnetMode    *bool
+
+	planRecoveryConcurrency *uint32
+	governorReleaseDelay    *time.Duration
+
+	missingVAAsHeaders     *[]string
+	missingVAAsBearerToken *bool
 )
 
 func init() {
@@ -54,6 +64,18 @@ func init() {
 
 	shouldBackfill = AdminClientFindMissingMessagesCmd.Flags().Bool(
 		"backfill", false, "backfill missing VAAs from public RPC")
+	backfillConcurrency = AdminClientFindMissingMessagesCmd.Flags().Uint32(
+		"backfillConcurrency", 0, "maximum number of VAAs to backfill in parallel (0 uses the node default)")
+	compactMissing = AdminClientFindMissingMessagesCmd.Flags().Bool(
+		"compact", false, "list contiguous ranges of missing sequences instead of every message ID")
+	planRecoveryConcurrency = PlanRecoveryCmd.Flags().Uint32(
+		"backfillConcurrency", 0, "maximum number of VAAs the backfill would fetch in parallel (0 uses the node default)")
+	governorReleaseDelay = ClientChainGovernorResetReleaseTimerCmd.Flags().Duration(
+		"releaseDelay", 0, "delay from now until the VAA is released (0 uses the standard enqueue time)")
+	missingVAAsHeaders = GetAndObserveMissingVAAs.Flags().StringArray(
+		"header", nil, "additional header to set on the request to the cloud function, as key=value (may be repeated)")
+	missingVAAsBearerToken = GetAndObserveMissingVAAs.Flags().Bool(
+		"bearerToken", false, "send the API key as an Authorization bearer token instead of in the request body")
 
 	AdminClientInjectGuardianSetUpdateCmd.Flags().AddFlagSet(pf)
 	AdminClientFindMissingMessagesCmd.Flags().AddFlagSet(pf)
@@ -66,10 +88,17 @@ func init() {
 	ClientChainGovernorDropPendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorReleasePendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorResetReleaseTimerCmd.Flags().AddFlagSet(pf)
+	ClientChainGovernorDumpConfigCmd.Flags().AddFlagSet(pf)
 	PurgePythNetVaasCmd.Flags().AddFlagSet(pf)
 	SignExistingVaaCmd.Flags().AddFlagSet(pf)
 	SignExistingVaasFromCSVCmd.Flags().AddFlagSet(pf)
 	GetAndObserveMissingVAAs.Flags().AddFlagSet(pf)
+	GetNodeVersionCmd.Flags().AddFlagSet(pf)
+	DatabaseStatsCmd.Flags().AddFlagSet(pf)
+	PlanRecoveryCmd.Flags().AddFlagSet(pf)
+	CheckGovernanceQuorumCmd.Flags().AddFlagSet(pf)
+	VerifyVAADigestCmd.Flags().AddFlagSet(pf)
+	GetProcessorStatsCmd.Flags().AddFlagSet(pf)
 
 	adminClientSignWormchainAddressFlags := pflag.NewFlagSet("adminClientSignWormchainAddressFlags", pflag.ContinueOnError)
 	unsafeDevnetMode = adminClientSignWormchainAddressFlags.Bool("unsafeDevMode", false, "Run in unsafe devnet mode")
@@ -88,11 +117,18 @@ func init() {
 	AdminCmd.AddCommand(ClientChainGovernorDropPendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorReleasePendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorResetReleaseTimerCmd)
+	AdminCmd.AddCommand(ClientChainGovernorDumpConfigCmd)
 	AdminCmd.AddCommand(PurgePythNetVaasCmd)
 	AdminCmd.AddCommand(SignExistingVaaCmd)
 	AdminCmd.AddCommand(SignExistingVaasFromCSVCmd)
 	AdminCmd.AddCommand(Keccak256Hash)
 	AdminCmd.AddCommand(GetAndObserveMissingVAAs)
+	AdminCmd.AddCommand(GetNodeVersionCmd)
+	AdminCmd.AddCommand(DatabaseStatsCmd)
+	AdminCmd.AddCommand(PlanRecoveryCmd)
+	AdminCmd.AddCommand(CheckGovernanceQuorumCmd)
+	AdminCmd.AddCommand(VerifyVAADigestCmd)
+	AdminCmd.AddCommand(GetProcessorStatsCmd)
 }
 
 var AdminCmd = &cobra.Command{
@@ -142,6 +178,13 @@ var ClientChainGovernorStatusCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(0),
 }
 
+var ClientChainGovernorDumpConfigCmd = &cobra.Command{
+	Use:   "governor-dump-config",
+	Short: "Displays the chain and token configuration loaded by the chain governor",
+	Run:   runChainGovernorDumpConfig,
+	Args:  cobra.ExactArgs(0),
+}
+
 var ClientChainGovernorReloadCmd = &cobra.Command{
 	Use:   "governor-reload",
 	Short: "Clears the chain governor history and reloads it from the database",
@@ -165,7 +208,7 @@ var ClientChainGovernorReleasePendingVAACmd = &cobra.Command{
 
 var ClientChainGovernorResetReleaseTimerCmd = &cobra.Command{
 	Use:   "governor-reset-release-timer [VAA_ID]",
-	Short: "Resets the release timer for a chain governor pending VAA, extending it to the configured maximum",
+	Short: "Resets the release timer for a chain governor pending VAA, by default extending it to the standard enqueue time",
 	Run:   runChainGovernorResetReleaseTimer,
 	Args:  cobra.ExactArgs(1),
 }
@@ -191,6 +234,51 @@ var SignExistingVaasFromCSVCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(4),
 }
 
+var GetNodeVersionCmd = &cobra.Command{
+	Use:   "get-node-version",
+	Short: "Displays the version and build information of the guardian",
+	Run:   runGetNodeVersion,
+	Args:  cobra.ExactArgs(0),
+}
+
+var DatabaseStatsCmd = &cobra.Command{
+	Use:   "database-stats",
+	Short: "Displays the number of VAAs stored in the guardian database for each emitter",
+	Run:   runDatabaseStats,
+	Args:  cobra.ExactArgs(0),
+}
+
+var PlanRecoveryCmd = &cobra.Command{
+	Use:   "plan-recovery [CHAIN_ID] [EMITTER_ADDRESS_HEX]",
+	Short: "Report the sequence gaps for the given chain ID and emitter address and an upper bound on how long backfilling them from public RPC would take",
+	Run:   runPlanRecovery,
+	Args:  cobra.ExactArgs(2),
+}
+
+var CheckGovernanceQuorumCmd = &cobra.Command{
+	Use:   "governance-quorum-check",
+	Short: "Displays whether this guardian is in the current guardian set and can contribute to the quorum of injected governance VAAs",
+	Run:   runCheckGovernanceQuorum,
+	Args:  cobra.ExactArgs(0),
+}
+
+var VerifyVAADigestCmd = &cobra.Command{
+	Use:   "verify-vaa-digest [VAA_ID]",
+	Short: "Recomputes the digest of a stored VAA, given as <chain>/<emitter_address>/<sequence>, and verifies its signatures",
+	Run:   runVerifyVAADigest,
+	Args:  cobra.ExactArgs(1),
+}
+
+var GetProcessorStatsCmd = &cobra.Command{
+	Use:   "processor-stats",
+	Short: "Displays the size and estimated memory footprint of the processor's aggregation state",
+	Long: "Displays the size and estimated memory footprint of the processor's aggregation state.\n\n" +
+		"The stats are a snapshot taken by the processor on every cleanup (every 30 seconds), not a live reading, " +
+		"so they may be up to that old. The \"computed at\" time shows when the snapshot was taken.",
+	Run:  runGetProcessorStats,
+	Args: cobra.ExactArgs(0),
+}
+
 var DumpRPCs = &cobra.Command{
 	Use:   "dump-rpcs",
 	Short: "Displays the RPCs in use by the guardian",
@@ -285,8 +373,12 @@ func runInjectGovernanceVAA(cmd *cobra.Command, args []string) {
 		log.Fatalf("failed to submit governance VAA: %v", err)
 	}
 
-	for _, digest := range resp.Digests {
-		log.Printf("VAA successfully injected with digest %s", hexutils.BytesToHex(digest))
+	for i, digest := range resp.Digests {
+		if i < len(resp.Sequences) {
+			log.Printf("VAA successfully injected with digest %s and sequence %d", hexutils.BytesToHex(digest), resp.Sequences[i])
+		} else {
+			log.Printf("VAA successfully injected with digest %s", hexutils.BytesToHex(digest))
+		}
 	}
 }
 
@@ -307,16 +399,25 @@ func runFindMissingMessages(cmd *cobra.Command, args []string) {
 	defer conn.Close()
 
 	msg := nodev1.FindMissingMessagesRequest{
-		EmitterChain:   uint32(chainID),
-		EmitterAddress: emitterAddress,
-		RpcBackfill:    *shouldBackfill,
-		BackfillNodes:  sdk.PublicRPCEndpoints,
+		EmitterChain:        uint32(chainID),
+		EmitterAddress:      emitterAddress,
+		RpcBackfill:         *shouldBackfill,
+		BackfillNodes:       sdk.PublicRPCEndpoints,
+		BackfillConcurrency: *backfillConcurrency,
+		Compact:             *compactMissing,
 	}
 	resp, err := c.FindMissingMessages(ctx, &msg)
 	if err != nil {
 		log.Fatalf("failed to run find FindMissingMessages RPC: %v", err)
 	}
 
+	if *compactMissing {
+		fmt.Println(formatSequenceRanges(resp.MissingRanges))
+		log.Printf("processed %s sequences %d to %d (%d gap ranges)",
+			emitterAddress, resp.FirstSequence, resp.LastSequence, len(resp.MissingRanges))
+		return
+	}
+
 	for _, id := range resp.MissingMessages {
 		fmt.Println(id)
 	}
@@ -325,22 +426,26 @@ func runFindMissingMessages(cmd *cobra.Command, args []string) {
 		emitterAddress, resp.FirstSequence, resp.LastSequence, len(resp.MissingMessages))
 }
 
+// formatSequenceRanges formats sequence ranges as a comma separated list, e.g. "100-150, 200".
+func formatSequenceRanges(ranges []*nodev1.SequenceRange) string {
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		if r.First == r.Last {
+			parts[i] = strconv.FormatUint(r.First, 10)
+		} else {
+			parts[i] = fmt.Sprintf("%d-%d", r.First, r.Last)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
 // runDumpVAAByMessageID uses GetSignedVAA to request the given message,
 // then decode and dump the VAA.
 func runDumpVAAByMessageID(cmd *cobra.Command, args []string) {
 	// Parse the {chain,emitter,seq} string.
-	parts := strings.Split(args[0], "/")
-	if len(parts) != 3 {
-		log.Fatalf("invalid message ID: %s", args[0])
-	}
-	chainID, err := strconv.ParseUint(parts[0], 10, 32)
+	id, err := db.ParseVAAID(args[0])
 	if err != nil {
-		log.Fatalf("invalid chain ID: %v", err)
-	}
-	emitterAddress := parts[1]
-	seq, err := strconv.ParseUint(parts[2], 10, 64)
-	if err != nil {
-		log.Fatalf("invalid sequence number: %v", err)
+		log.Fatalf("invalid message ID: %v", err)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -354,9 +459,9 @@ func runDumpVAAByMessageID(cmd *cobra.Command, args []string) {
 
 	msg := publicrpcv1.GetSignedVAARequest{
 		MessageId: &publicrpcv1.MessageID{
-			EmitterChain:   publicrpcv1.ChainID(chainID),
-			EmitterAddress: emitterAddress,
-			Sequence:       seq,
+			EmitterChain:   publicrpcv1.ChainID(id.EmitterChain),
+			EmitterAddress: id.EmitterAddress.String(),
+			Sequence:       id.Sequence,
 		},
 	}
 	resp, err := c.GetSignedVAA(ctx, &msg)
@@ -429,6 +534,162 @@ func runDumpRPCs(cmd *cobra.Command, args []string) {
 	}
 }
 
+func runGetNodeVersion(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetNodeVersion(ctx, &nodev1.GetNodeVersionRequest{})
+	if err != nil {
+		log.Fatalf("failed to run get-node-version: %s", err)
+	}
+
+	fmt.Println("version:   ", resp.Version)
+	fmt.Println("git commit:", resp.GitCommit)
+	fmt.Println("go version:", resp.GoVersion)
+	fmt.Println("dev build: ", resp.DevBuild)
+}
+
+func runDatabaseStats(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.DatabaseStats(ctx, &nodev1.DatabaseStatsRequest{})
+	if err != nil {
+		log.Fatalf("failed to run database-stats: %s", err)
+	}
+
+	emitters := make([]string, 0, len(resp.VaaCountsByEmitter))
+	for emitter := range resp.VaaCountsByEmitter {
+		emitters = append(emitters, emitter)
+	}
+	sort.Strings(emitters)
+	for _, emitter := range emitters {
+		fmt.Println(emitter, resp.VaaCountsByEmitter[emitter])
+	}
+	fmt.Println("total:", resp.TotalVaas)
+}
+
+func runPlanRecovery(cmd *cobra.Command, args []string) {
+	chainID, err := strconv.Atoi(args[0])
+	if err != nil {
+		log.Fatalf("invalid chain ID: %v", err)
+	}
+	emitterAddress := args[1]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.PlanRecovery(ctx, &nodev1.PlanRecoveryRequest{
+		EmitterChain:        uint32(chainID),
+		EmitterAddress:      emitterAddress,
+		BackfillNodes:       sdk.PublicRPCEndpoints,
+		BackfillConcurrency: *planRecoveryConcurrency,
+	})
+	if err != nil {
+		log.Fatalf("failed to run plan-recovery: %s", err)
+	}
+
+	fmt.Println(formatSequenceRanges(resp.MissingRanges))
+
+	log.Printf("processed %s sequences %d to %d (%d missing in %d ranges), backfill with concurrency %d would take at most %s",
+		emitterAddress, resp.FirstSequence, resp.LastSequence, resp.MissingCount, len(resp.MissingRanges),
+		resp.BackfillConcurrency, time.Duration(resp.MaxBackfillSeconds)*time.Second)
+}
+
+func runCheckGovernanceQuorum(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.CheckGovernanceQuorum(ctx, &nodev1.CheckGovernanceQuorumRequest{})
+	if err != nil {
+		log.Fatalf("failed to run governance-quorum-check: %s", err)
+	}
+
+	fmt.Println("guardian set index:", resp.GuardianSetIndex)
+	fmt.Println("number of guardians:", resp.NumGuardians)
+	fmt.Println("quorum:", resp.Quorum)
+	if resp.InGuardianSet {
+		fmt.Println("local guardian index:", resp.LocalGuardianIndex)
+	} else {
+		fmt.Println("local guardian is NOT in the current guardian set")
+	}
+}
+
+func runVerifyVAADigest(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.VerifyVAADigest(ctx, &nodev1.VerifyVAADigestRequest{VaaId: args[0]})
+	if err != nil {
+		log.Fatalf("failed to run verify-vaa-digest: %s", err)
+	}
+
+	fmt.Println("digest:", resp.Digest)
+	fmt.Println("guardian set index:", resp.GuardianSetIndex)
+	fmt.Println("signatures:", resp.NumSignatures)
+	fmt.Println("quorum:", resp.Quorum)
+	for _, e := range resp.Errors {
+		fmt.Println("error:", e)
+	}
+	if !resp.Valid {
+		log.Fatalf("VAA %s failed verification", args[0])
+	}
+	fmt.Println("VAA is valid")
+}
+
+func runGetProcessorStats(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetProcessorStats(ctx, &nodev1.GetProcessorStatsRequest{})
+	if err != nil {
+		log.Fatalf("failed to run processor-stats: %s", err)
+	}
+
+	fmt.Println("observations:        ", resp.Observations)
+	fmt.Println("pending observations:", resp.PendingObservations)
+	fmt.Println("signatures:          ", resp.Signatures)
+	fmt.Println("estimated memory:    ", resp.EstimatedMemoryBytes, "bytes")
+	computedAt := time.Unix(resp.Timestamp, 0)
+	fmt.Println("computed at:         ", computedAt.UTC().Format(time.RFC3339), fmt.Sprintf("(snapshot, %s old)", time.Since(computedAt).Truncate(time.Second)))
+}
+
 func runGetAndObserveMissingVAAs(cmd *cobra.Command, args []string) {
 	url := args[0]
 	if !strings.HasPrefix(url, "https://") {
@@ -438,6 +699,14 @@ func runGetAndObserveMissingVAAs(cmd *cobra.Command, args []string) {
 	if len(apiKey) == 0 {
 		log.Fatalf("missing api key")
 	}
+	headers := make(map[string]string, len(*missingVAAsHeaders))
+	for _, h := range *missingVAAsHeaders {
+		key, value, found := strings.Cut(h, "=")
+		if !found || key == "" {
+			log.Fatalf("invalid header %q, must be key=value", h)
+		}
+		headers[key] = value
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -448,8 +717,10 @@ func runGetAndObserveMissingVAAs(cmd *cobra.Command, args []string) {
 	defer conn.Close()
 
 	cmdInfo := nodev1.GetAndObserveMissingVAAsRequest{
-		Url:    url,
-		ApiKey: apiKey,
+		Url:                 url,
+		ApiKey:              apiKey,
+		Headers:             headers,
+		ApiKeyAsBearerToken: *missingVAAsBearerToken,
 	}
 	resp, err := c.GetAndObserveMissingVAAs(ctx, &cmdInfo)
 	if err != nil {
@@ -478,6 +749,31 @@ func runChainGovernorStatus(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+func runChainGovernorDumpConfig(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.ChainGovernorDumpConfigRequest{}
+	resp, err := c.ChainGovernorDumpConfig(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run ChainGovernorDumpConfig RPC: %s", err)
+	}
+
+	for _, ch := range resp.Chains {
+		fmt.Printf("chain: %v, dailyLimit: %v, bigTransactionSize: %v\n", vaa.ChainID(ch.ChainId), ch.DailyLimit, ch.BigTransactionSize)
+	}
+	for _, tk := range resp.Tokens {
+		fmt.Printf("token: %v:%v, symbol: %v, coinGeckoId: %v, decimals: %v, price: %v, cfgPrice: %v\n",
+			vaa.ChainID(tk.OriginChainId), tk.OriginAddress, tk.Symbol, tk.CoinGeckoId, tk.Decimals, tk.Price, tk.CfgPrice)
+	}
+}
+
 func runChainGovernorReload(cmd *cobra.Command, args []string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -549,8 +845,13 @@ func runChainGovernorResetReleaseTimer(cmd *cobra.Command, args []string) {
 	}
 	defer conn.Close()
 
+	if *governorReleaseDelay < 0 {
+		log.Fatalf("--releaseDelay must not be negative")
+	}
+
 	msg := nodev1.ChainGovernorResetReleaseTimerRequest{
-		VaaId: args[0],
+		VaaId:               args[0],
+		ReleaseDelaySeconds: uint64(governorReleaseDelay.Seconds()),
 	}
 	resp, err := c.ChainGovernorResetReleaseTimer(ctx, &msg)
 	if err != nil {
@@ -176,6 +176,11 @@ func init() {
 var TemplateCmd = &cobra.Command{
 	Use:   "template",
 	Short: "Guardian governance VAA template commands ",
+	Long: "Guardian governance VAA template commands.\n\n" +
+		"Templates use a random sequence, which must be the same on every guardian. To have the node assign sequences " +
+		"instead, set the sequences to zero and auto_assign_sequence to true in the request. Every guardian then assigns " +
+		"sequences from its own counter, so the assigned sequences must be coordinated across guardians: they only match " +
+		"if every guardian injected the same governance VAAs in the same order, and must be compared before signatures are collected.",
 }
 
 var AdminClientGuardianSetTemplateCmd = &cobra.Command{
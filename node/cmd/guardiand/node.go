@@ -2,6 +2,8 @@ package guardiand
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
 	"fmt"
 	"net"
 	_ "net/http/pprof" // #nosec G108 we are using a custom router (`router := mux.NewRouter()`) and thus not automatically expose pprof.
@@ -33,14 +35,20 @@ import (
 	"github.com/gagliardetto/solana-go/rpc"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/certusone/wormhole/node/pkg/adminrpc"
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/devnet"
+	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/node"
 	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	promremotew "github.com/certusone/wormhole/node/pkg/telemetry/prom_remote_write"
 	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -53,16 +61,34 @@ var (
 	p2pPort      *uint
 	p2pBootstrap *string
 
+	p2pConnMgrLow  *int
+	p2pConnMgrHigh *int
+
+	p2pRequireBootstrap *bool
+
 	nodeKeyPath *string
 
 	adminSocketPath      *string
+	adminDisabledMethods *string
+	adminAuditLogFile    *string
+	adminGrpcReflection  *bool
 	publicGRPCSocketPath *string
 
-	dataDir *string
+	guardianSetUpdateSoftMax *uint
+
+	dataDir         *string
+	dbCompressVAAs  *bool
+	maxDbSizeBytes  *int64
+	dbSizeLimitMode *string
+	dbCompression   *string
 
-	statusAddr *string
+	statusAddr      *string
+	metricsDumpPath *string
+
+	selfTest *bool
 
 	guardianKeyPath *string
+	guardianKeyDir  *string
 	solanaContract  *string
 
 	ethRPC      *string
@@ -155,7 +181,8 @@ var (
 	suiWS            *string
 	suiMoveEventType *string
 
-	solanaRPC *string
+	solanaRPC               *string
+	solanaStartupCommitment *string
 
 	pythnetContract *string
 	pythnetRPC      *string
@@ -205,8 +232,18 @@ var (
 	publicRPC *string
 	publicWeb *string
 
-	tlsHostname *string
-	tlsProdEnv  *bool
+	publicRpcTlsCert  *string
+	publicRpcTlsKey   *string
+	publicRpcClientCA *string
+
+	tlsHostname   *string
+	tlsProdEnv    *bool
+	tlsMinVersion *string
+
+	publicWebRateLimit    *float64
+	publicWebMaxBodyBytes *int64
+
+	startupGracePeriod *time.Duration
 
 	disableHeartbeatVerify *bool
 
@@ -218,14 +255,30 @@ var (
 	// Prometheus remote write URL
 	promRemoteURL *string
 
-	chainGovernorEnabled *bool
-
-	ccqEnabled           *bool
-	ccqAllowedRequesters *string
-	ccqP2pPort           *uint
-	ccqP2pBootstrap      *string
-	ccqAllowedPeers      *string
-	ccqBackfillCache     *bool
+	chainGovernorEnabled             *bool
+	chainGovernorMinConsistencyLevel *uint
+	chainGovernorMaxReleaseDelay     *time.Duration
+
+	processorMaxPendingObservations *int
+	processorCleanupInterval        *time.Duration
+	govCheckInterval                *time.Duration
+	processorReobservationBatchSize *int
+	processorMaxPayloadSize         *int
+	processorPersistAggState        *bool
+
+	observerMode *bool
+
+	ccqEnabled             *bool
+	ccqAllowedRequesters   *string
+	ccqP2pPort             *uint
+	ccqP2pBootstrap        *string
+	ccqAllowedPeers        *string
+	ccqBackfillCache       *bool
+	ccqSolanaRPC           *string
+	ccqResponseCacheTTL    *time.Duration
+	ccqRequestPrefix       *string
+	ccqMaxResponseDataSize *int
+	ccqGsTransitionWindow  *time.Duration
 
 	gatewayRelayerContract      *string
 	gatewayRelayerKeyPath       *string
@@ -236,17 +289,32 @@ func init() {
 	p2pNetworkID = NodeCmd.Flags().String("network", "/wormhole/dev", "P2P network identifier")
 	p2pPort = NodeCmd.Flags().Uint("port", p2p.DefaultPort, "P2P UDP listener port")
 	p2pBootstrap = NodeCmd.Flags().String("bootstrap", "", "P2P bootstrap peers (comma-separated)")
+	p2pConnMgrLow = NodeCmd.Flags().Int("p2pConnMgrLow", p2p.LowWaterMarkDefault, "P2P connection manager low watermark, connections are trimmed down to this number")
+	p2pConnMgrHigh = NodeCmd.Flags().Int("p2pConnMgrHigh", p2p.HighWaterMarkDefault, "P2P connection manager high watermark, connections are trimmed once there are more than this number")
+	p2pRequireBootstrap = NodeCmd.Flags().Bool("p2pRequireBootstrap", true, "Exit if no bootstrap peer can be reached on startup. If false, keep running and retry in the background while reporting not ready")
 
 	statusAddr = NodeCmd.Flags().String("statusAddr", "[::]:6060", "Listen address for status server (disabled if blank)")
+	metricsDumpPath = NodeCmd.Flags().String("metricsDumpPath", "", "File to write all Prometheus metrics to when the node shuts down (disabled if blank)")
+	selfTest = NodeCmd.Flags().Bool("selfTest", false, "Run the startup checks (database, guardian key, Solana RPC, p2p key), print a JSON report and exit without starting the node")
 
 	nodeKeyPath = NodeCmd.Flags().String("nodeKey", "", "Path to node key (will be generated if it doesn't exist)")
 
 	adminSocketPath = NodeCmd.Flags().String("adminSocket", "", "Admin gRPC service UNIX domain socket path")
+	adminDisabledMethods = NodeCmd.Flags().String("adminDisabledMethods", "", "Comma separated list of admin gRPC methods to reject with PermissionDenied, e.g. \"InjectGovernanceVAA,InjectSignedVAA\"")
+	adminAuditLogFile = NodeCmd.Flags().String("adminAuditLogFile", "", "If set, also append an audit entry for every admin gRPC call to this file")
+	adminGrpcReflection = NodeCmd.Flags().Bool("adminGrpcReflection", false, "Enable gRPC server reflection on the admin socket, so tools like grpcurl can list its methods (defaults to true in devnet)")
 	publicGRPCSocketPath = NodeCmd.Flags().String("publicGRPCSocket", "", "Public gRPC service UNIX domain socket path")
 
+	guardianSetUpdateSoftMax = NodeCmd.Flags().Uint("guardianSetUpdateSoftMax", 0, "Reject injected guardian set updates larger than this unless explicitly overridden (disabled if zero)")
+
 	dataDir = NodeCmd.Flags().String("dataDir", "", "Data directory")
+	dbCompressVAAs = NodeCmd.Flags().Bool("dbCompressVAAs", false, "Compress newly stored VAAs in the database using zstd (existing entries remain readable either way)")
+	dbCompression = NodeCmd.Flags().String("dbCompression", "snappy", "Block compression used by the database storage engine: \"none\", \"snappy\" or \"zstd\"")
+	maxDbSizeBytes = NodeCmd.Flags().Int64("maxDbSizeBytes", 0, "Maximum on-disk size of the database, checked every minute (0 means unlimited)")
+	dbSizeLimitMode = NodeCmd.Flags().String("dbSizeLimitMode", "reject", "What to do when the database exceeds --maxDbSizeBytes: \"reject\" new VAAs or \"purge\" the oldest non-governance VAAs")
 
 	guardianKeyPath = NodeCmd.Flags().String("guardianKey", "", "Path to guardian key (required)")
+	guardianKeyDir = NodeCmd.Flags().String("guardianKeyDir", "", "Directory with the guardian keys for a key rotation, used instead of --guardianKey. The node signs with primary.key. The optional secondary.key, the key being rotated out, is never used for signing and only helps diagnose a guardian set that still contains it")
 	solanaContract = NodeCmd.Flags().String("solanaContract", "", "Address of the Solana program (required)")
 
 	ethRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "ethRPC", "Ethereum RPC URL", "ws://eth-devnet:8545", []string{"ws", "wss"})
@@ -340,6 +408,11 @@ func init() {
 	suiMoveEventType = NodeCmd.Flags().String("suiMoveEventType", "", "Sui move event type for publish_message")
 
 	solanaRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "solanaRPC", "Solana RPC URL (required)", "http://solana-devnet:8899", []string{"http", "https"})
+	ccqResponseCacheTTL = NodeCmd.Flags().Duration("ccqResponseCacheTTL", 0, "How long cross chain query requests with identical queries, regardless of their nonce, are answered from the response cache (0 disables the cache)")
+	ccqMaxResponseDataSize = NodeCmd.Flags().Int("ccqMaxResponseDataSize", query.DefaultMaxResponseDataSize, "Maximum aggregate result data in bytes of a cross chain query response, larger responses are dropped (0 means unlimited)")
+	ccqGsTransitionWindow = NodeCmd.Flags().Duration("ccqGuardianSetTransitionWindow", 0, "How long before and after a guardian set change to withhold cross chain query responses, which clients see as a timeout (0 disables this)")
+	ccqRequestPrefix = NodeCmd.Flags().String("ccqRequestPrefix", "", "Custom cross chain query request signing prefix for private networks, not allowed in mainnet")
+	solanaStartupCommitment = NodeCmd.Flags().String("solanaStartupCommitment", "finalized", "Commitment used to read the slot the Solana watchers start from (finalized or confirmed)")
 
 	pythnetContract = NodeCmd.Flags().String("pythnetContract", "", "Address of the PythNet program (required)")
 	pythnetRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "pythnetRPC", "PythNet RPC URL (required)", "http://pythnet.rpcpool.com", []string{"http", "https"})
@@ -387,11 +460,19 @@ func init() {
 	nodeName = NodeCmd.Flags().String("nodeName", "", "Node name to announce in gossip heartbeats")
 
 	publicRPC = NodeCmd.Flags().String("publicRPC", "", "Listen address for public gRPC interface")
+	publicRpcTlsCert = NodeCmd.Flags().String("publicRpcTlsCert", "", "Path to a PEM encoded TLS certificate for --publicRPC. If set, the public gRPC interface is served over TLS")
+	publicRpcTlsKey = NodeCmd.Flags().String("publicRpcTlsKey", "", "Path to the PEM encoded private key of --publicRpcTlsCert")
+	publicRpcClientCA = NodeCmd.Flags().String("publicRpcClientCA", "", "Path to a PEM encoded CA certificate. If set, --publicRPC requires clients to present a certificate signed by it (mutual TLS)")
 	publicWeb = NodeCmd.Flags().String("publicWeb", "", "Listen address for public REST and gRPC Web interface")
 
 	tlsHostname = NodeCmd.Flags().String("tlsHostname", "", "If set, serve publicWeb as TLS with this hostname using Let's Encrypt")
 	tlsProdEnv = NodeCmd.Flags().Bool("tlsProdEnv", false,
 		"Use the production Let's Encrypt environment instead of staging")
+	tlsMinVersion = NodeCmd.Flags().String("tlsMinVersion", "1.2", "Minimum TLS version accepted by publicWeb when TLS is enabled (1.2 or 1.3)")
+	publicWebRateLimit = NodeCmd.Flags().Float64("publicWebRateLimit", 50, "Maximum number of publicWeb requests per second per client IP (0 disables the limit)")
+	publicWebMaxBodyBytes = NodeCmd.Flags().Int64("publicWebMaxBodyBytes", 1024*1024, "Maximum publicWeb request body size in bytes (0 disables the limit)")
+
+	startupGracePeriod = NodeCmd.Flags().Duration("startupGracePeriod", 0, "How long to wait for all components to become ready before considering startup failed, e.g. \"5m\" (0 disables the check). In devnet, this also bounds the wait for the bootstrap guardian")
 
 	disableHeartbeatVerify = NodeCmd.Flags().Bool("disableHeartbeatVerify", false,
 		"Disable heartbeat signature verification (useful during network startup)")
@@ -403,6 +484,17 @@ func init() {
 	promRemoteURL = NodeCmd.Flags().String("promRemoteURL", "", "Prometheus remote write URL (Grafana)")
 
 	chainGovernorEnabled = NodeCmd.Flags().Bool("chainGovernorEnabled", false, "Run the chain governor")
+	chainGovernorMinConsistencyLevel = NodeCmd.Flags().Uint("chainGovernorMinConsistencyLevel", 0, "Only count published messages at or above this consistency level toward the governor notional value, lower ones are still subject to the limits (0 counts all messages)")
+	chainGovernorMaxReleaseDelay = NodeCmd.Flags().Duration("chainGovernorMaxReleaseDelay", governor.DefaultMaxReleaseDelay, "Maximum delay an admin can set when resetting the release timer of a pending governor VAA")
+
+	processorMaxPendingObservations = NodeCmd.Flags().Int("processorMaxPendingObservations", 0, "Maximum number of observations the processor tracks at a time, the oldest ones without quorum are evicted beyond that (0 means unlimited)")
+	processorCleanupInterval = NodeCmd.Flags().Duration("processorCleanupInterval", processor.CleanupInterval, "Interval at which the processor retransmits and expires pending observations")
+	processorReobservationBatchSize = NodeCmd.Flags().Int("processorReobservationBatchSize", 0, "Maximum number of re-observation requests the processor sends per cleanup, the rest are deferred to the next one (0 means unlimited)")
+	processorMaxPayloadSize = NodeCmd.Flags().Int("processorMaxPayloadSize", processor.DefaultMaxPayloadSize, "Maximum size in bytes of a message payload, larger messages are dropped (0 means unlimited)")
+	processorPersistAggState = NodeCmd.Flags().Bool("processorPersistAggregationState", false, "Persist the signatures of observations that have not reached quorum to the database, so that they are restored after a restart")
+	govCheckInterval = NodeCmd.Flags().Duration("govCheckInterval", processor.GovInterval, "Interval at which the processor checks the governor for messages to release")
+
+	observerMode = NodeCmd.Flags().Bool("observerMode", false, "Verify and store VAAs without signing observations. --guardianKey is optional in this mode")
 
 	ccqEnabled = NodeCmd.Flags().Bool("ccqEnabled", false, "Enable cross chain query support")
 	ccqAllowedRequesters = NodeCmd.Flags().String("ccqAllowedRequesters", "", "Comma separated list of signers allowed to submit cross chain queries")
@@ -410,6 +502,7 @@ func init() {
 	ccqP2pBootstrap = NodeCmd.Flags().String("ccqP2pBootstrap", "", "CCQ P2P bootstrap peers (comma-separated)")
 	ccqAllowedPeers = NodeCmd.Flags().String("ccqAllowedPeers", "", "CCQ allowed P2P peers (comma-separated)")
 	ccqBackfillCache = NodeCmd.Flags().Bool("ccqBackfillCache", true, "Should EVM chains backfill CCQ timestamp cache on startup")
+	ccqSolanaRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "ccqSolanaRPC", "Solana RPC URL used to serve cross chain queries instead of --solanaRPC", "http://solana-devnet:8899", []string{"http", "https"})
 
 	gatewayRelayerContract = NodeCmd.Flags().String("gatewayRelayerContract", "", "Address of the smart contract on wormchain to receive relayed VAAs")
 	gatewayRelayerKeyPath = NodeCmd.Flags().String("gatewayRelayerKeyPath", "", "Path to gateway relayer private key for signing transactions")
@@ -427,6 +520,10 @@ var (
 	envPrefix      = "GUARDIAND"
 )
 
+// devnetBootstrapGracePeriod is how long non-bootstrap devnet guardians wait for the bootstrap guardian to become ready
+// if --startupGracePeriod is not set.
+const devnetBootstrapGracePeriod = 10 * time.Second
+
 // "Why would anyone do this?" are famous last words.
 //
 // We already forcibly override RPC URLs and keys in dev mode to prevent security
@@ -524,6 +621,10 @@ func runNode(cmd *cobra.Command, args []string) {
 			panic(err)
 		}
 
+		if !cmd.Flags().Changed("adminGrpcReflection") {
+			*adminGrpcReflection = true
+		}
+
 		// Use the first guardian node as bootstrap
 		*p2pBootstrap = fmt.Sprintf("/dns4/guardian-0.guardian/udp/%d/quic/p2p/%s", *p2pPort, g0key.String())
 		*ccqP2pBootstrap = fmt.Sprintf("/dns4/guardian-0.guardian/udp/%d/quic/p2p/%s", *ccqP2pPort, g0key.String())
@@ -559,8 +660,19 @@ func runNode(cmd *cobra.Command, args []string) {
 	if *nodeKeyPath == "" && !*unsafeDevMode { // In devnet mode, keys are deterministically generated.
 		logger.Fatal("Please specify --nodeKey")
 	}
-	if *guardianKeyPath == "" {
-		logger.Fatal("Please specify --guardianKey")
+	if *guardianKeyPath != "" && *guardianKeyDir != "" {
+		logger.Fatal("--guardianKey and --guardianKeyDir may not both be specified")
+	}
+	if *guardianKeyPath == "" && *guardianKeyDir == "" && !*observerMode {
+		logger.Fatal("Please specify --guardianKey or --guardianKeyDir")
+	}
+	if *observerMode {
+		if *ccqEnabled {
+			logger.Fatal("--ccqEnabled is not supported in --observerMode")
+		}
+		if *accountantContract != "" {
+			logger.Fatal("--accountantContract is not supported in --observerMode")
+		}
 	}
 	if *adminSocketPath == "" {
 		logger.Fatal("Please specify --adminSocket")
@@ -571,6 +683,23 @@ func runNode(cmd *cobra.Command, args []string) {
 	if (*publicRPC != "" || *publicWeb != "") && *publicGRPCSocketPath == "" {
 		logger.Fatal("If either --publicRPC or --publicWeb is specified, --publicGRPCSocket must also be specified")
 	}
+	if (*publicRpcTlsCert != "" || *publicRpcTlsKey != "" || *publicRpcClientCA != "") && *publicRPC == "" {
+		logger.Fatal("--publicRpcTlsCert, --publicRpcTlsKey and --publicRpcClientCA require --publicRPC")
+	}
+
+	publicWebTLSMinVersion, err := node.ParseTLSMinVersion(*tlsMinVersion)
+	if err != nil {
+		logger.Fatal("invalid --tlsMinVersion", zap.Error(err))
+	}
+
+	if err := readiness.ValidateGracePeriod(*startupGracePeriod); err != nil {
+		logger.Fatal("invalid --startupGracePeriod", zap.Error(err))
+	}
+
+	if *chainGovernorMinConsistencyLevel > 255 {
+		logger.Fatal("--chainGovernorMinConsistencyLevel must be at most 255")
+	}
+
 	if *dataDir == "" {
 		logger.Fatal("Please specify --dataDir")
 	}
@@ -767,6 +896,16 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("Please specify --nodeName")
 	}
 
+	if *processorCleanupInterval <= 0 {
+		logger.Fatal("--processorCleanupInterval must be positive")
+	}
+	if *govCheckInterval <= 0 {
+		logger.Fatal("--govCheckInterval must be positive")
+	}
+	if *processorMaxPayloadSize < 0 {
+		logger.Fatal("--processorMaxPayloadSize must not be negative")
+	}
+
 	// Solana, Terra Classic, Terra 2, and Algorand are optional in devnet
 	if !*unsafeDevMode {
 
@@ -846,6 +985,27 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("Cannot be in unsafeDevMode and testnetMode at the same time.")
 	}
 
+	if *p2pConnMgrLow < 0 || *p2pConnMgrHigh < *p2pConnMgrLow {
+		logger.Fatal("--p2pConnMgrLow must not be negative and must not exceed --p2pConnMgrHigh")
+	}
+
+	if *ccqMaxResponseDataSize < 0 {
+		logger.Fatal("--ccqMaxResponseDataSize must not be negative")
+	}
+
+	if *ccqGsTransitionWindow < 0 {
+		logger.Fatal("--ccqGuardianSetTransitionWindow must not be negative")
+	}
+
+	if *ccqRequestPrefix != "" {
+		if env == common.MainNet {
+			logger.Fatal("--ccqRequestPrefix is not allowed in mainnet")
+		}
+		if err := query.ValidateQueryRequestPrefix(*ccqRequestPrefix); err != nil {
+			logger.Fatal("invalid --ccqRequestPrefix", zap.Error(err))
+		}
+	}
+
 	// Complain about Infura on mainnet.
 	//
 	// As it turns out, Infura has a bug where it would sometimes incorrectly round
@@ -870,21 +1030,81 @@ func runNode(cmd *cobra.Command, args []string) {
 	}
 
 	// In devnet mode, we generate a deterministic guardian key and write it to disk.
-	if *unsafeDevMode {
+	if *unsafeDevMode && *guardianKeyPath != "" {
 		err := devnet.GenerateAndStoreDevnetGuardianKey(*guardianKeyPath)
 		if err != nil {
 			logger.Fatal("failed to generate devnet guardian key", zap.Error(err))
 		}
 	}
 
+	if *maxDbSizeBytes < 0 {
+		logger.Fatal("--maxDbSizeBytes must not be negative")
+	}
+	sizeLimitMode, err := db.ParseSizeLimitMode(*dbSizeLimitMode)
+	if err != nil {
+		logger.Fatal("invalid --dbSizeLimitMode", zap.Error(err))
+	}
+	compression, err := db.ParseCompression(*dbCompression)
+	if err != nil {
+		logger.Fatal("invalid --dbCompression", zap.Error(err))
+	}
+
+	if *selfTest {
+		checks := []node.SelfTestCheck{node.SelfTestDatabase(logger, *dataDir)}
+		if *guardianKeyPath != "" {
+			checks = append(checks, node.SelfTestGuardianKey(*guardianKeyPath, *unsafeDevMode))
+		}
+		if *guardianKeyDir != "" {
+			checks = append(checks, node.SelfTestGuardianKey(path.Join(*guardianKeyDir, common.GuardianKeyDirPrimary), *unsafeDevMode))
+		}
+		if shouldStart(solanaRPC) {
+			checks = append(checks, node.SelfTestSolanaRPC(*solanaRPC))
+		}
+		if !*unsafeDevMode {
+			checks = append(checks, node.SelfTestP2PKey(*nodeKeyPath))
+		}
+
+		report := node.RunSelfTest(context.Background(), checks)
+		if err := report.Write(os.Stdout); err != nil {
+			logger.Fatal("failed to write self test report", zap.Error(err))
+		}
+		if !report.Passed {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Database
-	db := db.OpenDb(logger, dataDir)
+	db := db.OpenDb(logger, dataDir, compression)
 	defer db.Close()
+	db.SetVAACompression(*dbCompressVAAs)
+	db.SetSizeLimit(logger, *maxDbSizeBytes, sizeLimitMode)
+	if err := db.RegisterReadiness(); err != nil {
+		logger.Fatal("database readiness check failed", zap.Error(err))
+	}
 
 	// Guardian key
-	gk, err := common.LoadGuardianKey(*guardianKeyPath, *unsafeDevMode)
-	if err != nil {
-		logger.Fatal("failed to load guardian key", zap.Error(err))
+	var gk, secondaryGk *ecdsa.PrivateKey
+	if *guardianKeyDir != "" {
+		gk, secondaryGk, err = common.LoadGuardianKeyDir(*guardianKeyDir, *unsafeDevMode)
+		if err != nil {
+			logger.Fatal("failed to load guardian keys", zap.String("guardianKeyDir", *guardianKeyDir), zap.Error(err))
+		}
+		if secondaryGk != nil {
+			logger.Info("Loaded secondary guardian key", zap.String(
+				"address", ethcrypto.PubkeyToAddress(secondaryGk.PublicKey).String()))
+		}
+	} else if *guardianKeyPath == "" {
+		// Observer mode without a guardian key. The ephemeral key only identifies our heartbeats and is never part of the guardian set.
+		gk, err = ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
+		if err != nil {
+			logger.Fatal("failed to generate ephemeral guardian key", zap.Error(err))
+		}
+	} else {
+		gk, err = common.LoadGuardianKey(*guardianKeyPath, *unsafeDevMode)
+		if err != nil {
+			logger.Fatal("failed to load guardian key", zap.Error(err))
+		}
 	}
 
 	logger.Info("Loaded guardian key", zap.String(
@@ -909,11 +1129,17 @@ func runNode(cmd *cobra.Command, args []string) {
 				logger.Info("Error resolving guardian-0.guardian. Trying again...")
 				time.Sleep(time.Second)
 			}
-			// TODO this is a hack. If this is not the bootstrap Guardian, we wait 10s such that the bootstrap Guardian has enough time to start.
-			// This may no longer be necessary because now the p2p.go ensures that it can connect to at least one bootstrap peer and will
-			// exit the whole guardian if it is unable to. Sleeping here for a bit may reduce overall startup time by preventing unnecessary restarts, though.
-			logger.Info("This is not a bootstrap Guardian. Waiting another 10 seconds for the bootstrap guardian to come online.")
-			time.Sleep(time.Second * 10)
+			// If this is not the bootstrap Guardian, wait for the bootstrap Guardian to become ready. p2p.go ensures that it can connect
+			// to at least one bootstrap peer and will exit the whole guardian if it is unable to, but waiting here reduces overall startup
+			// time by preventing unnecessary restarts. The wait is bounded by --startupGracePeriod, after which we start anyway.
+			bootstrapGracePeriod := *startupGracePeriod
+			if bootstrapGracePeriod == 0 {
+				bootstrapGracePeriod = devnetBootstrapGracePeriod
+			}
+			logger.Info("This is not a bootstrap Guardian. Waiting for the bootstrap guardian to become ready.", zap.Duration("startupGracePeriod", bootstrapGracePeriod))
+			if err := readiness.WaitForRemoteReady(context.Background(), "http://guardian-0.guardian:6060/readyz", bootstrapGracePeriod, time.Second); err != nil {
+				logger.Info("bootstrap guardian is not ready, starting anyway", zap.Error(err))
+			}
 		}
 	} else {
 		p2pKey, err = common.GetOrCreateNodeKey(logger, *nodeKeyPath)
@@ -961,6 +1187,9 @@ func runNode(cmd *cobra.Command, args []string) {
 	}
 	rpcMap["scrollRPC"] = *scrollRPC
 	rpcMap["solanaRPC"] = *solanaRPC
+	if *ccqSolanaRPC != "" {
+		rpcMap["ccqSolanaRPC"] = *ccqSolanaRPC
+	}
 	rpcMap["suiRPC"] = *suiRPC
 	rpcMap["suiWS"] = *suiWS
 	rpcMap["terraWS"] = *terraWS
@@ -1476,28 +1705,56 @@ func runNode(cmd *cobra.Command, args []string) {
 	}
 
 	if shouldStart(solanaRPC) {
+		// Fail fast on a misconfigured or unreachable RPC rather than leaving the watchers to retry silently. A node that is
+		// still catching up is reachable, and the watchers wait for it, so that only produces a warning.
+		if !*unsafeDevMode {
+			checkSolanaRPCHealth := func(rpcUrl string, flag string) {
+				err := solana.CheckRPCHealth(rootCtx, rpcUrl)
+				if err == nil {
+					return
+				}
+				if solana.IsRPCNodeBehindError(err) {
+					logger.Warn("Solana RPC is behind, continuing startup", zap.String("flag", flag), zap.Error(err))
+					return
+				}
+				logger.Fatal("Solana RPC is not healthy, check --"+flag, zap.Error(err))
+			}
+			checkSolanaRPCHealth(*solanaRPC, "solanaRPC")
+			if *ccqSolanaRPC != "" {
+				checkSolanaRPCHealth(*ccqSolanaRPC, "ccqSolanaRPC")
+			}
+		}
+
+		startupCommitment, err := solana.ParseStartupCommitment(*solanaStartupCommitment)
+		if err != nil {
+			logger.Fatal("invalid value for --solanaStartupCommitment", zap.Error(err))
+		}
+
 		// confirmed watcher
 		wc := &solana.WatcherConfig{
-			NetworkID:     "solana-confirmed",
-			ChainID:       vaa.ChainIDSolana,
-			Rpc:           *solanaRPC,
-			Websocket:     "",
-			Contract:      *solanaContract,
-			ReceiveObsReq: false,
-			Commitment:    rpc.CommitmentConfirmed,
+			NetworkID:         "solana-confirmed",
+			ChainID:           vaa.ChainIDSolana,
+			Rpc:               *solanaRPC,
+			Websocket:         "",
+			Contract:          *solanaContract,
+			ReceiveObsReq:     false,
+			Commitment:        rpc.CommitmentConfirmed,
+			StartupCommitment: startupCommitment,
 		}
 
 		watcherConfigs = append(watcherConfigs, wc)
 
 		// finalized watcher
 		wc = &solana.WatcherConfig{
-			NetworkID:     "solana-finalized",
-			ChainID:       vaa.ChainIDSolana,
-			Rpc:           *solanaRPC,
-			Websocket:     "",
-			Contract:      *solanaContract,
-			ReceiveObsReq: true,
-			Commitment:    rpc.CommitmentFinalized,
+			NetworkID:         "solana-finalized",
+			ChainID:           vaa.ChainIDSolana,
+			Rpc:               *solanaRPC,
+			Websocket:         "",
+			Contract:          *solanaContract,
+			ReceiveObsReq:     true,
+			Commitment:        rpc.CommitmentFinalized,
+			CcqRpc:            *ccqSolanaRPC,
+			StartupCommitment: startupCommitment,
 		}
 		watcherConfigs = append(watcherConfigs, wc)
 	}
@@ -1621,37 +1878,81 @@ func runNode(cmd *cobra.Command, args []string) {
 		node.GuardianOptionDatabase(db),
 		node.GuardianOptionWatchers(watcherConfigs, ibcWatcherConfig),
 		node.GuardianOptionAccountant(*accountantWS, *accountantContract, *accountantCheckEnabled, accountantWormchainConn, *accountantNttContract, accountantNttWormchainConn),
-		node.GuardianOptionGovernor(*chainGovernorEnabled),
+		node.GuardianOptionGovernor(*chainGovernorEnabled, uint8(*chainGovernorMinConsistencyLevel), *chainGovernorMaxReleaseDelay),
 		node.GuardianOptionGatewayRelayer(*gatewayRelayerContract, gatewayRelayerWormchainConn),
-		node.GuardianOptionQueryHandler(*ccqEnabled, *ccqAllowedRequesters),
-		node.GuardianOptionAdminService(*adminSocketPath, ethRPC, ethContract, rpcMap),
-		node.GuardianOptionP2P(p2pKey, *p2pNetworkID, *p2pBootstrap, *nodeName, *disableHeartbeatVerify, *p2pPort, *ccqP2pBootstrap, *ccqP2pPort, *ccqAllowedPeers, ibc.GetFeatures),
+		node.GuardianOptionQueryHandler(*ccqEnabled, *ccqAllowedRequesters, query.QueryHandlerConfig{
+			ResponseCacheTTL:    *ccqResponseCacheTTL,
+			RequestPrefix:       []byte(*ccqRequestPrefix),
+			MaxResponseDataSize: *ccqMaxResponseDataSize,
+			GsTransitionWindow:  *ccqGsTransitionWindow,
+		}),
+		node.GuardianOptionAdminService(*adminSocketPath, ethRPC, ethContract, rpcMap, adminrpc.AdminServiceConfig{
+			GuardianSetSoftMax: int(*guardianSetUpdateSoftMax),
+			NodeKeyPath:        *nodeKeyPath,
+			DevBuild:           Build == "dev",
+			DisabledMethods:    *adminDisabledMethods,
+			AuditLogFile:       *adminAuditLogFile,
+			GrpcReflection:     *adminGrpcReflection,
+			ObserverMode:       *observerMode,
+		}),
+		node.GuardianOptionP2P(p2pKey, *p2pNetworkID, *p2pBootstrap, *nodeName, *disableHeartbeatVerify, *p2pPort, *p2pConnMgrLow, *p2pConnMgrHigh, *p2pRequireBootstrap, *ccqP2pBootstrap, *ccqP2pPort, *ccqAllowedPeers, ibc.GetFeatures),
 		node.GuardianOptionStatusServer(*statusAddr),
-		node.GuardianOptionProcessor(),
+		node.GuardianOptionProcessor(processor.Config{
+			MaxPendingObservations: *processorMaxPendingObservations,
+			ReobservationBatchSize: *processorReobservationBatchSize,
+			MaxPayloadSize:         *processorMaxPayloadSize,
+			ObserverMode:           *observerMode,
+			PersistAggState:        *processorPersistAggState,
+			SecondaryGk:            secondaryGk,
+		}, *govCheckInterval, *processorCleanupInterval),
 	}
 
 	if shouldStart(publicGRPCSocketPath) {
 		guardianOptions = append(guardianOptions, node.GuardianOptionPublicRpcSocket(*publicGRPCSocketPath, publicRpcLogDetail))
 
 		if shouldStart(publicRPC) {
-			guardianOptions = append(guardianOptions, node.GuardianOptionPublicrpcTcpService(*publicRPC, publicRpcLogDetail))
+			guardianOptions = append(guardianOptions, node.GuardianOptionPublicrpcTcpService(*publicRPC, publicRpcLogDetail, *publicRpcTlsCert, *publicRpcTlsKey, *publicRpcClientCA))
 		}
 
 		if shouldStart(publicWeb) {
 			guardianOptions = append(guardianOptions,
-				node.GuardianOptionPublicWeb(*publicWeb, *publicGRPCSocketPath, *tlsHostname, *tlsProdEnv, path.Join(*dataDir, "autocert")),
+				node.GuardianOptionPublicWeb(*publicWeb, *publicGRPCSocketPath, *tlsHostname, *tlsProdEnv, path.Join(*dataDir, "autocert"), publicWebTLSMinVersion, *publicWebRateLimit, *publicWebMaxBodyBytes),
 			)
 		}
 	}
 
+	// Record the runnables that die so that a summary can be logged on exit.
+	failureSummary := node.NewFailureSummary()
+	supervisorLogger := logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, failureSummary.Core())
+	}))
+
 	// Run supervisor with Guardian Node as root.
-	supervisor.New(rootCtx, logger, guardianNode.Run(rootCtxCancel, guardianOptions...),
+	supervisor.New(rootCtx, supervisorLogger, guardianNode.Run(rootCtxCancel, guardianOptions...),
 		// It's safer to crash and restart the process in case we encounter a panic,
 		// rather than attempting to reschedule the runnable.
 		supervisor.WithPropagatePanic)
 
+	if gracePeriod := *startupGracePeriod; gracePeriod != 0 {
+		go func() {
+			if err := readiness.WaitForReady(rootCtx, gracePeriod, time.Second); err != nil && rootCtx.Err() == nil {
+				logger.Error("guardian did not become ready within the startup grace period", zap.Duration("startupGracePeriod", gracePeriod), zap.Error(err))
+				rootCtxCancel()
+			}
+		}()
+	}
+
 	<-rootCtx.Done()
 	logger.Info("root context cancelled, exiting...")
+	failureSummary.Log(logger)
+
+	if *metricsDumpPath != "" {
+		if err := telemetry.DumpMetrics(prometheus.DefaultGatherer, *metricsDumpPath); err != nil {
+			logger.Error("failed to dump metrics", zap.String("path", *metricsDumpPath), zap.Error(err))
+		} else {
+			logger.Info("dumped metrics", zap.String("path", *metricsDumpPath))
+		}
+	}
 }
 
 func shouldStart(rpc *string) bool {
@@ -24,7 +24,6 @@ import (
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/crypto"
-	"github.com/tendermint/tendermint/libs/rand"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
@@ -143,15 +142,10 @@ func main() {
 			Accounts:        [][query.SolanaPublicKeyLength]byte{account1, account2},
 		}
 
-		queryRequest := &query.QueryRequest{
-			Nonce: rand.Uint32(),
-			PerChainQueries: []*query.PerChainQueryRequest{
-				{
-					ChainId: 1,
-					Query:   callRequest,
-				},
-			},
-		}
+		queryRequest := query.NewQueryRequest(&query.PerChainQueryRequest{
+			ChainId: 1,
+			Query:   callRequest,
+		})
 		sendSolanaQueryAndGetRsp(queryRequest, sk, th_req, ctx, logger, sub)
 	}
 
@@ -159,30 +153,13 @@ func main() {
 		logger.Info("Running Solana PDA test")
 
 		// Start of query creation...
-		callRequest := &query.SolanaPdaQueryRequest{
-			Commitment:      "finalized",
-			DataSliceOffset: 0,
-			DataSliceLength: 100,
-			PDAs: []query.SolanaPDAEntry{
-				query.SolanaPDAEntry{
-					ProgramAddress: ethCommon.HexToHash("0x02c806312cbe5b79ef8aa6c17e3f423d8fdfe1d46909fb1f6cdf65ee8e2e6faa"), // Devnet core bridge
-					Seeds: [][]byte{
-						[]byte("GuardianSet"),
-						make([]byte, 4),
-					},
-				},
-			},
-		}
+		callRequest := query.NewGuardianSetPdaQuery(ethCommon.HexToHash("0x02c806312cbe5b79ef8aa6c17e3f423d8fdfe1d46909fb1f6cdf65ee8e2e6faa"), 0) // Devnet core bridge
+		callRequest.DataSliceLength = 100
 
-		queryRequest := &query.QueryRequest{
-			Nonce: rand.Uint32(),
-			PerChainQueries: []*query.PerChainQueryRequest{
-				{
-					ChainId: 1,
-					Query:   callRequest,
-				},
-			},
-		}
+		queryRequest := query.NewQueryRequest(&query.PerChainQueryRequest{
+			ChainId: 1,
+			Query:   callRequest,
+		})
 		sendSolanaQueryAndGetRsp(queryRequest, sk, th_req, ctx, logger, sub)
 	}
 
@@ -287,16 +264,10 @@ const (
 )
 
 func createQueryRequest(callRequest *query.EthCallQueryRequest) *query.QueryRequest {
-	queryRequest := &query.QueryRequest{
-		Nonce: rand.Uint32(),
-		PerChainQueries: []*query.PerChainQueryRequest{
-			{
-				ChainId: 2,
-				Query:   callRequest,
-			},
-		},
-	}
-	return queryRequest
+	return query.NewQueryRequest(&query.PerChainQueryRequest{
+		ChainId: 2,
+		Query:   callRequest,
+	})
 }
 
 func createQueryRequestWithMultipleRequests(callRequests []*query.EthCallQueryRequest) *query.QueryRequest {
@@ -308,11 +279,7 @@ func createQueryRequestWithMultipleRequests(callRequests []*query.EthCallQueryRe
 		})
 	}
 
-	queryRequest := &query.QueryRequest{
-		Nonce:           rand.Uint32(),
-		PerChainQueries: perChainQueries,
-	}
-	return queryRequest
+	return query.NewQueryRequest(perChainQueries...)
 }
 
 func sendQueryAndGetRsp(queryRequest *query.QueryRequest, sk *ecdsa.PrivateKey, th *pubsub.Topic, ctx context.Context, logger *zap.Logger, sub *pubsub.Subscription, wethAbi abi.ABI, methods []string) {
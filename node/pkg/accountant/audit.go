@@ -283,7 +283,7 @@ func (acct *Accountant) queryMissingObservations(wormchainConn AccountantWormcha
 		return nil, fmt.Errorf("failed to get guardian set")
 	}
 
-	guardianIndex, found := gs.KeyIndex(acct.guardianAddr)
+	guardianIndex, found := gs.IndexOf(acct.guardianAddr)
 	if !found {
 		return nil, fmt.Errorf("failed to get guardian index")
 	}
@@ -85,7 +85,7 @@ func (acct *Accountant) handleBatch(ctx context.Context, subChan chan *common.Me
 		return fmt.Errorf("failed to get guardian set for %s", tag)
 	}
 
-	guardianIndex, found := gs.KeyIndex(acct.guardianAddr)
+	guardianIndex, found := gs.IndexOf(acct.guardianAddr)
 	if !found {
 		return fmt.Errorf("failed to get guardian index for %s", tag)
 	}
@@ -14,9 +14,10 @@ import (
 	"math/big"
 	"math/rand"
 	"net/http"
-	"strconv"
+	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
@@ -27,25 +28,54 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/mr-tron/base58"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/certusone/wormhole/node/pkg/version"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// defaultBackfillConcurrency is the number of missing messages backfilled in parallel if the request does not specify it.
+	defaultBackfillConcurrency = 4
+	// maxBackfillConcurrency caps the requested backfill concurrency to avoid overwhelming the public RPC nodes.
+	maxBackfillConcurrency = 32
+	// backfillFetchTimeout bounds how long fetching a single missing message from the backfill nodes may take.
+	backfillFetchTimeout = time.Second
+
+	// governanceInjectTimeout bounds how long InjectGovernanceVAA waits for the processor to accept a message.
+	governanceInjectTimeout = 5 * time.Second
+
+	// governorReloadTimeout bounds how long ChainGovernorReload waits for the governor to reload.
+	governorReloadTimeout = 30 * time.Second
+)
+
+// ErrInjectChannelFull is returned when the processor does not accept an injected governance message in time.
+var ErrInjectChannelFull = errors.New("inject channel is full, the processor is not accepting messages")
+
 var (
-	vaaInjectionsTotal = promauto.NewCounter(
+	vaaInjectionsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "wormhole_vaa_injections_total",
 			Help: "Total number of injected VAA queued for broadcast",
+		}, []string{"action"})
+	governanceInjectChannelFull = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_governance_inject_channel_full_total",
+			Help: "Total number of governance VAA injections that failed because the inject channel was full",
 		})
 )
 
@@ -56,12 +86,63 @@ type nodePrivilegedService struct {
 	obsvReqSendC    chan<- *gossipv1.ObservationRequest
 	logger          *zap.Logger
 	signedInC       chan<- *gossipv1.SignedVAAWithQuorum
+	gossipSendC     chan<- []byte
+	gst             *common.GuardianSetState
 	governor        *governor.ChainGovernor
 	evmConnector    connectors.Connector
 	gsCache         sync.Map
 	gk              *ecdsa.PrivateKey
 	guardianAddress ethcommon.Address
 	rpcMap          map[string]string
+
+	// guardianSetSoftMax is an operator configured limit on the size of an injected guardian set update.
+	// It is stricter than common.MaxGuardianCount and can be bypassed per request. Zero disables it.
+	guardianSetSoftMax int
+
+	env            common.Environment
+	nodeKeyPath    string
+	nodeKeyRotateC chan<- p2pcrypto.PrivKey
+
+	devBuild bool
+
+	// watchedChains is the set of chains the node has watchers for. Observation requests for other chains are rejected.
+	watchedChains map[vaa.ChainID]struct{}
+
+	// backfillNodes tracks public RPC nodes that return bad data during backfills so they can be temporarily skipped.
+	backfillNodes *backfillNodeTracker
+
+	// observerMode is set when the node verifies and stores VAAs without signing anything.
+	observerMode bool
+
+	// aggStats holds the aggregation state stats published by the processor.
+	aggStats *processor.AggregationStatsState
+
+	// governorReloading is set while a governor reload runs, which may be after its request timed out.
+	governorReloading atomic.Bool
+}
+
+// AdminServiceConfig holds the optional settings of the admin service. The zero value disables all of them.
+type AdminServiceConfig struct {
+	// GuardianSetSoftMax rejects injected guardian set updates larger than this unless overridden per request. Zero disables it.
+	GuardianSetSoftMax int
+
+	// NodeKeyPath is the path of the p2p node key, which RotateNodeKey replaces. Node key rotation is refused if it is empty.
+	NodeKeyPath string
+
+	// DevBuild is reported by GetNodeVersion.
+	DevBuild bool
+
+	// DisabledMethods is a comma separated list of admin methods to reject with PermissionDenied.
+	DisabledMethods string
+
+	// AuditLogFile, if set, is a file every admin call is also audited to.
+	AuditLogFile string
+
+	// GrpcReflection enables gRPC server reflection on the admin socket.
+	GrpcReflection bool
+
+	// ObserverMode is set when the node verifies and stores VAAs without signing anything.
+	ObserverMode bool
 }
 
 func NewPrivService(
@@ -70,24 +151,44 @@ func NewPrivService(
 	obsvReqSendC chan<- *gossipv1.ObservationRequest,
 	logger *zap.Logger,
 	signedInC chan<- *gossipv1.SignedVAAWithQuorum,
+	gossipSendC chan<- []byte,
+	gst *common.GuardianSetState,
 	governor *governor.ChainGovernor,
 	evmConnector connectors.Connector,
 	gk *ecdsa.PrivateKey,
 	guardianAddress ethcommon.Address,
 	rpcMap map[string]string,
-
+	guardianSetSoftMax int,
+	env common.Environment,
+	nodeKeyPath string,
+	nodeKeyRotateC chan<- p2pcrypto.PrivKey,
+	devBuild bool,
+	watchedChains map[vaa.ChainID]struct{},
+	observerMode bool,
+	aggStats *processor.AggregationStatsState,
 ) *nodePrivilegedService {
 	return &nodePrivilegedService{
-		db:              db,
-		injectC:         injectC,
-		obsvReqSendC:    obsvReqSendC,
-		logger:          logger,
-		signedInC:       signedInC,
-		governor:        governor,
-		evmConnector:    evmConnector,
-		gk:              gk,
-		guardianAddress: guardianAddress,
-		rpcMap:          rpcMap,
+		db:                 db,
+		injectC:            injectC,
+		obsvReqSendC:       obsvReqSendC,
+		logger:             logger,
+		signedInC:          signedInC,
+		gossipSendC:        gossipSendC,
+		gst:                gst,
+		governor:           governor,
+		evmConnector:       evmConnector,
+		gk:                 gk,
+		guardianAddress:    guardianAddress,
+		rpcMap:             rpcMap,
+		guardianSetSoftMax: guardianSetSoftMax,
+		env:                env,
+		nodeKeyPath:        nodeKeyPath,
+		nodeKeyRotateC:     nodeKeyRotateC,
+		devBuild:           devBuild,
+		watchedChains:      watchedChains,
+		backfillNodes:      newBackfillNodeTracker(backfillNodeMaxFailures, backfillNodeSkipWindow),
+		observerMode:       observerMode,
+		aggStats:           aggStats,
 	}
 }
 
@@ -127,6 +228,20 @@ func adminGuardianSetUpdateToVAA(req *nodev1.GuardianSetUpdate, timestamp time.T
 	return v, nil
 }
 
+// checkGuardianSetSoftMax enforces the configured soft maximum on the size of a proposed guardian set.
+// A softMax of zero disables the check. The hard limit is enforced by adminGuardianSetUpdateToVAA.
+func checkGuardianSetSoftMax(req *nodev1.GuardianSetUpdate, softMax int) error {
+	if softMax <= 0 || req.OverrideSoftMax {
+		return nil
+	}
+
+	if len(req.Guardians) > softMax {
+		return fmt.Errorf("too many guardians - %d, configured soft maximum is %d (set override_soft_max to bypass)", len(req.Guardians), softMax)
+	}
+
+	return nil
+}
+
 // adminContractUpgradeToVAA converts a nodev1.ContractUpgrade message to its canonical VAA representation.
 // Returns an error if the data is invalid.
 func adminContractUpgradeToVAA(req *nodev1.ContractUpgrade, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
@@ -184,19 +299,59 @@ func tokenBridgeRegisterChain(req *nodev1.BridgeRegisterChain, timestamp time.Ti
 	return v, nil
 }
 
-// recoverChainId converts a nodev1.RecoverChainId message to its canonical VAA representation.
-// Returns an error if the data is invalid.
-func recoverChainId(req *nodev1.RecoverChainId, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	evm_chain_id_big := big.NewInt(0)
-	evm_chain_id_big, ok := evm_chain_id_big.SetString(req.EvmChainId, 10)
+// EvmChainIdErrorReason describes why an evm_chain_id was rejected.
+type EvmChainIdErrorReason string
+
+const (
+	EvmChainIdNotNumeric EvmChainIdErrorReason = "not a decimal integer"
+	EvmChainIdNegative   EvmChainIdErrorReason = "negative"
+	EvmChainIdOverflow   EvmChainIdErrorReason = "does not fit in 256 bits"
+)
+
+// EvmChainIdError is returned when the evm_chain_id of a RecoverChainId message is not a valid uint256.
+type EvmChainIdError struct {
+	// Value is the evm_chain_id as supplied.
+	Value  string
+	Reason EvmChainIdErrorReason
+}
+
+func (e *EvmChainIdError) Error() string {
+	return fmt.Sprintf("invalid evm_chain_id %q: %s", e.Value, e.Reason)
+}
+
+// parseEvmChainId parses a decimal evm_chain_id into a uint256.
+func parseEvmChainId(s string) (*uint256.Int, error) {
+	evm_chain_id_big, ok := new(big.Int).SetString(s, 10)
 	if !ok {
-		return nil, errors.New("invalid evm_chain_id")
+		return nil, &EvmChainIdError{Value: s, Reason: EvmChainIdNotNumeric}
+	}
+
+	// uint256.FromBig silently wraps negative values, so they have to be rejected explicitly.
+	if evm_chain_id_big.Sign() < 0 {
+		return nil, &EvmChainIdError{Value: s, Reason: EvmChainIdNegative}
 	}
 
 	// uint256 has Bytes32 method for easier serialization
 	evm_chain_id, overflow := uint256.FromBig(evm_chain_id_big)
 	if overflow {
-		return nil, errors.New("evm_chain_id overflow")
+		return nil, &EvmChainIdError{Value: s, Reason: EvmChainIdOverflow}
+	}
+
+	return evm_chain_id, nil
+}
+
+// recoverChainId converts a nodev1.RecoverChainId message to its canonical VAA representation.
+// Returns an error if the data is invalid.
+func recoverChainId(req *nodev1.RecoverChainId, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
+	// BodyRecoverChainId.Serialize uses the TokenBridge action for any module other than Core, so a typo would silently
+	// produce a TokenBridge recover action.
+	if req.Module != "Core" && req.Module != "TokenBridge" {
+		return nil, fmt.Errorf("invalid module %q, must be Core or TokenBridge", req.Module)
+	}
+
+	evm_chain_id, err := parseEvmChainId(req.EvmChainId)
+	if err != nil {
+		return nil, err
 	}
 
 	if req.NewChainId > math.MaxUint16 {
@@ -577,12 +732,148 @@ func wormholeRelayerSetDefaultDeliveryProvider(req *nodev1.WormholeRelayerSetDef
 	return v, nil
 }
 
+// supportedGovernanceActions lists the module and action of the VAAs produced by GovMsgToVaa for each payload type,
+// keyed by the name of the GovernanceMessage payload field. It has to be kept in sync with the switch in GovMsgToVaa.
+var supportedGovernanceActions = []struct {
+	payload string
+	module  string
+	action  vaa.GovernanceAction
+}{
+	{"guardian_set", "Core", vaa.ActionGuardianSetUpdate},
+	{"contract_upgrade", "Core", vaa.ActionContractUpgrade},
+	{"bridge_register_chain", "TokenBridge", vaa.ActionRegisterChain},
+	{"bridge_register_chain", "NFTBridge", vaa.ActionRegisterChain},
+	{"bridge_contract_upgrade", "TokenBridge", vaa.ActionUpgradeTokenBridge},
+	{"bridge_contract_upgrade", "NFTBridge", vaa.ActionUpgradeTokenBridge},
+	{"recover_chain_id", "Core", vaa.ActionCoreRecoverChainId},
+	{"recover_chain_id", "TokenBridge", vaa.ActionTokenBridgeRecoverChainId},
+	{"accountant_modify_balance", "GlobalAccountant", vaa.ActionModifyBalance},
+	{"wormchain_store_code", strings.TrimLeft(vaa.WasmdModuleStr, "\x00"), vaa.ActionStoreCode},
+	{"wormchain_instantiate_contract", strings.TrimLeft(vaa.WasmdModuleStr, "\x00"), vaa.ActionInstantiateContract},
+	{"wormchain_migrate_contract", strings.TrimLeft(vaa.WasmdModuleStr, "\x00"), vaa.ActionMigrateContract},
+	{"wormchain_wasm_instantiate_allowlist", strings.TrimLeft(vaa.WasmdModuleStr, "\x00"), vaa.ActionAddWasmInstantiateAllowlist},
+	{"wormchain_wasm_instantiate_allowlist", strings.TrimLeft(vaa.WasmdModuleStr, "\x00"), vaa.ActionDeleteWasmInstantiateAllowlist},
+	{"gateway_schedule_upgrade", strings.TrimLeft(vaa.GatewayModuleStr, "\x00"), vaa.ActionScheduleUpgrade},
+	{"gateway_cancel_upgrade", strings.TrimLeft(vaa.GatewayModuleStr, "\x00"), vaa.ActionCancelUpgrade},
+	{"gateway_ibc_composability_mw_set_contract", strings.TrimLeft(vaa.GatewayModuleStr, "\x00"), vaa.ActionSetIbcComposabilityMwContract},
+	{"circle_integration_update_wormhole_finality", strings.TrimLeft(vaa.CircleIntegrationModuleStr, "\x00"), vaa.CircleIntegrationActionUpdateWormholeFinality},
+	{"circle_integration_register_emitter_and_domain", strings.TrimLeft(vaa.CircleIntegrationModuleStr, "\x00"), vaa.CircleIntegrationActionRegisterEmitterAndDomain},
+	{"circle_integration_upgrade_contract_implementation", strings.TrimLeft(vaa.CircleIntegrationModuleStr, "\x00"), vaa.CircleIntegrationActionUpgradeContractImplementation},
+	{"ibc_update_channel_chain", strings.TrimLeft(vaa.IbcReceiverModuleStr, "\x00"), vaa.IbcReceiverActionUpdateChannelChain},
+	{"ibc_update_channel_chain", strings.TrimLeft(vaa.IbcTranslatorModuleStr, "\x00"), vaa.IbcTranslatorActionUpdateChannelChain},
+	{"wormhole_relayer_set_default_delivery_provider", strings.TrimLeft(vaa.WormholeRelayerModuleStr, "\x00"), vaa.WormholeRelayerSetDefaultDeliveryProvider},
+}
+
+func (s *nodePrivilegedService) ListSupportedGovernanceActions(ctx context.Context, req *nodev1.ListSupportedGovernanceActionsRequest) (*nodev1.ListSupportedGovernanceActionsResponse, error) {
+	actions := make([]*nodev1.SupportedGovernanceAction, 0, len(supportedGovernanceActions))
+	for _, a := range supportedGovernanceActions {
+		actions = append(actions, &nodev1.SupportedGovernanceAction{
+			Payload: a.payload,
+			Module:  a.module,
+			Action:  uint32(a.action),
+		})
+	}
+	return &nodev1.ListSupportedGovernanceActionsResponse{Actions: actions}, nil
+}
+
+func (s *nodePrivilegedService) ListSupportedQueryTypes(ctx context.Context, req *nodev1.ListSupportedQueryTypesRequest) (*nodev1.ListSupportedQueryTypesResponse, error) {
+	queryTypes := make([]*nodev1.SupportedQueryType, 0, len(query.SupportedQueryTypes))
+	for _, qt := range query.SupportedQueryTypes {
+		queryTypes = append(queryTypes, &nodev1.SupportedQueryType{
+			Type: uint32(qt.Type),
+			Name: qt.Name,
+		})
+	}
+	return &nodev1.ListSupportedQueryTypesResponse{QueryTypes: queryTypes}, nil
+}
+
+// knownChains is the set of chains recognized by this node.
+var knownChains = func() map[vaa.ChainID]struct{} {
+	chains := make(map[vaa.ChainID]struct{})
+	for _, chainId := range vaa.GetAllNetworkIDs() {
+		chains[chainId] = struct{}{}
+	}
+	return chains
+}()
+
+// govMsgChainRef is a chain ID referenced by a governance message.
+type govMsgChainRef struct {
+	field   string
+	chainId uint32
+	// target is set for target chain fields, which may be zero to address all chains.
+	target bool
+}
+
+// govMsgChainRefs returns the chain IDs referenced by the payload of a governance message.
+func govMsgChainRefs(message *nodev1.GovernanceMessage) []govMsgChainRef {
+	switch payload := message.Payload.(type) {
+	case *nodev1.GovernanceMessage_ContractUpgrade:
+		return []govMsgChainRef{{field: "chain_id", chainId: payload.ContractUpgrade.ChainId}}
+	case *nodev1.GovernanceMessage_BridgeRegisterChain:
+		return []govMsgChainRef{{field: "chain_id", chainId: payload.BridgeRegisterChain.ChainId}}
+	case *nodev1.GovernanceMessage_BridgeContractUpgrade:
+		return []govMsgChainRef{{field: "target_chain_id", chainId: payload.BridgeContractUpgrade.TargetChainId, target: true}}
+	case *nodev1.GovernanceMessage_RecoverChainId:
+		return []govMsgChainRef{{field: "new_chain_id", chainId: payload.RecoverChainId.NewChainId}}
+	case *nodev1.GovernanceMessage_AccountantModifyBalance:
+		return []govMsgChainRef{
+			{field: "target_chain_id", chainId: payload.AccountantModifyBalance.TargetChainId, target: true},
+			{field: "chain_id", chainId: payload.AccountantModifyBalance.ChainId},
+			{field: "token_chain", chainId: payload.AccountantModifyBalance.TokenChain},
+		}
+	case *nodev1.GovernanceMessage_CircleIntegrationUpdateWormholeFinality:
+		return []govMsgChainRef{{field: "target_chain_id", chainId: payload.CircleIntegrationUpdateWormholeFinality.TargetChainId, target: true}}
+	case *nodev1.GovernanceMessage_CircleIntegrationRegisterEmitterAndDomain:
+		return []govMsgChainRef{
+			{field: "target_chain_id", chainId: payload.CircleIntegrationRegisterEmitterAndDomain.TargetChainId, target: true},
+			{field: "foreign_emitter_chain_id", chainId: payload.CircleIntegrationRegisterEmitterAndDomain.ForeignEmitterChainId},
+		}
+	case *nodev1.GovernanceMessage_CircleIntegrationUpgradeContractImplementation:
+		return []govMsgChainRef{{field: "target_chain_id", chainId: payload.CircleIntegrationUpgradeContractImplementation.TargetChainId, target: true}}
+	case *nodev1.GovernanceMessage_IbcUpdateChannelChain:
+		return []govMsgChainRef{
+			{field: "target_chain_id", chainId: payload.IbcUpdateChannelChain.TargetChainId, target: true},
+			{field: "chain_id", chainId: payload.IbcUpdateChannelChain.ChainId},
+		}
+	case *nodev1.GovernanceMessage_WormholeRelayerSetDefaultDeliveryProvider:
+		return []govMsgChainRef{{field: "chain_id", chainId: payload.WormholeRelayerSetDefaultDeliveryProvider.ChainId}}
+	default:
+		return nil
+	}
+}
+
+// checkGovMsgChains verifies that every chain referenced by a governance message is known to this node,
+// unless the message explicitly allows unknown chains. Target chain fields may be zero to address all chains.
+func checkGovMsgChains(message *nodev1.GovernanceMessage) error {
+	if message.AllowUnknownChains {
+		return nil
+	}
+
+	for _, ref := range govMsgChainRefs(message) {
+		if ref.target && ref.chainId == uint32(vaa.ChainIDUnset) {
+			continue
+		}
+		if ref.chainId > math.MaxUint16 {
+			return fmt.Errorf("invalid %s, must be <= %d", ref.field, math.MaxUint16)
+		}
+		if _, exists := knownChains[vaa.ChainID(ref.chainId)]; !exists {
+			return fmt.Errorf("invalid %s %d: unknown chain (set allow_unknown_chains to override)", ref.field, ref.chainId)
+		}
+	}
+
+	return nil
+}
+
 func GovMsgToVaa(message *nodev1.GovernanceMessage, currentSetIndex uint32, timestamp time.Time) (*vaa.VAA, error) {
 	var (
 		v   *vaa.VAA
 		err error
 	)
 
+	if err := checkGovMsgChains(message); err != nil {
+		return nil, err
+	}
+
 	switch payload := message.Payload.(type) {
 	case *nodev1.GovernanceMessage_GuardianSet:
 		v, err = adminGuardianSetUpdateToVAA(payload.GuardianSet, timestamp, currentSetIndex, message.Nonce, message.Sequence)
@@ -627,51 +918,413 @@ func GovMsgToVaa(message *nodev1.GovernanceMessage, currentSetIndex uint32, time
 	return v, err
 }
 
+// govMsgAction returns the name of the governance action in a message, for use as a metric label.
+func govMsgAction(message *nodev1.GovernanceMessage) string {
+	switch message.Payload.(type) {
+	case *nodev1.GovernanceMessage_GuardianSet:
+		return "guardian_set_update"
+	case *nodev1.GovernanceMessage_ContractUpgrade:
+		return "contract_upgrade"
+	case *nodev1.GovernanceMessage_BridgeRegisterChain:
+		return "register_chain"
+	case *nodev1.GovernanceMessage_BridgeContractUpgrade:
+		return "bridge_contract_upgrade"
+	case *nodev1.GovernanceMessage_RecoverChainId:
+		return "recover_chain_id"
+	case *nodev1.GovernanceMessage_AccountantModifyBalance:
+		return "accountant_modify_balance"
+	case *nodev1.GovernanceMessage_WormchainStoreCode:
+		return "wormchain_store_code"
+	case *nodev1.GovernanceMessage_WormchainInstantiateContract:
+		return "wormchain_instantiate_contract"
+	case *nodev1.GovernanceMessage_WormchainMigrateContract:
+		return "wormchain_migrate_contract"
+	case *nodev1.GovernanceMessage_WormchainWasmInstantiateAllowlist:
+		return "wormchain_wasm_instantiate_allowlist"
+	case *nodev1.GovernanceMessage_GatewayScheduleUpgrade:
+		return "gateway_schedule_upgrade"
+	case *nodev1.GovernanceMessage_GatewayCancelUpgrade:
+		return "gateway_cancel_upgrade"
+	case *nodev1.GovernanceMessage_GatewayIbcComposabilityMwSetContract:
+		return "gateway_ibc_composability_mw_set_contract"
+	case *nodev1.GovernanceMessage_CircleIntegrationUpdateWormholeFinality:
+		return "circle_integration_update_wormhole_finality"
+	case *nodev1.GovernanceMessage_CircleIntegrationRegisterEmitterAndDomain:
+		return "circle_integration_register_emitter_and_domain"
+	case *nodev1.GovernanceMessage_CircleIntegrationUpgradeContractImplementation:
+		return "circle_integration_upgrade_contract_implementation"
+	case *nodev1.GovernanceMessage_IbcUpdateChannelChain:
+		return "ibc_update_channel_chain"
+	case *nodev1.GovernanceMessage_WormholeRelayerSetDefaultDeliveryProvider:
+		return "wormhole_relayer_set_default_delivery_provider"
+	default:
+		return "unknown"
+	}
+}
+
 func (s *nodePrivilegedService) InjectGovernanceVAA(ctx context.Context, req *nodev1.InjectGovernanceVAARequest) (*nodev1.InjectGovernanceVAAResponse, error) {
-	s.logger.Info("governance VAA injected via admin socket", zap.String("request", req.String()))
+	logger := s.logger
+	if req.RequestLabel != "" {
+		logger = logger.With(zap.String("requestLabel", req.RequestLabel))
+	}
+
+	logger.Info("governance VAA injected via admin socket", zap.String("request", req.String()))
 
 	var (
 		v   *vaa.VAA
 		err error
 	)
 
-	timestamp := time.Unix(int64(req.Timestamp), 0)
+	if err := s.checkSigningAllowed(); err != nil {
+		return nil, err
+	}
 
-	digests := make([][]byte, len(req.Messages))
+	if err := s.checkLocalGuardianInSet(logger, req.OverrideNotInGuardianSet); err != nil {
+		return nil, err
+	}
 
+	timestamp := time.Unix(int64(req.Timestamp), 0)
+
+	// All messages are checked before any is injected, so that a rejected message does not leave the earlier ones injected.
+	vaas := make([]*vaa.VAA, len(req.Messages))
 	for i, message := range req.Messages {
+		if payload, ok := message.Payload.(*nodev1.GovernanceMessage_GuardianSet); ok {
+			if err := checkGuardianSetSoftMax(payload.GuardianSet, s.guardianSetSoftMax); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			if payload.GuardianSet.OverrideSoftMax && s.guardianSetSoftMax > 0 && len(payload.GuardianSet.Guardians) > s.guardianSetSoftMax {
+				logger.Warn("guardian set update exceeds the configured soft maximum, allowing because override was requested",
+					zap.Int("numGuardians", len(payload.GuardianSet.Guardians)),
+					zap.Int("softMax", s.guardianSetSoftMax),
+				)
+			}
+		}
+
+		if req.AutoAssignSequence && message.Sequence != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "message %d specifies sequence %d, but auto_assign_sequence is set", i, message.Sequence)
+		}
+
 		v, err = GovMsgToVaa(message, req.CurrentSetIndex, timestamp)
 
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
+		vaas[i] = v
+	}
+
+	// Sequences are only assigned or recorded once the whole batch is valid, so that a rejected message does not use them up.
+	if err := s.assignGovernanceSequences(logger, req, vaas); err != nil {
+		return nil, err
+	}
+
+	digests := make([][]byte, len(req.Messages))
+	sequences := make([]uint64, len(req.Messages))
+
+	for i, message := range req.Messages {
+		v := vaas[i]
+
 		// Generate digest of the unsigned VAA.
 		digest := v.SigningDigest()
 
-		s.logger.Info("governance VAA constructed",
+		logger.Info("governance VAA constructed",
+			zap.Uint32("nonce", message.Nonce),
+			zap.Uint64("sequence", message.Sequence),
 			zap.Any("vaa", v),
 			zap.String("digest", digest.String()),
 		)
 
-		vaaInjectionsTotal.Inc()
-
-		s.injectC <- &common.MessagePublication{
-			TxHash:           ethcommon.Hash{},
-			Timestamp:        v.Timestamp,
-			Nonce:            v.Nonce,
-			Sequence:         v.Sequence,
-			ConsistencyLevel: v.ConsistencyLevel,
-			EmitterChain:     v.EmitterChain,
-			EmitterAddress:   v.EmitterAddress,
-			Payload:          v.Payload,
-			Unreliable:       false,
+		if err := s.postGovernanceMessage(ctx, common.MessagePublicationFromVAA(v)); err != nil {
+			logger.Error("failed to inject governance VAA", zap.Int("injected", i), zap.Int("total", len(req.Messages)), zap.Error(err))
+			return nil, status.Errorf(codes.Unavailable, "%v (%d of %d messages were injected)", err, i, len(req.Messages))
 		}
 
+		vaaInjectionsTotal.WithLabelValues(govMsgAction(message)).Inc()
+
 		digests[i] = digest.Bytes()
+		sequences[i] = message.Sequence
+	}
+
+	return &nodev1.InjectGovernanceVAAResponse{Digests: digests, Sequences: sequences}, nil
+}
+
+// governanceQuorum reports the local guardian's position in the current guardian set and the quorum threshold.
+// It returns nil if the guardian set is not known yet.
+func (s *nodePrivilegedService) governanceQuorum() *nodev1.CheckGovernanceQuorumResponse {
+	if s.gst == nil {
+		return nil
+	}
+	gs := s.gst.Get()
+	if gs == nil {
+		return nil
+	}
+
+	resp := &nodev1.CheckGovernanceQuorumResponse{
+		GuardianSetIndex: gs.Index,
+		NumGuardians:     uint32(len(gs.Keys)),
+		Quorum:           uint32(vaa.CalculateQuorum(len(gs.Keys))),
+	}
+	if idx, found := gs.IndexOf(s.guardianAddress); found {
+		resp.InGuardianSet = true
+		resp.LocalGuardianIndex = uint32(idx)
+	}
+	return resp
+}
+
+// checkSigningAllowed refuses requests that need the local guardian to sign when the node runs in observer mode.
+func (s *nodePrivilegedService) checkSigningAllowed() error {
+	if s.observerMode {
+		return status.Error(codes.FailedPrecondition, "signing is not supported in observer mode")
+	}
+	return nil
+}
+
+// checkLocalGuardianInSet refuses governance injections the local guardian cannot contribute a quorum signature to,
+// unless overridden. The check is skipped if the guardian set is not known yet.
+func (s *nodePrivilegedService) checkLocalGuardianInSet(logger *zap.Logger, override bool) error {
+	q := s.governanceQuorum()
+	if q == nil {
+		logger.Warn("guardian set is not initialized yet, unable to check whether the local guardian can sign governance VAAs")
+		return nil
+	}
+
+	if q.InGuardianSet {
+		logger.Info("local guardian is in the current guardian set",
+			zap.Uint32("guardianSetIndex", q.GuardianSetIndex),
+			zap.Uint32("localGuardianIndex", q.LocalGuardianIndex),
+			zap.Uint32("numGuardians", q.NumGuardians),
+			zap.Uint32("quorum", q.Quorum),
+		)
+		return nil
+	}
+
+	if !override {
+		return status.Errorf(codes.FailedPrecondition,
+			"local guardian %s is not in the current guardian set %d, its signature cannot count toward quorum (set override_not_in_guardian_set to inject anyway)",
+			s.guardianAddress.Hex(), q.GuardianSetIndex)
+	}
+
+	logger.Warn("local guardian is not in the current guardian set, injecting anyway because override was requested",
+		zap.String("guardianAddress", s.guardianAddress.Hex()),
+		zap.Uint32("guardianSetIndex", q.GuardianSetIndex),
+	)
+	return nil
+}
+
+// CheckGovernanceQuorum reports whether the local guardian is in the current guardian set and the quorum threshold.
+func (s *nodePrivilegedService) CheckGovernanceQuorum(ctx context.Context, req *nodev1.CheckGovernanceQuorumRequest) (*nodev1.CheckGovernanceQuorumResponse, error) {
+	q := s.governanceQuorum()
+	if q == nil {
+		return nil, status.Error(codes.FailedPrecondition, "guardian set is not initialized yet")
+	}
+	return q, nil
+}
+
+// VerifyVAADigest recomputes the signing digest of a stored VAA and verifies its signatures against the guardian set it claims
+// to be signed by. Verification failures are reported in the response rather than as an error.
+func (s *nodePrivilegedService) VerifyVAADigest(ctx context.Context, req *nodev1.VerifyVAADigestRequest) (*nodev1.VerifyVAADigestResponse, error) {
+	if s.db == nil {
+		return nil, status.Error(codes.FailedPrecondition, "database is not available")
+	}
+	if s.gst == nil {
+		return nil, status.Error(codes.FailedPrecondition, "guardian set state is not available")
+	}
+
+	id, err := db.ParseVAAID(req.VaaId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	b, err := s.db.GetSignedVAABytes(id)
+	if err != nil {
+		if errors.Is(err, db.ErrVAANotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &nodev1.VerifyVAADigestResponse{}
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		resp.Errors = append(resp.Errors, fmt.Sprintf("failed to unmarshal stored VAA: %v", err))
+		return resp, nil
+	}
+
+	resp.Digest = hex.EncodeToString(v.SigningDigest().Bytes())
+	resp.GuardianSetIndex = v.GuardianSetIndex
+	resp.NumSignatures = uint32(len(v.Signatures))
+
+	if v.EmitterChain != id.EmitterChain || v.EmitterAddress != id.EmitterAddress || v.Sequence != id.Sequence {
+		resp.Errors = append(resp.Errors, fmt.Sprintf("stored VAA has ID %s, expected %s", db.VaaIDFromVAA(v).String(), id.String()))
+	}
+
+	if gs, ok := s.gst.GetForIndex(v.GuardianSetIndex); !ok {
+		resp.Errors = append(resp.Errors, fmt.Sprintf("guardian set %d is not known", v.GuardianSetIndex))
+	} else {
+		resp.Quorum = uint32(vaa.CalculateQuorum(len(gs.Keys)))
+		if err := v.Verify(gs.Keys); err != nil {
+			resp.Errors = append(resp.Errors, err.Error())
+		}
+	}
+
+	resp.Valid = len(resp.Errors) == 0
+	return resp, nil
+}
+
+// assignGovernanceSequences sets the sequences of the governance messages and their VAAs to a consecutive range reserved from the
+// counter persisted in the database if the request sets auto_assign_sequence. Otherwise the highest explicit sequence is recorded
+// so that the counter never hands it out again. The sequences are checked for collisions before the counter is written, so that
+// a collision leaves it unchanged.
+func (s *nodePrivilegedService) assignGovernanceSequences(logger *zap.Logger, req *nodev1.InjectGovernanceVAARequest, vaas []*vaa.VAA) error {
+	if len(req.Messages) == 0 {
+		return nil
+	}
+
+	checkCollisions := func() error {
+		for _, v := range vaas {
+			if err := s.checkGovernanceSequenceCollision(logger, v, req.OverrideSequenceCollision); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !req.AutoAssignSequence {
+		if err := checkCollisions(); err != nil {
+			return err
+		}
+		if s.db == nil {
+			return nil
+		}
+		var highest uint64
+		for _, message := range req.Messages {
+			if message.Sequence > highest {
+				highest = message.Sequence
+			}
+		}
+		if err := s.db.RecordGovernanceSequence(highest); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	}
+
+	if s.db == nil {
+		return status.Error(codes.FailedPrecondition, "auto_assign_sequence is set but no database is available to assign sequences")
+	}
+	_, err := s.db.ReserveGovernanceSequences(len(req.Messages), func(first uint64) error {
+		for i, message := range req.Messages {
+			message.Sequence = first + uint64(i)
+			vaas[i].Sequence = message.Sequence
+		}
+		return checkCollisions()
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// checkGovernanceSequenceCollision refuses to inject a governance VAA if this node already stored one from the same emitter with
+// the same sequence, since injecting it would produce two conflicting governance VAAs for that sequence.
+func (s *nodePrivilegedService) checkGovernanceSequenceCollision(logger *zap.Logger, v *vaa.VAA, override bool) error {
+	if s.db == nil {
+		return nil
+	}
+
+	exists, err := s.db.HasVAA(db.VAAID{EmitterChain: v.EmitterChain, EmitterAddress: v.EmitterAddress, Sequence: v.Sequence})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !exists {
+		return nil
+	}
+
+	if !override {
+		return status.Errorf(codes.AlreadyExists,
+			"a governance VAA with sequence %d already exists for emitter %s (set override_sequence_collision to inject anyway)",
+			v.Sequence, v.EmitterAddress)
+	}
+
+	logger.Warn("a governance VAA with this sequence already exists, injecting anyway because override was requested",
+		zap.Uint64("sequence", v.Sequence),
+		zap.Stringer("emitterAddress", v.EmitterAddress),
+	)
+	return nil
+}
+
+// postGovernanceMessage sends an injected governance message to the processor. It gives up with ErrInjectChannelFull
+// if the message is not accepted within governanceInjectTimeout or before ctx is done, so that a stuck processor cannot
+// block the admin server.
+func (s *nodePrivilegedService) postGovernanceMessage(ctx context.Context, msg *common.MessagePublication) error {
+	ctx, cancel := context.WithTimeout(ctx, governanceInjectTimeout)
+	defer cancel()
+
+	select {
+	case s.injectC <- msg:
+		return nil
+	case <-ctx.Done():
+		governanceInjectChannelFull.Inc()
+		return ErrInjectChannelFull
+	}
+}
+
+// InjectSignedVAA verifies a fully signed VAA, e.g. one produced offline, against the current guardian set, stores it
+// and gossips it to the network. It is not injected into the signed VAA receive path, which would drop it as a duplicate
+// once it is stored and never re-gossips anything.
+func (s *nodePrivilegedService) InjectSignedVAA(ctx context.Context, req *nodev1.InjectSignedVAARequest) (*nodev1.InjectSignedVAAResponse, error) {
+	v, err := vaa.Unmarshal(req.Vaa)
+	if err != nil {
+		return nil, vaaUnmarshalError(err)
+	}
+
+	if s.gst == nil {
+		return nil, status.Error(codes.FailedPrecondition, "guardian set state is not available")
+	}
+	gs := s.gst.Get()
+	if gs == nil {
+		return nil, status.Error(codes.FailedPrecondition, "guardian set is not initialized yet")
+	}
+
+	if v.GuardianSetIndex != gs.Index {
+		return nil, status.Errorf(codes.InvalidArgument, "VAA is signed by guardian set %d, current guardian set is %d", v.GuardianSetIndex, gs.Index)
+	}
+
+	if err := v.Verify(gs.Keys); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to verify VAA: %v", err)
+	}
+
+	if s.db == nil {
+		return nil, status.Error(codes.FailedPrecondition, "database is not available")
+	}
+
+	if err := s.db.StoreSignedVAA(v); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store VAA: %v", err)
+	}
+
+	msg, err := proto.Marshal(&gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedVaaWithQuorum{
+		SignedVaaWithQuorum: &gossipv1.SignedVAAWithQuorum{Vaa: req.Vaa},
+	}})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal gossip message: %v", err)
+	}
+
+	select {
+	case s.gossipSendC <- msg:
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 
-	return &nodev1.InjectGovernanceVAAResponse{Digests: digests}, nil
+	digest := v.SigningDigest()
+	s.logger.Info("signed VAA injected via admin socket",
+		zap.String("message_id", v.MessageID()),
+		zap.String("digest", digest.String()),
+	)
+
+	return &nodev1.InjectSignedVAAResponse{Digest: digest.Bytes()}, nil
 }
 
 // fetchMissing attempts to backfill a gap by fetching and storing missing signed VAAs from the network.
@@ -684,15 +1337,21 @@ func (s *nodePrivilegedService) fetchMissing(
 	addr string,
 	seq uint64) (bool, error) {
 
-	// shuffle the list of public RPC endpoints
+	// shuffle a copy of the list of public RPC endpoints, since it is shared between concurrent backfills
+	nodes = append([]string(nil), nodes...)
 	rand.Shuffle(len(nodes), func(i, j int) {
 		nodes[i], nodes[j] = nodes[j], nodes[i]
 	})
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	ctx, cancel := context.WithTimeout(ctx, backfillFetchTimeout)
 	defer cancel()
 
 	for _, node := range nodes {
+		if s.backfillNodes.skip(node) {
+			s.logger.Debug("skipping misbehaving backfill node", zap.String("node", node))
+			continue
+		}
+
 		req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf(
 			"%s/v1/signed_vaa/%d/%s/%d", node, chain, addr, seq), nil)
 		if err != nil {
@@ -729,6 +1388,7 @@ func (s *nodePrivilegedService) fetchMissing(
 					zap.Uint64("sequence", seq),
 					zap.Error(err),
 				)
+				s.recordBackfillNodeFailure(node)
 				continue
 			}
 
@@ -743,9 +1403,25 @@ func (s *nodePrivilegedService) fetchMissing(
 					zap.Uint64("sequence", seq),
 					zap.Error(err),
 				)
+				s.recordBackfillNodeFailure(node)
 				continue
 			}
 
+			if err := s.verifyBackfilledVAA(vaaBytes, chain, addr, seq); err != nil {
+				resp.Body.Close()
+				s.logger.Warn("backfilled VAA failed verification",
+					zap.String("node", node),
+					zap.String("chain", chain.String()),
+					zap.String("address", addr),
+					zap.Uint64("sequence", seq),
+					zap.Error(err),
+				)
+				s.recordBackfillNodeFailure(node)
+				continue
+			}
+
+			s.backfillNodes.recordSuccess(node)
+
 			s.logger.Info("backfilled VAA",
 				zap.Uint16("chain", uint16(chain)),
 				zap.String("address", addr),
@@ -771,6 +1447,119 @@ func (s *nodePrivilegedService) fetchMissing(
 	return false, nil
 }
 
+// verifyBackfilledVAA checks that a VAA returned by a backfill node is the requested one and is validly signed by the
+// guardian set it claims, so that a node returning bad VAAs is counted as failing. The signatures are not checked if the
+// guardian set is not known, in which case the signed VAA receive path drops the VAA.
+func (s *nodePrivilegedService) verifyBackfilledVAA(b []byte, chain vaa.ChainID, addr string, seq uint64) error {
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal VAA: %w", err)
+	}
+
+	if v.EmitterChain != chain || !strings.EqualFold(v.EmitterAddress.String(), addr) || v.Sequence != seq {
+		return fmt.Errorf("received VAA %s instead of %d/%s/%d", v.MessageID(), chain, addr, seq)
+	}
+
+	if s.gst == nil {
+		return nil
+	}
+	gs, ok := s.gst.GetForIndex(v.GuardianSetIndex)
+	if !ok {
+		return nil
+	}
+	return v.Verify(gs.Keys)
+}
+
+// recordBackfillNodeFailure records a bad response from a backfill node and logs when the node starts being skipped.
+func (s *nodePrivilegedService) recordBackfillNodeFailure(node string) {
+	if s.backfillNodes.recordFailure(node) {
+		s.logger.Warn("backfill node returned bad data too many times, skipping it temporarily",
+			zap.String("node", node),
+			zap.Duration("skipWindow", s.backfillNodes.skipWindow),
+		)
+	}
+}
+
+// backfillMissing attempts to fetch the given sequences from the backfill nodes using at most concurrency parallel workers.
+// It returns the sequences that could not be backfilled, in the order they were passed in.
+func (s *nodePrivilegedService) backfillMissing(
+	ctx context.Context,
+	nodes []string,
+	chain vaa.ChainID,
+	addr string,
+	ids []uint64,
+	concurrency int) ([]uint64, error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c := &http.Client{}
+	filled := make([]bool, len(ids))
+	sem := make(chan struct{}, concurrency)
+
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		firstErr error
+	)
+
+	for idx, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(idx int, id uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			ok, err := s.fetchMissing(ctx, nodes, c, chain, addr, id)
+			if err != nil {
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errMu.Unlock()
+				cancel()
+				return
+			}
+			// Each worker writes to its own index, so no locking is needed.
+			filled[idx] = ok
+		}(idx, id)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	unfilled := make([]uint64, 0, len(ids))
+	for idx, id := range ids {
+		if !filled[idx] {
+			unfilled = append(unfilled, id)
+		}
+	}
+	return unfilled, nil
+}
+
+// backfillConcurrency applies the default and the limit to the requested backfill concurrency.
+func backfillConcurrency(requested uint32) int {
+	if requested == 0 {
+		return defaultBackfillConcurrency
+	}
+	if requested > maxBackfillConcurrency {
+		return maxBackfillConcurrency
+	}
+	return int(requested)
+}
+
 func (s *nodePrivilegedService) FindMissingMessages(ctx context.Context, req *nodev1.FindMissingMessagesRequest) (*nodev1.FindMissingMessagesResponse, error) {
 	b, err := hex.DecodeString(req.EmitterAddress)
 	if err != nil {
@@ -788,22 +1577,25 @@ func (s *nodePrivilegedService) FindMissingMessages(ctx context.Context, req *no
 	}
 
 	if req.RpcBackfill {
-		c := &http.Client{}
-		unfilled := make([]uint64, 0, len(ids))
-		for _, id := range ids {
-			if ok, err := s.fetchMissing(ctx, req.BackfillNodes, c, vaa.ChainID(req.EmitterChain), emitterAddress.String(), id); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to backfill VAA: %v", err)
-			} else if ok {
-				continue
-			}
-			unfilled = append(unfilled, id)
+		concurrency := backfillConcurrency(req.BackfillConcurrency)
+		ids, err = s.backfillMissing(ctx, req.BackfillNodes, vaa.ChainID(req.EmitterChain), emitterAddress.String(), ids, concurrency)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to backfill VAA: %v", err)
 		}
-		ids = unfilled
+	}
+
+	if req.Compact {
+		return &nodev1.FindMissingMessagesResponse{
+			MissingRanges: sequenceRanges(ids),
+			FirstSequence: first,
+			LastSequence:  last,
+		}, nil
 	}
 
 	resp := make([]string, len(ids))
 	for i, v := range ids {
-		resp[i] = fmt.Sprintf("%d/%s/%d", req.EmitterChain, emitterAddress, v)
+		id := db.VAAID{EmitterChain: vaa.ChainID(req.EmitterChain), EmitterAddress: emitterAddress, Sequence: v}
+		resp[i] = id.String()
 	}
 	return &nodev1.FindMissingMessagesResponse{
 		MissingMessages: resp,
@@ -813,6 +1605,18 @@ func (s *nodePrivilegedService) FindMissingMessages(ctx context.Context, req *no
 }
 
 func (s *nodePrivilegedService) SendObservationRequest(ctx context.Context, req *nodev1.SendObservationRequestRequest) (*nodev1.SendObservationRequestResponse, error) {
+	if req.ObservationRequest == nil {
+		return nil, status.Error(codes.InvalidArgument, "no observation request specified")
+	}
+
+	if req.ObservationRequest.ChainId > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id: %d", req.ObservationRequest.ChainId)
+	}
+	chainID := vaa.ChainID(req.ObservationRequest.ChainId)
+	if _, ok := s.watchedChains[chainID]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "chain %s is not watched by this node", chainID)
+	}
+
 	if err := common.PostObservationRequest(s.obsvReqSendC, req.ObservationRequest); err != nil {
 		return nil, err
 	}
@@ -831,12 +1635,23 @@ func (s *nodePrivilegedService) ChainGovernorStatus(ctx context.Context, req *no
 	}, nil
 }
 
+func (s *nodePrivilegedService) ChainGovernorDumpConfig(ctx context.Context, req *nodev1.ChainGovernorDumpConfigRequest) (*nodev1.ChainGovernorDumpConfigResponse, error) {
+	if s.governor == nil {
+		return nil, fmt.Errorf("chain governor is not enabled")
+	}
+
+	return s.governor.DumpConfig(), nil
+}
+
 func (s *nodePrivilegedService) ChainGovernorReload(ctx context.Context, req *nodev1.ChainGovernorReloadRequest) (*nodev1.ChainGovernorReloadResponse, error) {
 	if s.governor == nil {
 		return nil, fmt.Errorf("chain governor is not enabled")
 	}
 
-	resp, err := s.governor.Reload()
+	ctx, cancel := context.WithTimeout(ctx, governorReloadTimeout)
+	defer cancel()
+
+	resp, err := callExclusive(ctx, &s.governorReloading, s.governor.Reload)
 	if err != nil {
 		return nil, err
 	}
@@ -846,16 +1661,65 @@ func (s *nodePrivilegedService) ChainGovernorReload(ctx context.Context, req *no
 	}, nil
 }
 
+// callWithContext runs f and waits for it to complete or for the context to be done, in which case it returns the
+// corresponding gRPC status error. f cannot be interrupted, so it keeps running in the background after a timeout.
+func callWithContext(ctx context.Context, f func() (string, error)) (string, error) {
+	type result struct {
+		resp string
+		err  error
+	}
+
+	// Buffered so that f can complete after we stopped waiting for it.
+	resultC := make(chan result, 1)
+	go func() {
+		resp, err := f()
+		resultC <- result{resp, err}
+	}()
+
+	select {
+	case r := <-resultC:
+		return r.resp, r.err
+	case <-ctx.Done():
+		return "", status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// callExclusive is like callWithContext, but rejects the call with codes.Unavailable while a previous f guarded by the
+// same inFlight flag is still running, including one that kept running after its request timed out.
+func callExclusive(ctx context.Context, inFlight *atomic.Bool, f func() (string, error)) (string, error) {
+	if !inFlight.CompareAndSwap(false, true) {
+		return "", status.Error(codes.Unavailable, "a previous call is still in progress, try again later")
+	}
+	return callWithContext(ctx, func() (string, error) {
+		defer inFlight.Store(false)
+		return f()
+	})
+}
+
+// parseGovernorVaaId validates a VAA id passed to one of the governor methods and returns it in the canonical form used
+// by the governor to identify pending VAAs.
+func parseGovernorVaaId(vaaId string) (string, error) {
+	if len(vaaId) == 0 {
+		return "", fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+	id, err := db.ParseVAAID(vaaId)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
 func (s *nodePrivilegedService) ChainGovernorDropPendingVAA(ctx context.Context, req *nodev1.ChainGovernorDropPendingVAARequest) (*nodev1.ChainGovernorDropPendingVAAResponse, error) {
 	if s.governor == nil {
 		return nil, fmt.Errorf("chain governor is not enabled")
 	}
 
-	if len(req.VaaId) == 0 {
-		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	vaaId, err := parseGovernorVaaId(req.VaaId)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := s.governor.DropPendingVAA(req.VaaId)
+	resp, err := s.governor.DropPendingVAA(vaaId)
 	if err != nil {
 		return nil, err
 	}
@@ -870,11 +1734,12 @@ func (s *nodePrivilegedService) ChainGovernorReleasePendingVAA(ctx context.Conte
 		return nil, fmt.Errorf("chain governor is not enabled")
 	}
 
-	if len(req.VaaId) == 0 {
-		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	vaaId, err := parseGovernorVaaId(req.VaaId)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := s.governor.ReleasePendingVAA(req.VaaId)
+	resp, err := s.governor.ReleasePendingVAA(vaaId)
 	if err != nil {
 		return nil, err
 	}
@@ -889,11 +1754,16 @@ func (s *nodePrivilegedService) ChainGovernorResetReleaseTimer(ctx context.Conte
 		return nil, fmt.Errorf("chain governor is not enabled")
 	}
 
-	if len(req.VaaId) == 0 {
-		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	vaaId, err := parseGovernorVaaId(req.VaaId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ReleaseDelaySeconds > math.MaxInt64/uint64(time.Second) {
+		return nil, fmt.Errorf("release delay of %d seconds is too large", req.ReleaseDelaySeconds)
 	}
 
-	resp, err := s.governor.ResetReleaseTimer(req.VaaId)
+	resp, err := s.governor.ResetReleaseTimer(vaaId, time.Duration(req.ReleaseDelaySeconds)*time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -916,18 +1786,30 @@ func (s *nodePrivilegedService) PurgePythNetVaas(ctx context.Context, req *nodev
 	}, nil
 }
 
+// vaaUnmarshalError converts an error returned by vaa.Unmarshal into a gRPC status so that clients can tell malformed input apart from other failures.
+func vaaUnmarshalError(err error) error {
+	if errors.Is(err, vaa.ErrVAATooShort) || errors.Is(err, vaa.ErrUnsupportedVersion) || errors.Is(err, vaa.ErrBadSignatureCount) {
+		return status.Errorf(codes.InvalidArgument, "failed to unmarshal VAA: %v", err)
+	}
+	return fmt.Errorf("failed to unmarshal VAA: %w", err)
+}
+
 func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1.SignExistingVAARequest) (*nodev1.SignExistingVAAResponse, error) {
+	if err := s.checkSigningAllowed(); err != nil {
+		return nil, err
+	}
+
 	v, err := vaa.Unmarshal(req.Vaa)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal VAA: %w", err)
+		return nil, vaaUnmarshalError(err)
 	}
 
 	if req.NewGuardianSetIndex <= v.GuardianSetIndex {
-		return nil, errors.New("new guardian set index must be higher than provided VAA")
+		return nil, status.Error(codes.InvalidArgument, "new guardian set index must be higher than provided VAA")
 	}
 
 	if s.evmConnector == nil {
-		return nil, errors.New("the node needs to have an Ethereum connection configured to sign existing VAAs")
+		return nil, status.Error(codes.FailedPrecondition, "the node needs to have an Ethereum connection configured to sign existing VAAs")
 	}
 
 	var gs *common.GuardianSet
@@ -935,35 +1817,36 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 		var ok bool
 		gs, ok = cachedGs.(*common.GuardianSet)
 		if !ok {
-			return nil, fmt.Errorf("internal error")
+			return nil, status.Error(codes.Internal, "internal error")
 		}
 	} else {
 		evmGs, err := s.evmConnector.GetGuardianSet(ctx, v.GuardianSetIndex)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load guardian set [%d]: %w", v.GuardianSetIndex, err)
 		}
-		gs = &common.GuardianSet{
-			Keys:  evmGs.Keys,
-			Index: v.GuardianSetIndex,
-		}
+		gs = common.NewGuardianSet(evmGs.Keys, v.GuardianSetIndex)
 		s.gsCache.Store(v.GuardianSetIndex, gs)
 	}
 
-	if slices.Index(gs.Keys, s.guardianAddress) != -1 {
-		return nil, fmt.Errorf("local guardian is already on the old set")
+	if _, found := gs.IndexOf(s.guardianAddress); found {
+		return nil, status.Error(codes.InvalidArgument, "local guardian is already on the old set")
 	}
 
 	// Verify VAA
 	err = v.Verify(gs.Keys)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify existing VAA: %w", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to verify existing VAA: %v", err)
 	}
 
 	if len(req.NewGuardianAddrs) > 255 {
-		return nil, errors.New("new guardian set has too many guardians")
+		return nil, status.Error(codes.InvalidArgument, "new guardian set has too many guardians")
 	}
 	newGS := make([]ethcommon.Address, len(req.NewGuardianAddrs))
 	for i, guardianString := range req.NewGuardianAddrs {
+		// HexToAddress silently maps malformed input to (a prefix of) the zero address, so validate explicitly.
+		if !ethcommon.IsHexAddress(guardianString) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid guardian address at index %d: %q", i, guardianString)
+		}
 		guardianAddress := ethcommon.HexToAddress(guardianString)
 		newGS[i] = guardianAddress
 	}
@@ -975,12 +1858,13 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 	})
 	newGsLen := len(newGSSorted)
 	if len(slices.Compact(newGSSorted)) != newGsLen {
-		return nil, fmt.Errorf("duplicate guardians in the guardian set")
+		return nil, status.Error(codes.InvalidArgument, "duplicate guardians in the guardian set")
 	}
 
-	localGuardianIndex := slices.Index(newGS, s.guardianAddress)
-	if localGuardianIndex == -1 {
-		return nil, fmt.Errorf("local guardian is not a member of the new guardian set")
+	newGuardianSet := common.NewGuardianSet(newGS, req.NewGuardianSetIndex)
+	localGuardianIndex, found := newGuardianSet.IndexOf(s.guardianAddress)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "local guardian is not a member of the new guardian set")
 	}
 
 	newVAA := &vaa.VAA{
@@ -1001,9 +1885,9 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 	// Copy original VAA signatures
 	for _, sig := range v.Signatures {
 		signerAddress := gs.Keys[sig.Index]
-		newIndex := slices.Index(newGS, signerAddress)
+		newIndex, found := newGuardianSet.IndexOf(signerAddress)
 		// Guardian is not part of the new set
-		if newIndex == -1 {
+		if !found {
 			continue
 		}
 		newVAA.Signatures = append(newVAA.Signatures, &vaa.Signature{
@@ -1014,7 +1898,7 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 
 	// Add our own signature only if the new guardian set would reach quorum
 	if vaa.CalculateQuorum(len(newGS)) > len(newVAA.Signatures)+1 {
-		return nil, errors.New("cannot reach quorum on new guardian set with the local signature")
+		return nil, status.Error(codes.InvalidArgument, "cannot reach quorum on new guardian set with the local signature")
 	}
 
 	// Add local signature
@@ -1044,13 +1928,161 @@ func (s *nodePrivilegedService) DumpRPCs(ctx context.Context, req *nodev1.DumpRP
 	}, nil
 }
 
+// RotateNodeKey replaces the p2p node key with a freshly generated one and signals the p2p stack to reconnect with
+// the new identity. This is refused in devnet, where node keys are derived deterministically from the node index.
+func (s *nodePrivilegedService) RotateNodeKey(ctx context.Context, req *nodev1.RotateNodeKeyRequest) (*nodev1.RotateNodeKeyResponse, error) {
+	if s.env == common.UnsafeDevNet {
+		return nil, status.Error(codes.FailedPrecondition, "node key rotation is not supported in devnet, node keys are deterministic")
+	}
+
+	if s.nodeKeyPath == "" {
+		return nil, status.Error(codes.FailedPrecondition, "no node key path configured")
+	}
+
+	newKey, err := common.RotateNodeKey(s.logger, s.nodeKeyPath)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	peerID, err := peer.IDFromPrivateKey(newKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	select {
+	case s.nodeKeyRotateC <- newKey:
+	case <-ctx.Done():
+		return nil, status.Error(codes.Canceled, "node key was persisted but p2p was not restarted, restart the guardian to apply it")
+	}
+
+	s.logger.Info("node key rotated, restarting p2p", zap.Stringer("peerID", peerID))
+
+	return &nodev1.RotateNodeKeyResponse{
+		PeerId: peerID.String(),
+	}, nil
+}
+
+func (s *nodePrivilegedService) GetNodeVersion(ctx context.Context, req *nodev1.GetNodeVersionRequest) (*nodev1.GetNodeVersionResponse, error) {
+	return &nodev1.GetNodeVersionResponse{
+		Version:   version.Version(),
+		GitCommit: version.GitCommit(),
+		GoVersion: runtime.Version(),
+		DevBuild:  s.devBuild,
+	}, nil
+}
+
+// DatabaseStats returns the number of VAAs stored for each emitter, to help operators plan retention.
+func (s *nodePrivilegedService) DatabaseStats(ctx context.Context, req *nodev1.DatabaseStatsRequest) (*nodev1.DatabaseStatsResponse, error) {
+	if s.db == nil {
+		return nil, status.Error(codes.FailedPrecondition, "database is not available")
+	}
+
+	counts, err := s.db.CountVAAsByEmitter()
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to count VAAs: %v", err))
+	}
+
+	var total uint64
+	for _, count := range counts {
+		total += count
+	}
+
+	return &nodev1.DatabaseStatsResponse{
+		VaaCountsByEmitter: counts,
+		TotalVaas:          total,
+	}, nil
+}
+
+// GetProcessorStats returns the aggregation state stats last published by the processor.
+func (s *nodePrivilegedService) GetProcessorStats(ctx context.Context, req *nodev1.GetProcessorStatsRequest) (*nodev1.GetProcessorStatsResponse, error) {
+	if s.aggStats == nil {
+		return nil, status.Error(codes.FailedPrecondition, "processor stats are not available")
+	}
+
+	stats := s.aggStats.Get()
+	if stats.Timestamp.IsZero() {
+		return nil, status.Error(codes.Unavailable, "processor stats have not been computed yet")
+	}
+
+	return &nodev1.GetProcessorStatsResponse{
+		Observations:         uint64(stats.Observations),
+		PendingObservations:  uint64(stats.PendingObservations),
+		Signatures:           uint64(stats.Signatures),
+		EstimatedMemoryBytes: stats.EstimatedMemoryBytes,
+		Timestamp:            stats.Timestamp.Unix(),
+	}, nil
+}
+
+// PlanRecovery finds the sequence gaps of an emitter and bounds the backfill time, without backfilling anything.
+func (s *nodePrivilegedService) PlanRecovery(ctx context.Context, req *nodev1.PlanRecoveryRequest) (*nodev1.PlanRecoveryResponse, error) {
+	if s.db == nil {
+		return nil, status.Error(codes.FailedPrecondition, "database is not available")
+	}
+
+	if req.EmitterChain > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid emitter chain: %d does not fit in a uint16", req.EmitterChain)
+	}
+	if len(req.EmitterAddress) != 2*len(vaa.Address{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid emitter address: must be %d hex characters, got %d", 2*len(vaa.Address{}), len(req.EmitterAddress))
+	}
+	b, err := hex.DecodeString(req.EmitterAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid emitter address encoding: %v", err)
+	}
+	emitterAddress := vaa.Address{}
+	copy(emitterAddress[:], b)
+
+	ids, first, last, err := s.db.FindEmitterSequenceGap(db.VAAID{
+		EmitterChain:   vaa.ChainID(req.EmitterChain),
+		EmitterAddress: emitterAddress,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database operation failed: %v", err)
+	}
+
+	concurrency := backfillConcurrency(req.BackfillConcurrency)
+
+	// Each worker fetches one message at a time and every fetch is bounded by backfillFetchTimeout, so this is the worst
+	// case. Without backfill nodes nothing would be fetched, so there is no bound to report.
+	var maxBackfill time.Duration
+	if len(req.BackfillNodes) != 0 {
+		batches := (len(ids) + concurrency - 1) / concurrency
+		maxBackfill = time.Duration(batches) * backfillFetchTimeout
+	}
+
+	return &nodev1.PlanRecoveryResponse{
+		FirstSequence:       first,
+		LastSequence:        last,
+		MissingCount:        uint64(len(ids)),
+		MissingRanges:       sequenceRanges(ids),
+		BackfillConcurrency: uint32(concurrency),
+		MaxBackfillSeconds:  uint64(maxBackfill / time.Second),
+	}, nil
+}
+
+// sequenceRanges groups ascending sequence numbers into contiguous ranges.
+func sequenceRanges(ids []uint64) []*nodev1.SequenceRange {
+	ranges := []*nodev1.SequenceRange{}
+	for _, id := range ids {
+		if n := len(ranges); n > 0 && ranges[n-1].Last+1 == id {
+			ranges[n-1].Last = id
+			continue
+		}
+		ranges = append(ranges, &nodev1.SequenceRange{First: id, Last: id})
+	}
+	return ranges
+}
+
 func (s *nodePrivilegedService) GetAndObserveMissingVAAs(ctx context.Context, req *nodev1.GetAndObserveMissingVAAsRequest) (*nodev1.GetAndObserveMissingVAAsResponse, error) {
 	// Get URL and API key from the command line
 	url := req.GetUrl()
 	apiKey := req.GetApiKey()
 
-	// Create the body of the request
+	// Create the body of the request. The API key goes in a header instead if the endpoint expects a bearer token.
 	jsonBody := []byte(`{"apiKey": "` + apiKey + `"}`)
+	if req.GetApiKeyAsBearerToken() {
+		jsonBody = []byte(`{}`)
+	}
 	jsonBodyReader := bytes.NewReader(jsonBody)
 
 	// Create the actual request
@@ -1061,6 +2093,13 @@ func (s *nodePrivilegedService) GetAndObserveMissingVAAs(ctx context.Context, re
 	}
 
 	httpRequest.Header.Set("Content-Type", "application/json")
+	if req.GetApiKeyAsBearerToken() {
+		httpRequest.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+	// Headers configured by the operator are applied last, so they can override the defaults above.
+	for key, value := range req.GetHeaders() {
+		httpRequest.Header.Set(key, value)
+	}
 
 	client := http.Client{
 		Timeout: 30 * time.Second,
@@ -1107,21 +2146,12 @@ func (s *nodePrivilegedService) GetAndObserveMissingVAAs(ctx context.Context, re
 	for i := 0; i < processingLen; i++ {
 		missingVAA := missingVAAs[i]
 		// First check to see if this VAA has already been signed
-		// Convert vaaKey to VAAID
-		splits := strings.Split(missingVAA.VaaKey, "/")
-		chainID, err := strconv.Atoi(splits[0])
-		if err != nil {
-			errMsgs += fmt.Sprintf("\nerror converting chainID [%s] to int", missingVAA.VaaKey)
-			errCounter++
-			continue
-		}
-		sequence, err := strconv.ParseUint(splits[2], 10, 64)
+		vaaKey, err := db.ParseVAAID(missingVAA.VaaKey)
 		if err != nil {
-			errMsgs += fmt.Sprintf("\nerror converting sequence %s to uint64", splits[2])
+			errMsgs += fmt.Sprintf("\nerror parsing VAA key [%s]: %v", missingVAA.VaaKey, err)
 			errCounter++
 			continue
 		}
-		vaaKey := db.VAAID{EmitterChain: vaa.ChainID(chainID), EmitterAddress: vaa.Address([]byte(splits[1])), Sequence: sequence}
 		hasVaa, err := s.db.HasVAA(vaaKey)
 		if err != nil || hasVaa {
 			errMsgs += fmt.Sprintf("\nerror checking for VAA %s", missingVAA.VaaKey)
@@ -4,10 +4,28 @@ package adminrpc
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	gcommon "github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
 	ethereum "github.com/ethereum/go-ethereum"
@@ -17,9 +35,16 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/event"
 	ethRpc "github.com/ethereum/go-ethereum/rpc"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type mockEVMConnector struct {
@@ -158,6 +183,7 @@ func TestSignExistingVAA_NoVAA(t *testing.T) {
 		NewGuardianSetIndex: 0,
 	})
 	require.ErrorContains(t, err, "failed to unmarshal VAA")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_NotGuardian(t *testing.T) {
@@ -172,6 +198,7 @@ func TestSignExistingVAA_NotGuardian(t *testing.T) {
 		NewGuardianSetIndex: 1,
 	})
 	require.ErrorContains(t, err, "local guardian is not a member of the new guardian set")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_InvalidVAA(t *testing.T) {
@@ -187,6 +214,7 @@ func TestSignExistingVAA_InvalidVAA(t *testing.T) {
 		NewGuardianSetIndex: 1,
 	})
 	require.ErrorContains(t, err, "failed to verify existing VAA")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_DuplicateGuardian(t *testing.T) {
@@ -203,6 +231,41 @@ func TestSignExistingVAA_DuplicateGuardian(t *testing.T) {
 		NewGuardianSetIndex: 1,
 	})
 	require.ErrorContains(t, err, "duplicate guardians in the guardian set")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignExistingVAA_MalformedGuardianAddress(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(5)
+	s := setupAdminServerForVAASigning(0, gsAddrs)
+
+	v := generateMockVAA(0, gsKeys)
+
+	newAddrs := addrsToHexStrings(append(gsAddrs, s.guardianAddress))
+	newAddrs = append(newAddrs, "0xnot-a-guardian")
+	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
+		Vaa:                 v,
+		NewGuardianAddrs:    newAddrs,
+		NewGuardianSetIndex: 1,
+	})
+	require.ErrorContains(t, err, "invalid guardian address at index 6")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignExistingVAA_DuplicateGuardianMixedCase(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(5)
+	s := setupAdminServerForVAASigning(0, gsAddrs)
+
+	v := generateMockVAA(0, gsKeys)
+
+	newAddrs := addrsToHexStrings(append(gsAddrs, s.guardianAddress))
+	newAddrs = append(newAddrs, "0x"+strings.ToUpper(s.guardianAddress.Hex()[2:]))
+	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
+		Vaa:                 v,
+		NewGuardianAddrs:    newAddrs,
+		NewGuardianSetIndex: 1,
+	})
+	require.ErrorContains(t, err, "duplicate guardians in the guardian set")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_AlreadyGuardian(t *testing.T) {
@@ -222,6 +285,7 @@ func TestSignExistingVAA_AlreadyGuardian(t *testing.T) {
 		NewGuardianSetIndex: 1,
 	})
 	require.ErrorContains(t, err, "local guardian is already on the old set")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_NotAFutureGuardian(t *testing.T) {
@@ -236,6 +300,7 @@ func TestSignExistingVAA_NotAFutureGuardian(t *testing.T) {
 		NewGuardianSetIndex: 1,
 	})
 	require.ErrorContains(t, err, "local guardian is not a member of the new guardian set")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_CantReachQuorum(t *testing.T) {
@@ -251,6 +316,7 @@ func TestSignExistingVAA_CantReachQuorum(t *testing.T) {
 		NewGuardianSetIndex: 1,
 	})
 	require.ErrorContains(t, err, "cannot reach quorum on new guardian set with the local signature")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestSignExistingVAA_Valid(t *testing.T) {
@@ -270,3 +336,1539 @@ func TestSignExistingVAA_Valid(t *testing.T) {
 	v2 := generateMockVAA(1, append(gsKeys, s.gk))
 	require.Equal(t, v2, res.Vaa)
 }
+
+func TestSignExistingVAA_ObserverMode(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(5)
+	s := setupAdminServerForVAASigning(0, gsAddrs)
+	s.observerMode = true
+
+	v := generateMockVAA(0, gsKeys)
+
+	gsAddrs = append(gsAddrs, s.guardianAddress)
+	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
+		Vaa:                 v,
+		NewGuardianAddrs:    addrsToHexStrings(gsAddrs),
+		NewGuardianSetIndex: 1,
+	})
+	require.ErrorContains(t, err, "not supported in observer mode")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func guardianSetUpdateRequest(numGuardians int, override bool) *nodev1.InjectGovernanceVAARequest {
+	_, addrs := generateGS(numGuardians)
+	guardians := make([]*nodev1.GuardianSetUpdate_Guardian, numGuardians)
+	for i, addr := range addrs {
+		guardians[i] = &nodev1.GuardianSetUpdate_Guardian{Pubkey: addr.Hex()}
+	}
+
+	return &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: 0,
+		Timestamp:       uint32(time.Now().Unix()),
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: 1,
+				Nonce:    1,
+				Payload: &nodev1.GovernanceMessage_GuardianSet{
+					GuardianSet: &nodev1.GuardianSetUpdate{
+						Guardians:       guardians,
+						OverrideSoftMax: override,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestInjectGovernanceVAA_GuardianSetSoftMax(t *testing.T) {
+	injectC := make(chan *gcommon.MessagePublication, 1)
+	s := &nodePrivilegedService{
+		injectC:            injectC,
+		logger:             zap.NewNop(),
+		guardianSetSoftMax: 5,
+	}
+
+	_, err := s.InjectGovernanceVAA(context.Background(), guardianSetUpdateRequest(6, false))
+	require.ErrorContains(t, err, "configured soft maximum is 5")
+	require.Equal(t, 0, len(injectC))
+
+	res, err := s.InjectGovernanceVAA(context.Background(), guardianSetUpdateRequest(5, false))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Digests))
+	<-injectC
+}
+
+func TestInjectGovernanceVAA_GuardianSetSoftMaxOverride(t *testing.T) {
+	injectC := make(chan *gcommon.MessagePublication, 1)
+	s := &nodePrivilegedService{
+		injectC:            injectC,
+		logger:             zap.NewNop(),
+		guardianSetSoftMax: 5,
+	}
+
+	res, err := s.InjectGovernanceVAA(context.Background(), guardianSetUpdateRequest(6, true))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Digests))
+	require.Equal(t, 1, len(injectC))
+
+	// The hard limit still applies when overriding.
+	_, err = s.InjectGovernanceVAA(context.Background(), guardianSetUpdateRequest(gcommon.MaxGuardianCount+1, true))
+	require.ErrorContains(t, err, "too many guardians")
+}
+
+func getInjectionCount(action string) float64 {
+	var m = &dto.Metric{}
+	if err := vaaInjectionsTotal.WithLabelValues(action).Write(m); err != nil {
+		return 0
+	}
+	return m.Counter.GetValue()
+}
+
+func TestInjectGovernanceVAA_MetricsByAction(t *testing.T) {
+	injectC := make(chan *gcommon.MessagePublication, 1)
+	s := &nodePrivilegedService{
+		injectC: injectC,
+		logger:  zap.NewNop(),
+	}
+
+	before := getInjectionCount("contract_upgrade")
+	beforeGS := getInjectionCount("guardian_set_update")
+
+	_, err := s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: 0,
+		Timestamp:       uint32(time.Now().Unix()),
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: 1,
+				Nonce:    1,
+				Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+					ContractUpgrade: &nodev1.ContractUpgrade{
+						ChainId:     uint32(vaa.ChainIDSolana),
+						NewContract: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(injectC))
+
+	require.Equal(t, before+1, getInjectionCount("contract_upgrade"))
+	require.Equal(t, beforeGS, getInjectionCount("guardian_set_update"))
+}
+
+func TestRotateNodeKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.key")
+	oldKey, err := gcommon.GetOrCreateNodeKey(zap.NewNop(), path)
+	require.NoError(t, err)
+
+	rotateC := make(chan p2pcrypto.PrivKey, 1)
+	s := &nodePrivilegedService{
+		logger:         zap.NewNop(),
+		env:            gcommon.GoTest,
+		nodeKeyPath:    path,
+		nodeKeyRotateC: rotateC,
+	}
+
+	resp, err := s.RotateNodeKey(context.Background(), &nodev1.RotateNodeKeyRequest{})
+	require.NoError(t, err)
+
+	newKey := <-rotateC
+	require.False(t, oldKey.Equals(newKey))
+
+	peerID, err := peer.IDFromPrivateKey(newKey)
+	require.NoError(t, err)
+	require.Equal(t, peerID.String(), resp.PeerId)
+
+	persistedKey, err := gcommon.GetOrCreateNodeKey(zap.NewNop(), path)
+	require.NoError(t, err)
+	require.True(t, newKey.Equals(persistedKey))
+}
+
+func TestRotateNodeKey_RefusedInDevnet(t *testing.T) {
+	s := &nodePrivilegedService{
+		logger:         zap.NewNop(),
+		env:            gcommon.UnsafeDevNet,
+		nodeKeyPath:    filepath.Join(t.TempDir(), "node.key"),
+		nodeKeyRotateC: make(chan p2pcrypto.PrivKey, 1),
+	}
+
+	_, err := s.RotateNodeKey(context.Background(), &nodev1.RotateNodeKeyRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestInjectGovernanceVAA_RequestLabelLogged(t *testing.T) {
+	observedZapCore, observedLogs := observer.New(zap.InfoLevel)
+	injectC := make(chan *gcommon.MessagePublication, 1)
+	s := &nodePrivilegedService{
+		injectC: injectC,
+		logger:  zap.New(observedZapCore),
+	}
+
+	_, err := s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: 0,
+		Timestamp:       uint32(time.Now().Unix()),
+		RequestLabel:    "ceremony-42",
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: 1234,
+				Nonce:    5678,
+				Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+					ContractUpgrade: &nodev1.ContractUpgrade{
+						ChainId:     uint32(vaa.ChainIDSolana),
+						NewContract: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	entries := observedLogs.All()
+	require.NotEmpty(t, entries)
+	for _, entry := range entries {
+		require.Equal(t, "ceremony-42", entry.ContextMap()["requestLabel"], entry.Message)
+	}
+
+	constructed := observedLogs.FilterMessage("governance VAA constructed").All()
+	require.Equal(t, 1, len(constructed))
+	require.Equal(t, uint32(5678), constructed[0].ContextMap()["nonce"])
+	require.Equal(t, uint64(1234), constructed[0].ContextMap()["sequence"])
+}
+
+func TestGetNodeVersion(t *testing.T) {
+	s := &nodePrivilegedService{
+		logger:   zap.NewNop(),
+		devBuild: true,
+	}
+
+	resp, err := s.GetNodeVersion(context.Background(), &nodev1.GetNodeVersionRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Version)
+	require.NotEmpty(t, resp.GitCommit)
+	require.Equal(t, runtime.Version(), resp.GoVersion)
+	require.True(t, resp.DevBuild)
+}
+
+func TestDatabaseStats(t *testing.T) {
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+	_, err := s.DatabaseStats(context.Background(), &nodev1.DatabaseStatsRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close() })
+	s.db = database
+
+	signer, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	for i, chain := range []vaa.ChainID{vaa.ChainIDSolana, vaa.ChainIDSolana, vaa.ChainIDEthereum} {
+		v := &vaa.VAA{
+			Version:        vaa.SupportedVAAVersion,
+			Timestamp:      time.Unix(1700000000, 0),
+			EmitterChain:   chain,
+			EmitterAddress: vaa.Address{1},
+			Sequence:       uint64(i),
+		}
+		v.AddSignature(signer, 0)
+		require.NoError(t, database.StoreSignedVAA(v))
+	}
+
+	resp, err := s.DatabaseStats(context.Background(), &nodev1.DatabaseStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.TotalVaas)
+	require.Equal(t, map[string]uint64{
+		fmt.Sprintf("%d/%s", vaa.ChainIDSolana, vaa.Address{1}):   2,
+		fmt.Sprintf("%d/%s", vaa.ChainIDEthereum, vaa.Address{1}): 1,
+	}, resp.VaaCountsByEmitter)
+}
+
+func TestGetProcessorStats(t *testing.T) {
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+	_, err := s.GetProcessorStats(context.Background(), &nodev1.GetProcessorStatsRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	s.aggStats = processor.NewAggregationStatsState()
+	_, err = s.GetProcessorStats(context.Background(), &nodev1.GetProcessorStatsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	now := time.Unix(1700000000, 0)
+	s.aggStats.Set(processor.AggregationStats{
+		Observations:         3,
+		PendingObservations:  2,
+		Signatures:           6,
+		EstimatedMemoryBytes: 4096,
+		Timestamp:            now,
+	})
+
+	resp, err := s.GetProcessorStats(context.Background(), &nodev1.GetProcessorStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Observations)
+	require.Equal(t, uint64(2), resp.PendingObservations)
+	require.Equal(t, uint64(6), resp.Signatures)
+	require.Equal(t, uint64(4096), resp.EstimatedMemoryBytes)
+	require.Equal(t, now.Unix(), resp.Timestamp)
+}
+
+func setupAdminServerForSignedVAAInjection(t *testing.T, gsIndex uint32, gsAddrs []common.Address) (*nodePrivilegedService, chan []byte) {
+	t.Helper()
+
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close() })
+
+	gst := gcommon.NewGuardianSetState(nil)
+	gst.Set(&gcommon.GuardianSet{Keys: gsAddrs, Index: gsIndex})
+
+	gossipSendC := make(chan []byte, 1)
+	return &nodePrivilegedService{
+		db:          database,
+		logger:      zap.NewNop(),
+		gossipSendC: gossipSendC,
+		gst:         gst,
+	}, gossipSendC
+}
+
+func TestInjectSignedVAA_Valid(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(5)
+	s, gossipSendC := setupAdminServerForSignedVAAInjection(t, 0, gsAddrs)
+
+	vBytes := generateMockVAA(0, gsKeys)
+	resp, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: vBytes})
+	require.NoError(t, err)
+
+	v, err := vaa.Unmarshal(vBytes)
+	require.NoError(t, err)
+	require.Equal(t, v.SigningDigest().Bytes(), resp.Digest)
+
+	// The VAA should be stored...
+	stored, err := s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	require.NoError(t, err)
+	require.Equal(t, vBytes, stored)
+
+	// ...and broadcast to the network as a signed VAA with quorum.
+	require.Equal(t, 1, len(gossipSendC))
+	var msg gossipv1.GossipMessage
+	require.NoError(t, proto.Unmarshal(<-gossipSendC, &msg))
+	require.Equal(t, vBytes, msg.GetSignedVaaWithQuorum().GetVaa())
+}
+
+func TestInjectSignedVAA_InvalidSignature(t *testing.T) {
+	_, gsAddrs := generateGS(5)
+	s, gossipSendC := setupAdminServerForSignedVAAInjection(t, 0, gsAddrs)
+
+	// Signed by keys that are not part of the guardian set.
+	otherKeys, _ := generateGS(5)
+	vBytes := generateMockVAA(0, otherKeys)
+	_, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: vBytes})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, err, "failed to verify VAA")
+
+	v, err := vaa.Unmarshal(vBytes)
+	require.NoError(t, err)
+	_, err = s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	require.ErrorIs(t, err, db.ErrVAANotFound)
+	require.Equal(t, 0, len(gossipSendC))
+}
+
+func TestInjectSignedVAA_NoDatabase(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(5)
+	s, gossipSendC := setupAdminServerForSignedVAAInjection(t, 0, gsAddrs)
+	s.db = nil
+
+	_, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: generateMockVAA(0, gsKeys)})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, 0, len(gossipSendC))
+}
+
+func TestInjectSignedVAA_NoGuardianSet(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(5)
+	s, gossipSendC := setupAdminServerForSignedVAAInjection(t, 0, gsAddrs)
+	vBytes := generateMockVAA(0, gsKeys)
+
+	s.gst = nil
+	_, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: vBytes})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// A guardian set state without a guardian set is rejected the same way.
+	s.gst = gcommon.NewGuardianSetState(nil)
+	_, err = s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: vBytes})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	v, err := vaa.Unmarshal(vBytes)
+	require.NoError(t, err)
+	_, err = s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	require.ErrorIs(t, err, db.ErrVAANotFound)
+	require.Equal(t, 0, len(gossipSendC))
+}
+
+func TestSendObservationRequest_WatchedChains(t *testing.T) {
+	obsvReqSendC := make(chan *gossipv1.ObservationRequest, 1)
+	s := &nodePrivilegedService{
+		obsvReqSendC:  obsvReqSendC,
+		logger:        zap.NewNop(),
+		watchedChains: map[vaa.ChainID]struct{}{vaa.ChainIDSolana: {}},
+	}
+
+	_, err := s.SendObservationRequest(context.Background(), &nodev1.SendObservationRequestRequest{
+		ObservationRequest: &gossipv1.ObservationRequest{ChainId: uint32(vaa.ChainIDEthereum), TxHash: []byte{1, 2, 3}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, err, "is not watched by this node")
+	require.Equal(t, 0, len(obsvReqSendC))
+
+	_, err = s.SendObservationRequest(context.Background(), &nodev1.SendObservationRequestRequest{
+		ObservationRequest: &gossipv1.ObservationRequest{ChainId: uint32(vaa.ChainIDSolana), TxHash: []byte{1, 2, 3}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(obsvReqSendC))
+}
+
+// backfillVAAForTest returns a marshalled VAA with the given ID signed by key, as served by a backfill node.
+func backfillVAAForTest(t *testing.T, key *ecdsa.PrivateKey, chain vaa.ChainID, addr string, seq uint64) []byte {
+	t.Helper()
+	emitter, err := vaa.StringToAddress(addr)
+	require.NoError(t, err)
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Timestamp:        time.Unix(1700000000, 0),
+		EmitterChain:     chain,
+		EmitterAddress:   emitter,
+		Sequence:         seq,
+		ConsistencyLevel: 32,
+		Payload:          []byte{byte(seq)},
+	}
+	v.AddSignature(key, 0)
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func TestBackfillMissing_BoundedConcurrency(t *testing.T) {
+	const concurrency = 3
+	const numGaps = 40
+
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cur := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			prev := maxInFlight.Load()
+			if cur <= prev || maxInFlight.CompareAndSwap(prev, cur) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		var chain, seq uint64
+		var addr string
+		if _, err := fmt.Sscanf(strings.ReplaceAll(r.URL.Path, "/", " "), " v1 signed_vaa %d %s %d", &chain, &addr, &seq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Only even sequences are available.
+		if seq%2 != 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"vaaBytes": %q}`, base64.StdEncoding.EncodeToString(backfillVAAForTest(t, key, vaa.ChainID(chain), addr, seq)))
+	}))
+	defer srv.Close()
+
+	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, numGaps)
+	s := &nodePrivilegedService{
+		logger:    zap.NewNop(),
+		signedInC: signedInC,
+	}
+
+	ids := make([]uint64, numGaps)
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+
+	unfilled, err := s.backfillMissing(context.Background(), []string{srv.URL}, vaa.ChainIDSolana, "0000000000000000000000000000000000000000000000000000000000000004", ids, concurrency)
+	require.NoError(t, err)
+
+	expected := []uint64{}
+	for i := uint64(1); i < numGaps; i += 2 {
+		expected = append(expected, i)
+	}
+	require.Equal(t, expected, unfilled)
+	require.Equal(t, numGaps/2, len(signedInC))
+	require.LessOrEqual(t, maxInFlight.Load(), int32(concurrency))
+	require.Greater(t, maxInFlight.Load(), int32(1))
+}
+
+func TestFetchMissing_SkipsMisbehavingNode(t *testing.T) {
+	var badRequests, goodRequests atomic.Int32
+	badSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		badRequests.Add(1)
+		_, _ = fmt.Fprint(w, `{"vaaBytes": "not base64!"}`)
+	}))
+	defer badSrv.Close()
+	goodSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		goodRequests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer goodSrv.Close()
+
+	now := time.Now()
+	tracker := newBackfillNodeTracker(backfillNodeMaxFailures, backfillNodeSkipWindow)
+	tracker.now = func() time.Time { return now }
+
+	guardianKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	gst := gcommon.NewGuardianSetState(nil)
+	gst.Set(gcommon.NewGuardianSet([]common.Address{ethcrypto.PubkeyToAddress(guardianKey.PublicKey)}, 0))
+
+	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 1)
+	s := &nodePrivilegedService{
+		logger:        zap.NewNop(),
+		signedInC:     signedInC,
+		backfillNodes: tracker,
+		gst:           gst,
+	}
+
+	nodes := []string{badSrv.URL, goodSrv.URL}
+	addr := "0000000000000000000000000000000000000000000000000000000000000004"
+
+	// The bad node is queried until it has failed backfillNodeMaxFailures times.
+	for i := 0; i < backfillNodeMaxFailures; i++ {
+		ok, err := s.fetchMissing(context.Background(), nodes, &http.Client{}, vaa.ChainIDSolana, addr, uint64(i))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+	require.Equal(t, int32(backfillNodeMaxFailures), badRequests.Load())
+
+	// After that it is skipped, but the good node is still queried.
+	ok, err := s.fetchMissing(context.Background(), nodes, &http.Client{}, vaa.ChainIDSolana, addr, 10)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, int32(backfillNodeMaxFailures), badRequests.Load())
+	require.Equal(t, int32(backfillNodeMaxFailures+1), goodRequests.Load())
+
+	// Once the skip window has passed, the bad node is queried again.
+	now = now.Add(backfillNodeSkipWindow)
+	_, err = s.fetchMissing(context.Background(), nodes, &http.Client{}, vaa.ChainIDSolana, addr, 11)
+	require.NoError(t, err)
+	require.Equal(t, int32(backfillNodeMaxFailures+1), badRequests.Load())
+
+	// A node that returns well-formed VAAs that are not signed by the guardian set is skipped as well.
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	var badlySignedRequests atomic.Int32
+	badlySignedSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		badlySignedRequests.Add(1)
+		seq, err := strconv.ParseUint(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"vaaBytes": %q}`, base64.StdEncoding.EncodeToString(backfillVAAForTest(t, otherKey, vaa.ChainIDSolana, addr, seq)))
+	}))
+	defer badlySignedSrv.Close()
+
+	nodes = []string{badlySignedSrv.URL}
+	for i := 0; i < backfillNodeMaxFailures; i++ {
+		ok, err := s.fetchMissing(context.Background(), nodes, &http.Client{}, vaa.ChainIDSolana, addr, uint64(20+i))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+	ok, err = s.fetchMissing(context.Background(), nodes, &http.Client{}, vaa.ChainIDSolana, addr, 30)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, int32(backfillNodeMaxFailures), badlySignedRequests.Load())
+	require.Equal(t, 0, len(signedInC))
+
+	// A correctly signed VAA is accepted.
+	goodSignedSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"vaaBytes": %q}`, base64.StdEncoding.EncodeToString(backfillVAAForTest(t, guardianKey, vaa.ChainIDSolana, addr, 31)))
+	}))
+	defer goodSignedSrv.Close()
+	ok, err = s.fetchMissing(context.Background(), []string{goodSignedSrv.URL}, &http.Client{}, vaa.ChainIDSolana, addr, 31)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, len(signedInC))
+}
+
+func TestInjectGovernanceVAA_ChannelFull(t *testing.T) {
+	// The channel is already full, so the injection cannot be accepted.
+	injectC := make(chan *gcommon.MessagePublication, 1)
+	injectC <- &gcommon.MessagePublication{}
+	s := &nodePrivilegedService{
+		injectC: injectC,
+		logger:  zap.NewNop(),
+	}
+
+	var m = &dto.Metric{}
+	require.NoError(t, governanceInjectChannelFull.Write(m))
+	before := m.Counter.GetValue()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := s.InjectGovernanceVAA(ctx, &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: 0,
+		Timestamp:       uint32(time.Now().Unix()),
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: 1,
+				Nonce:    1,
+				Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+					ContractUpgrade: &nodev1.ContractUpgrade{
+						ChainId:     uint32(vaa.ChainIDSolana),
+						NewContract: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16",
+					},
+				},
+			},
+		},
+	})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.ErrorContains(t, err, ErrInjectChannelFull.Error())
+	require.Equal(t, 1, len(injectC))
+
+	m = &dto.Metric{}
+	require.NoError(t, governanceInjectChannelFull.Write(m))
+	require.Equal(t, before+1, m.Counter.GetValue())
+}
+
+func TestInjectGovernanceVAA_AssignsSequence(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	injectC := make(chan *gcommon.MessagePublication, 10)
+	s := &nodePrivilegedService{
+		db:      database,
+		injectC: injectC,
+		logger:  zap.NewNop(),
+	}
+
+	request := func(auto bool, sequences ...uint64) *nodev1.InjectGovernanceVAARequest {
+		req := &nodev1.InjectGovernanceVAARequest{
+			CurrentSetIndex:    0,
+			Timestamp:          uint32(time.Now().Unix()),
+			AutoAssignSequence: auto,
+		}
+		for _, sequence := range sequences {
+			req.Messages = append(req.Messages, &nodev1.GovernanceMessage{
+				Sequence: sequence,
+				Nonce:    1,
+				Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+					ContractUpgrade: &nodev1.ContractUpgrade{
+						ChainId:     uint32(vaa.ChainIDSolana),
+						NewContract: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16",
+					},
+				},
+			})
+		}
+		return req
+	}
+
+	inject := func(auto bool, sequences ...uint64) *nodev1.InjectGovernanceVAAResponse {
+		t.Helper()
+		resp, err := s.InjectGovernanceVAA(context.Background(), request(auto, sequences...))
+		require.NoError(t, err)
+		require.Equal(t, len(sequences), len(resp.Sequences))
+		return resp
+	}
+
+	first := inject(true, 0)
+	second := inject(true, 0)
+	require.Greater(t, second.Sequences[0], first.Sequences[0])
+	require.NotEqual(t, first.Digests[0], second.Digests[0])
+
+	// Without auto_assign_sequence, sequence zero is used as is.
+	zero := inject(false, 0)
+	require.Equal(t, uint64(0), zero.Sequences[0])
+
+	// The next assigned sequence is above an explicitly specified one.
+	explicit := inject(false, 1000)
+	require.Equal(t, uint64(1000), explicit.Sequences[0])
+	third := inject(true, 0)
+	require.Equal(t, uint64(1001), third.Sequences[0])
+
+	// A batch is assigned consecutive sequences.
+	batch := inject(true, 0, 0)
+	require.Equal(t, []uint64{1002, 1003}, batch.Sequences)
+
+	require.Equal(t, 7, len(injectC))
+	msg := <-injectC
+	require.Equal(t, first.Sequences[0], msg.Sequence)
+	msg = <-injectC
+	require.Equal(t, second.Sequences[0], msg.Sequence)
+	for len(injectC) > 0 {
+		<-injectC
+	}
+
+	// Messages must not specify a sequence if auto_assign_sequence is set.
+	_, err = s.InjectGovernanceVAA(context.Background(), request(true, 0, 5))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A message rejected later in the batch does not use up any sequence.
+	req := request(true, 0, 0)
+	req.Messages[1].Payload = &nodev1.GovernanceMessage_ContractUpgrade{
+		ContractUpgrade: &nodev1.ContractUpgrade{ChainId: uint32(vaa.ChainIDSolana), NewContract: "invalid"},
+	}
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, 0, len(injectC))
+
+	next := inject(true, 0)
+	require.Equal(t, uint64(1004), next.Sequences[0])
+}
+
+func TestInjectGovernanceVAA_SequenceCollision(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	// A governance VAA with sequence 1000 was already produced.
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	existing := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Timestamp:        time.Unix(0, 0),
+		EmitterChain:     vaa.GovernanceChain,
+		EmitterAddress:   vaa.GovernanceEmitter,
+		Sequence:         1000,
+		ConsistencyLevel: 32,
+		Payload:          []byte{1},
+	}
+	existing.AddSignature(key, 0)
+	require.NoError(t, database.StoreSignedVAA(existing))
+
+	injectC := make(chan *gcommon.MessagePublication, 10)
+	s := &nodePrivilegedService{
+		db:      database,
+		injectC: injectC,
+		logger:  zap.NewNop(),
+	}
+
+	req := &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: 0,
+		Timestamp:       uint32(time.Now().Unix()),
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: 1000,
+				Nonce:    1,
+				Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+					ContractUpgrade: &nodev1.ContractUpgrade{
+						ChainId:     uint32(vaa.ChainIDSolana),
+						NewContract: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16",
+					},
+				},
+			},
+		},
+	}
+
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "sequence 1000 already exists")
+	require.Equal(t, 0, len(injectC))
+
+	// A collision on a later message prevents the earlier ones from being injected.
+	req.Messages = append([]*nodev1.GovernanceMessage{
+		{
+			Sequence: 999,
+			Nonce:    1,
+			Payload:  req.Messages[0].Payload,
+		},
+	}, req.Messages...)
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Equal(t, 0, len(injectC))
+	req.Messages = req.Messages[1:]
+
+	// A batch with one collision and one fresh sequence does not change the sequence counter, whether the sequences are
+	// explicit or auto-assigned.
+	existingAtTwo := *existing
+	existingAtTwo.Sequence = 2
+	require.NoError(t, database.StoreSignedVAA(&existingAtTwo))
+	payload := req.Messages[0].Payload
+	_, err = s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+		Timestamp: req.Timestamp,
+		Messages: []*nodev1.GovernanceMessage{
+			{Sequence: 1000, Nonce: 1, Payload: payload},
+			{Sequence: 2000, Nonce: 1, Payload: payload},
+		},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+		Timestamp:          req.Timestamp,
+		AutoAssignSequence: true,
+		Messages: []*nodev1.GovernanceMessage{
+			{Nonce: 1, Payload: payload},
+			{Nonce: 1, Payload: payload},
+		},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "sequence 2 already exists")
+	require.Equal(t, 0, len(injectC))
+	first, err := database.ReserveGovernanceSequences(1, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first)
+
+	// An unused sequence is accepted.
+	req.Messages[0].Sequence = 1001
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(injectC))
+
+	// The colliding sequence is accepted with the override.
+	req.Messages[0].Sequence = 1000
+	req.OverrideSequenceCollision = true
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(injectC))
+}
+
+func TestFindMissingMessages_VaaIDFormat(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	emitter, err := vaa.StringToAddress("000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7")
+	require.NoError(t, err)
+	for _, seq := range []uint64{0, 2} {
+		require.NoError(t, database.StoreSignedVAA(&vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Signatures:       []*vaa.Signature{{Index: 0}},
+			Timestamp:        time.Unix(1700000000, 0),
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitter,
+			Sequence:         seq,
+			ConsistencyLevel: 1,
+		}))
+	}
+
+	s := &nodePrivilegedService{db: database, logger: zap.NewNop()}
+	resp, err := s.FindMissingMessages(context.Background(), &nodev1.FindMissingMessagesRequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: emitter.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.MissingMessages))
+
+	// The reported key matches the formatting used everywhere else and parses back to the same ID.
+	id := db.VAAID{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, Sequence: 1}
+	require.Equal(t, id.String(), resp.MissingMessages[0])
+	parsed, err := db.VaaIDFromString(resp.MissingMessages[0])
+	require.NoError(t, err)
+	require.Equal(t, id, *parsed)
+}
+
+func TestFindMissingMessages_Compact(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	emitter, err := vaa.StringToAddress("000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7")
+	require.NoError(t, err)
+	for _, seq := range []uint64{0, 1, 5, 6, 7, 8, 10, 12, 13} {
+		require.NoError(t, database.StoreSignedVAA(&vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Signatures:       []*vaa.Signature{{Index: 0}},
+			Timestamp:        time.Unix(1700000000, 0),
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitter,
+			Sequence:         seq,
+			ConsistencyLevel: 1,
+		}))
+	}
+
+	s := &nodePrivilegedService{db: database, logger: zap.NewNop()}
+	resp, err := s.FindMissingMessages(context.Background(), &nodev1.FindMissingMessagesRequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: emitter.String(),
+		Compact:        true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.MissingMessages)
+	require.Equal(t, uint64(0), resp.FirstSequence)
+	require.Equal(t, uint64(13), resp.LastSequence)
+
+	type seqRange struct{ first, last uint64 }
+	ranges := make([]seqRange, len(resp.MissingRanges))
+	for i, r := range resp.MissingRanges {
+		ranges[i] = seqRange{r.First, r.Last}
+	}
+	require.Equal(t, []seqRange{{2, 4}, {9, 9}, {11, 11}}, ranges)
+
+	// Without compaction every missing message is listed.
+	resp, err = s.FindMissingMessages(context.Background(), &nodev1.FindMissingMessagesRequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: emitter.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(resp.MissingMessages))
+	require.Empty(t, resp.MissingRanges)
+}
+
+func TestPlanRecovery(t *testing.T) {
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+	_, err := s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+	s.db = database
+
+	emitter, err := vaa.StringToAddress("000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7")
+	require.NoError(t, err)
+	for _, seq := range []uint64{0, 1, 4, 5, 6, 9} {
+		require.NoError(t, database.StoreSignedVAA(&vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Signatures:       []*vaa.Signature{{Index: 0}},
+			Timestamp:        time.Unix(1700000000, 0),
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitter,
+			Sequence:         seq,
+			ConsistencyLevel: 1,
+		}))
+	}
+
+	_, err = s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: "not hex",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Short emitter addresses are not padded.
+	_, err = s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: "0001",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{
+		EmitterChain:   math.MaxUint16 + 1,
+		EmitterAddress: emitter.String(),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{
+		EmitterChain:        uint32(vaa.ChainIDEthereum),
+		EmitterAddress:      emitter.String(),
+		BackfillNodes:       []string{"https://node1", "https://node2"},
+		BackfillConcurrency: 3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.FirstSequence)
+	require.Equal(t, uint64(9), resp.LastSequence)
+	require.Equal(t, uint64(4), resp.MissingCount)
+	require.Equal(t, 2, len(resp.MissingRanges))
+	require.Equal(t, uint64(2), resp.MissingRanges[0].First)
+	require.Equal(t, uint64(3), resp.MissingRanges[0].Last)
+	require.Equal(t, uint64(7), resp.MissingRanges[1].First)
+	require.Equal(t, uint64(8), resp.MissingRanges[1].Last)
+	require.Equal(t, uint32(3), resp.BackfillConcurrency)
+	// Four missing messages with three workers take two rounds of fetches.
+	require.Equal(t, uint64(2*backfillFetchTimeout/time.Second), resp.MaxBackfillSeconds)
+
+	// Without backfill nodes there is no upper bound, and the default concurrency is reported.
+	resp, err = s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: emitter.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), resp.MissingCount)
+	require.Equal(t, uint32(defaultBackfillConcurrency), resp.BackfillConcurrency)
+	require.Equal(t, uint64(0), resp.MaxBackfillSeconds)
+
+	// An emitter without any gaps needs no recovery.
+	require.NoError(t, database.StoreSignedVAA(&vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Signatures:       []*vaa.Signature{{Index: 0}},
+		Timestamp:        time.Unix(1700000000, 0),
+		EmitterChain:     vaa.ChainIDSolana,
+		EmitterAddress:   emitter,
+		Sequence:         0,
+		ConsistencyLevel: 1,
+	}))
+	resp, err = s.PlanRecovery(context.Background(), &nodev1.PlanRecoveryRequest{
+		EmitterChain:   uint32(vaa.ChainIDSolana),
+		EmitterAddress: emitter.String(),
+		BackfillNodes:  []string{"https://node1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.MissingCount)
+	require.Empty(t, resp.MissingRanges)
+	require.Equal(t, uint64(0), resp.MaxBackfillSeconds)
+}
+
+func TestParseGovernorVaaId(t *testing.T) {
+	vaaId, err := parseGovernorVaaId("2/000000000000000000000000B6F6D86A8F9879A9C87F643768D9EFC38C1DA6E7/7")
+	require.NoError(t, err)
+	require.Equal(t, "2/000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7/7", vaaId)
+
+	_, err = parseGovernorVaaId("")
+	require.ErrorContains(t, err, "must be specified")
+
+	_, err = parseGovernorVaaId("2/b6f6d86a8f9879a9c87f643768d9efc38c1da6e7/7")
+	require.ErrorContains(t, err, "invalid emitter address")
+}
+
+func TestRecoverChainId_InvalidEvmChainId(t *testing.T) {
+	s := &nodePrivilegedService{
+		injectC: make(chan *gcommon.MessagePublication, 10),
+		logger:  zap.NewNop(),
+	}
+
+	tests := []struct {
+		evmChainId string
+		reason     EvmChainIdErrorReason
+	}{
+		{"mainnet", EvmChainIdNotNumeric},
+		{"0x1", EvmChainIdNotNumeric},
+		{"-1", EvmChainIdNegative},
+		// 2^256
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", EvmChainIdOverflow},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.evmChainId, func(t *testing.T) {
+			msg := &nodev1.GovernanceMessage{
+				Sequence: 1,
+				Nonce:    1,
+				Payload: &nodev1.GovernanceMessage_RecoverChainId{
+					RecoverChainId: &nodev1.RecoverChainId{
+						Module:     "Core",
+						EvmChainId: tc.evmChainId,
+						NewChainId: uint32(vaa.ChainIDEthereum),
+					},
+				},
+			}
+
+			_, err := GovMsgToVaa(msg, 0, time.Unix(1700000000, 0))
+			var evmErr *EvmChainIdError
+			require.ErrorAs(t, err, &evmErr)
+			require.Equal(t, tc.evmChainId, evmErr.Value)
+			require.Equal(t, tc.reason, evmErr.Reason)
+
+			_, err = s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+				Timestamp: 1700000000,
+				Messages:  []*nodev1.GovernanceMessage{msg},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.Contains(t, status.Convert(err).Message(), tc.evmChainId)
+		})
+	}
+
+	// 2^256 - 1 is the largest valid value.
+	v, err := recoverChainId(&nodev1.RecoverChainId{
+		Module:     "Core",
+		EvmChainId: "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+		NewChainId: uint32(vaa.ChainIDEthereum),
+	}, time.Unix(1700000000, 0), 0, 1, 1)
+	require.NoError(t, err)
+	require.NotNil(t, v)
+}
+
+func TestRecoverChainId_Module(t *testing.T) {
+	s := &nodePrivilegedService{
+		injectC: make(chan *gcommon.MessagePublication, 10),
+		logger:  zap.NewNop(),
+	}
+
+	inject := func(module string) error {
+		_, err := s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+			Timestamp: 1700000000,
+			Messages: []*nodev1.GovernanceMessage{
+				{
+					Sequence: 1,
+					Nonce:    1,
+					Payload: &nodev1.GovernanceMessage_RecoverChainId{
+						RecoverChainId: &nodev1.RecoverChainId{
+							Module:     module,
+							EvmChainId: "1",
+							NewChainId: uint32(vaa.ChainIDEthereum),
+						},
+					},
+				},
+			},
+		})
+		return err
+	}
+
+	for module, action := range map[string]vaa.GovernanceAction{
+		"Core":        vaa.ActionCoreRecoverChainId,
+		"TokenBridge": vaa.ActionTokenBridgeRecoverChainId,
+	} {
+		require.NoError(t, inject(module))
+
+		v, err := recoverChainId(&nodev1.RecoverChainId{Module: module, EvmChainId: "1", NewChainId: uint32(vaa.ChainIDEthereum)},
+			time.Unix(1700000000, 0), 0, 1, 1)
+		require.NoError(t, err)
+		body, err := vaa.ParseBodyRecoverChainId(v.Payload)
+		require.NoError(t, err)
+		require.Equal(t, module, body.Module)
+		require.Equal(t, byte(action), v.Payload[32])
+	}
+
+	for _, module := range []string{"core", "Token Bridge", "NFTBridge", ""} {
+		err := inject(module)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), module)
+		require.Contains(t, status.Convert(err).Message(), "invalid module")
+	}
+}
+
+func TestListSupportedGovernanceActions(t *testing.T) {
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+	resp, err := s.ListSupportedGovernanceActions(context.Background(), &nodev1.ListSupportedGovernanceActionsRequest{})
+	require.NoError(t, err)
+
+	listed := map[string]bool{}
+	for _, a := range resp.Actions {
+		require.NotEmpty(t, a.Module, a.Payload)
+		require.NotZero(t, a.Action, a.Payload)
+		listed[a.Payload] = true
+	}
+
+	// Every payload of the GovernanceMessage oneof is listed and handled by GovMsgToVaa.
+	payloads := (&nodev1.GovernanceMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload").Fields()
+	require.Equal(t, payloads.Len(), len(listed))
+	for i := 0; i < payloads.Len(); i++ {
+		fd := payloads.Get(i)
+		require.True(t, listed[string(fd.Name())], "%s is not listed", fd.Name())
+
+		msg := &nodev1.GovernanceMessage{}
+		msg.ProtoReflect().Set(fd, msg.ProtoReflect().NewField(fd))
+		require.True(t, handledByGovMsgToVaa(msg), "%s is not handled by GovMsgToVaa", fd.Name())
+	}
+}
+
+func TestListSupportedQueryTypes(t *testing.T) {
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+	resp, err := s.ListSupportedQueryTypes(context.Background(), &nodev1.ListSupportedQueryTypesRequest{})
+	require.NoError(t, err)
+
+	listed := map[uint32]string{}
+	for _, qt := range resp.QueryTypes {
+		_, exists := listed[qt.Type]
+		require.False(t, exists, "%d is listed more than once", qt.Type)
+		listed[qt.Type] = qt.Name
+	}
+
+	// The type values are part of the CCQ wire format, so they are spelled out rather than taken from the constants.
+	require.Equal(t, map[uint32]string{
+		1: "eth_call",
+		2: "eth_call_by_timestamp",
+		3: "eth_call_with_finality",
+		4: "sol_account",
+		5: "sol_pda",
+		6: "sol_token_accounts_by_owner",
+	}, listed)
+
+	// These are the request types the EVM and Solana watchers' QueryHandler switch on.
+	handledByWatchers := []query.ChainSpecificQuery{
+		&query.EthCallQueryRequest{},
+		&query.EthCallByTimestampQueryRequest{},
+		&query.EthCallWithFinalityQueryRequest{},
+		&query.SolanaAccountQueryRequest{},
+		&query.SolanaPdaQueryRequest{},
+		&query.SolanaTokenAccountsByOwnerQueryRequest{},
+	}
+	require.Equal(t, len(handledByWatchers), len(listed))
+	for _, req := range handledByWatchers {
+		require.Contains(t, listed, uint32(req.Type()), "%T", req)
+	}
+}
+
+// handledByGovMsgToVaa returns false if GovMsgToVaa does not support the payload of msg. The payload is empty, so
+// GovMsgToVaa may fail in other ways, which is fine.
+func handledByGovMsgToVaa(msg *nodev1.GovernanceMessage) (handled bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			handled = !strings.Contains(fmt.Sprint(r), "unsupported VAA type")
+		}
+	}()
+	_, _ = GovMsgToVaa(msg, 0, time.Unix(1700000000, 0))
+	return true
+}
+
+// The NFT bridge is governed through bridge_register_chain and bridge_contract_upgrade with the "NFTBridge" module.
+func TestInjectGovernanceVAA_NFTBridge(t *testing.T) {
+	injectC := make(chan *gcommon.MessagePublication, 10)
+	s := &nodePrivilegedService{
+		injectC: injectC,
+		logger:  zap.NewNop(),
+	}
+
+	addr := "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16"
+	var address vaa.Address
+	copy(address[:], common.FromHex(addr))
+
+	tests := []struct {
+		name     string
+		msg      *nodev1.GovernanceMessage
+		expected []byte
+	}{
+		{
+			name: "register chain",
+			msg: &nodev1.GovernanceMessage{Sequence: 1, Nonce: 1, Payload: &nodev1.GovernanceMessage_BridgeRegisterChain{
+				BridgeRegisterChain: &nodev1.BridgeRegisterChain{Module: "NFTBridge", ChainId: uint32(vaa.ChainIDSolana), EmitterAddress: addr},
+			}},
+			expected: vaa.BodyTokenBridgeRegisterChain{Module: "NFTBridge", ChainID: vaa.ChainIDSolana, EmitterAddress: address}.Serialize(),
+		},
+		{
+			name: "upgrade contract",
+			msg: &nodev1.GovernanceMessage{Sequence: 1, Nonce: 1, Payload: &nodev1.GovernanceMessage_BridgeContractUpgrade{
+				BridgeContractUpgrade: &nodev1.BridgeUpgradeContract{Module: "NFTBridge", TargetChainId: uint32(vaa.ChainIDSolana), NewContract: addr},
+			}},
+			expected: vaa.BodyTokenBridgeUpgradeContract{Module: "NFTBridge", TargetChainID: vaa.ChainIDSolana, NewContract: address}.Serialize(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+				Timestamp: 1700000000,
+				Messages:  []*nodev1.GovernanceMessage{tc.msg},
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(injectC))
+			msg := <-injectC
+			require.Equal(t, tc.expected, msg.Payload)
+		})
+	}
+
+	// Invalid addresses are rejected.
+	_, err := s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+		Timestamp: 1700000000,
+		Messages: []*nodev1.GovernanceMessage{{Sequence: 1, Nonce: 1, Payload: &nodev1.GovernanceMessage_BridgeRegisterChain{
+			BridgeRegisterChain: &nodev1.BridgeRegisterChain{Module: "NFTBridge", ChainId: uint32(vaa.ChainIDSolana), EmitterAddress: "abcd"},
+		}}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGovMsgToVaa_UnknownChains(t *testing.T) {
+	const unknownChain = 65000
+	_, exists := knownChains[vaa.ChainID(unknownChain)]
+	require.False(t, exists)
+
+	addr := "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16"
+	contractUpgrade := func(chainId uint32) *nodev1.GovernanceMessage {
+		return &nodev1.GovernanceMessage{
+			Sequence: 1,
+			Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+				ContractUpgrade: &nodev1.ContractUpgrade{ChainId: chainId, NewContract: addr},
+			},
+		}
+	}
+	bridgeUpgrade := func(targetChainId uint32) *nodev1.GovernanceMessage {
+		return &nodev1.GovernanceMessage{
+			Sequence: 1,
+			Payload: &nodev1.GovernanceMessage_BridgeContractUpgrade{
+				BridgeContractUpgrade: &nodev1.BridgeUpgradeContract{Module: "TokenBridge", TargetChainId: targetChainId, NewContract: addr},
+			},
+		}
+	}
+	modifyBalance := func(tokenChain uint32) *nodev1.GovernanceMessage {
+		return &nodev1.GovernanceMessage{
+			Sequence: 1,
+			Payload: &nodev1.GovernanceMessage_AccountantModifyBalance{
+				AccountantModifyBalance: &nodev1.AccountantModifyBalance{
+					Module:        "GlobalAccountant",
+					TargetChainId: uint32(vaa.ChainIDWormchain),
+					ChainId:       uint32(vaa.ChainIDSolana),
+					TokenChain:    tokenChain,
+					TokenAddress:  addr,
+					Amount:        "1",
+				},
+			},
+		}
+	}
+	timestamp := time.Unix(1700000000, 0)
+
+	// Known chains are accepted.
+	for _, msg := range []*nodev1.GovernanceMessage{contractUpgrade(uint32(vaa.ChainIDSolana)), bridgeUpgrade(uint32(vaa.ChainIDEthereum)), modifyBalance(uint32(vaa.ChainIDEthereum))} {
+		_, err := GovMsgToVaa(msg, 0, timestamp)
+		require.NoError(t, err)
+	}
+
+	// Target chain fields may address all chains, other chain fields may not.
+	_, err := GovMsgToVaa(bridgeUpgrade(uint32(vaa.ChainIDUnset)), 0, timestamp)
+	require.NoError(t, err)
+	_, err = GovMsgToVaa(contractUpgrade(uint32(vaa.ChainIDUnset)), 0, timestamp)
+	require.ErrorContains(t, err, "invalid chain_id 0: unknown chain")
+
+	// Unknown chains are rejected, in any of the chain fields.
+	_, err = GovMsgToVaa(contractUpgrade(unknownChain), 0, timestamp)
+	require.ErrorContains(t, err, "invalid chain_id 65000: unknown chain")
+	_, err = GovMsgToVaa(bridgeUpgrade(unknownChain), 0, timestamp)
+	require.ErrorContains(t, err, "invalid target_chain_id 65000: unknown chain")
+	_, err = GovMsgToVaa(modifyBalance(unknownChain), 0, timestamp)
+	require.ErrorContains(t, err, "invalid token_chain 65000: unknown chain")
+	_, err = GovMsgToVaa(contractUpgrade(math.MaxUint16+1), 0, timestamp)
+	require.ErrorContains(t, err, "invalid chain_id, must be <= 65535")
+
+	// The rejection is reported as an invalid argument by InjectGovernanceVAA.
+	s := &nodePrivilegedService{
+		injectC: make(chan *gcommon.MessagePublication, 10),
+		logger:  zap.NewNop(),
+	}
+	_, err = s.InjectGovernanceVAA(context.Background(), &nodev1.InjectGovernanceVAARequest{
+		Messages: []*nodev1.GovernanceMessage{contractUpgrade(unknownChain)},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Unknown chains are accepted with the override, but the uint16 limit still applies.
+	for _, msg := range []*nodev1.GovernanceMessage{contractUpgrade(unknownChain), bridgeUpgrade(unknownChain), modifyBalance(unknownChain)} {
+		msg.AllowUnknownChains = true
+		_, err := GovMsgToVaa(msg, 0, timestamp)
+		require.NoError(t, err)
+	}
+	msg := contractUpgrade(math.MaxUint16 + 1)
+	msg.AllowUnknownChains = true
+	_, err = GovMsgToVaa(msg, 0, timestamp)
+	require.ErrorContains(t, err, "invalid chain_id")
+}
+
+func TestInjectGovernanceVAA_LocalGuardianNotInSet(t *testing.T) {
+	local := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	others := []common.Address{
+		common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		common.HexToAddress("0x0000000000000000000000000000000000000003"),
+		common.HexToAddress("0x0000000000000000000000000000000000000004"),
+	}
+
+	injectC := make(chan *gcommon.MessagePublication, 10)
+	gst := gcommon.NewGuardianSetState(nil)
+	gst.Set(gcommon.NewGuardianSet(others, 4))
+	s := &nodePrivilegedService{
+		injectC:         injectC,
+		logger:          zap.NewNop(),
+		gst:             gst,
+		guardianAddress: local,
+	}
+
+	q, err := s.CheckGovernanceQuorum(context.Background(), &nodev1.CheckGovernanceQuorumRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), q.GuardianSetIndex)
+	require.Equal(t, uint32(3), q.NumGuardians)
+	require.Equal(t, uint32(vaa.CalculateQuorum(3)), q.Quorum)
+	require.False(t, q.InGuardianSet)
+
+	req := &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: 4,
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: 1,
+				Payload: &nodev1.GovernanceMessage_ContractUpgrade{
+					ContractUpgrade: &nodev1.ContractUpgrade{
+						ChainId:     uint32(vaa.ChainIDSolana),
+						NewContract: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16",
+					},
+				},
+			},
+		},
+	}
+
+	// The injection is refused since the local signature cannot count toward quorum.
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "not in the current guardian set 4")
+	require.Equal(t, 0, len(injectC))
+
+	// It is accepted with the override.
+	req.OverrideNotInGuardianSet = true
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(injectC))
+
+	// Once the local guardian is in the set, no override is needed.
+	gst.Set(gcommon.NewGuardianSet(append(others, local), 5))
+	q, err = s.CheckGovernanceQuorum(context.Background(), &nodev1.CheckGovernanceQuorumRequest{})
+	require.NoError(t, err)
+	require.True(t, q.InGuardianSet)
+	require.Equal(t, uint32(3), q.LocalGuardianIndex)
+	require.Equal(t, uint32(vaa.CalculateQuorum(4)), q.Quorum)
+
+	req.OverrideNotInGuardianSet = false
+	req.Messages[0].Sequence = 2
+	_, err = s.InjectGovernanceVAA(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(injectC))
+}
+
+func TestCheckGovernanceQuorum_NoGuardianSet(t *testing.T) {
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+	_, err := s.CheckGovernanceQuorum(context.Background(), &nodev1.CheckGovernanceQuorumRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	s.gst = gcommon.NewGuardianSetState(nil)
+	_, err = s.CheckGovernanceQuorum(context.Background(), &nodev1.CheckGovernanceQuorumRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCallWithContext(t *testing.T) {
+	resp, err := callWithContext(context.Background(), func() (string, error) { return "reloaded", nil })
+	require.NoError(t, err)
+	require.Equal(t, "reloaded", resp)
+
+	_, err = callWithContext(context.Background(), func() (string, error) { return "", errors.New("reload failed") })
+	require.ErrorContains(t, err, "reload failed")
+
+	// A reload that blocks past the deadline returns DeadlineExceeded without waiting for it.
+	release := make(chan struct{})
+	defer close(release)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = callWithContext(ctx, func() (string, error) {
+		<-release
+		return "reloaded", nil
+	})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Less(t, time.Since(start), time.Second)
+
+	// A cancelled request is reported as such.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = callWithContext(ctx, func() (string, error) {
+		<-release
+		return "reloaded", nil
+	})
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestCallExclusiveRejectsRetryWhileTimedOutCallRuns(t *testing.T) {
+	var inFlight atomic.Bool
+	release := make(chan struct{})
+	calls := 0
+	slowReload := func() (string, error) {
+		calls++
+		<-release
+		return "reloaded", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := callExclusive(ctx, &inFlight, slowReload)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// The timed out reload is still running, so a retry must not start a second one.
+	_, err = callExclusive(context.Background(), &inFlight, slowReload)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	close(release)
+	require.Eventually(t, func() bool { return !inFlight.Load() }, time.Second, time.Millisecond)
+
+	resp, err := callExclusive(context.Background(), &inFlight, slowReload)
+	require.NoError(t, err)
+	require.Equal(t, "reloaded", resp)
+	require.Equal(t, 2, calls)
+}
+
+func TestVerifyVAADigest(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	gst := gcommon.NewGuardianSetState(nil)
+	gst.Set(gcommon.NewGuardianSet([]common.Address{ethcrypto.PubkeyToAddress(key.PublicKey)}, 0))
+
+	s := &nodePrivilegedService{
+		db:     database,
+		gst:    gst,
+		logger: zap.NewNop(),
+	}
+
+	newVAA := func(sequence uint64) *vaa.VAA {
+		v := &vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			GuardianSetIndex: 0,
+			Timestamp:        time.Unix(0, 0),
+			EmitterChain:     vaa.ChainIDSolana,
+			EmitterAddress:   vaa.Address{1},
+			Sequence:         sequence,
+			ConsistencyLevel: 32,
+			Payload:          []byte{1, 2, 3},
+		}
+		v.AddSignature(key, 0)
+		return v
+	}
+
+	valid := newVAA(1)
+	require.NoError(t, database.StoreSignedVAA(valid))
+	resp, err := s.VerifyVAADigest(context.Background(), &nodev1.VerifyVAADigestRequest{VaaId: db.VaaIDFromVAA(valid).String()})
+	require.NoError(t, err)
+	require.True(t, resp.Valid)
+	require.Empty(t, resp.Errors)
+	require.Equal(t, hex.EncodeToString(valid.SigningDigest().Bytes()), resp.Digest)
+	require.Equal(t, uint32(1), resp.NumSignatures)
+	require.Equal(t, uint32(1), resp.Quorum)
+
+	// The payload was modified after the VAA was signed.
+	tampered := newVAA(2)
+	tampered.Payload = []byte{4, 5, 6}
+	require.NoError(t, database.StoreSignedVAA(tampered))
+	resp, err = s.VerifyVAADigest(context.Background(), &nodev1.VerifyVAADigestRequest{VaaId: db.VaaIDFromVAA(tampered).String()})
+	require.NoError(t, err)
+	require.False(t, resp.Valid)
+	require.Equal(t, hex.EncodeToString(tampered.SigningDigest().Bytes()), resp.Digest)
+	require.Equal(t, 1, len(resp.Errors))
+	require.Contains(t, resp.Errors[0], "bad signatures")
+
+	// The VAA claims a guardian set that is not known.
+	unknownSet := newVAA(3)
+	unknownSet.GuardianSetIndex = 7
+	require.NoError(t, database.StoreSignedVAA(unknownSet))
+	resp, err = s.VerifyVAADigest(context.Background(), &nodev1.VerifyVAADigestRequest{VaaId: db.VaaIDFromVAA(unknownSet).String()})
+	require.NoError(t, err)
+	require.False(t, resp.Valid)
+	require.Equal(t, []string{"guardian set 7 is not known"}, resp.Errors)
+
+	_, err = s.VerifyVAADigest(context.Background(), &nodev1.VerifyVAADigestRequest{VaaId: db.VaaIDFromVAA(newVAA(4)).String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.VerifyVAADigest(context.Background(), &nodev1.VerifyVAADigestRequest{VaaId: "not a vaa id"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetAndObserveMissingVAAs_Headers(t *testing.T) {
+	var gotHeader http.Header
+	var gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		gotBody = string(body)
+		_, _ = fmt.Fprint(w, `[]`)
+	}))
+	defer srv.Close()
+
+	s := &nodePrivilegedService{logger: zap.NewNop()}
+
+	// By default the API key is sent in the body.
+	resp, err := s.GetAndObserveMissingVAAs(context.Background(), &nodev1.GetAndObserveMissingVAAsRequest{
+		Url:     srv.URL,
+		ApiKey:  "secret",
+		Headers: map[string]string{"X-Custom": "value"},
+	})
+	require.NoError(t, err)
+	require.Contains(t, resp.Response, "There were no missing VAAs to recover.")
+	require.Equal(t, `{"apiKey": "secret"}`, gotBody)
+	require.Equal(t, "value", gotHeader.Get("X-Custom"))
+	require.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+	require.Empty(t, gotHeader.Get("Authorization"))
+
+	// As a bearer token, the API key is only sent in the Authorization header.
+	_, err = s.GetAndObserveMissingVAAs(context.Background(), &nodev1.GetAndObserveMissingVAAsRequest{
+		Url:                 srv.URL,
+		ApiKey:              "secret",
+		ApiKeyAsBearerToken: true,
+		Headers:             map[string]string{"X-Custom": "other", "Content-Type": "application/vnd.custom+json"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{}`, gotBody)
+	require.Equal(t, "Bearer secret", gotHeader.Get("Authorization"))
+	require.Equal(t, "other", gotHeader.Get("X-Custom"))
+	require.Equal(t, "application/vnd.custom+json", gotHeader.Get("Content-Type"))
+}
@@ -0,0 +1,126 @@
+package adminrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	interceptor := NewAuditInterceptor(zap.New(core))
+
+	okHandler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	deniedHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.PermissionDenied, "nope")
+	}
+
+	_, err := interceptor(context.Background(),
+		&nodev1.InjectSignedVAARequest{Vaa: []byte{1, 2, 3, 4}},
+		&grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/InjectSignedVAA"}, okHandler)
+	require.NoError(t, err)
+
+	_, err = interceptor(context.Background(),
+		&nodev1.InjectGovernanceVAARequest{CurrentSetIndex: 3, RequestLabel: "upgrade"},
+		&grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/InjectGovernanceVAA"}, deniedHandler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Public RPC calls served on the admin socket are not audited.
+	_, err = interceptor(context.Background(),
+		&publicrpcv1.GetLastHeartbeatsRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/publicrpc.v1.PublicRPCService/GetLastHeartbeats"}, okHandler)
+	require.NoError(t, err)
+
+	entries := logs.All()
+	require.Equal(t, 2, len(entries))
+
+	first := entries[0].ContextMap()
+	assert.Equal(t, "InjectSignedVAA", first["method"])
+	assert.Equal(t, "InjectSignedVAARequest{vaa=<4 bytes>}", first["request"])
+	assert.Equal(t, "OK", first["code"])
+
+	second := entries[1].ContextMap()
+	assert.Equal(t, "InjectGovernanceVAA", second["method"])
+	assert.Equal(t, `InjectGovernanceVAARequest{current_set_index=3, request_label="upgrade"}`, second["request"])
+	assert.Equal(t, "PermissionDenied", second["code"])
+
+	// Each entry is chained to the previous one.
+	assert.NotEmpty(t, first["chainHash"])
+	assert.NotEqual(t, first["chainHash"], second["chainHash"])
+}
+
+func TestAuditLoggerFile(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	auditLogFile := filepath.Join(t.TempDir(), "audit.log")
+
+	auditLogger, closeAuditLog, err := NewAuditLogger(zap.New(core), auditLogFile)
+	require.NoError(t, err)
+
+	interceptor := NewAuditInterceptor(auditLogger)
+	_, err = interceptor(context.Background(), &nodev1.GetNodeVersionRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/GetNodeVersion"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+	require.NoError(t, err)
+	require.NoError(t, auditLogger.Sync())
+	require.NoError(t, closeAuditLog())
+
+	// The entry goes to both the regular log and the audit file.
+	assert.Equal(t, 1, logs.Len())
+
+	b, err := os.ReadFile(auditLogFile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Equal(t, 1, len(lines))
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "admin rpc call", entry["msg"])
+	assert.Equal(t, "GetNodeVersion", entry["method"])
+	assert.Equal(t, "OK", entry["code"])
+}
+
+func TestAuditRedactsSecretFields(t *testing.T) {
+	const apiKey = "super-secret-api-key"
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	auditLogFile := filepath.Join(t.TempDir(), "audit.log")
+
+	auditLogger, closeAuditLog, err := NewAuditLogger(zap.New(core), auditLogFile)
+	require.NoError(t, err)
+
+	interceptor := NewAuditInterceptor(auditLogger)
+	_, err = interceptor(context.Background(),
+		&nodev1.GetAndObserveMissingVAAsRequest{Url: "https://example.com", ApiKey: apiKey},
+		&grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/GetAndObserveMissingVAAs"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+	require.NoError(t, err)
+	require.NoError(t, auditLogger.Sync())
+	require.NoError(t, closeAuditLog())
+
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, `GetAndObserveMissingVAAsRequest{url="https://example.com", api_key=<redacted, 20 chars>}`,
+		logs.All()[0].ContextMap()["request"])
+	for _, v := range logs.All()[0].ContextMap() {
+		assert.NotContains(t, fmt.Sprint(v), apiKey)
+	}
+
+	b, err := os.ReadFile(auditLogFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), apiKey)
+	assert.Contains(t, string(b), "<redacted, 20 chars>")
+}
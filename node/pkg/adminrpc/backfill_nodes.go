@@ -0,0 +1,98 @@
+package adminrpc
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// backfillNodeMaxFailures is the number of consecutive bad responses after which a backfill node is skipped.
+	backfillNodeMaxFailures = 3
+	// backfillNodeSkipWindow is how long a misbehaving backfill node is skipped before it is given another chance.
+	backfillNodeSkipWindow = 10 * time.Minute
+)
+
+type (
+	// backfillNodeTracker keeps track of backfill nodes that return data which cannot be decoded, so that
+	// they can be temporarily skipped. A nil tracker never skips any nodes.
+	backfillNodeTracker struct {
+		mu          sync.Mutex
+		maxFailures int
+		skipWindow  time.Duration
+		nodes       map[string]*backfillNodeState
+		now         func() time.Time
+	}
+
+	// backfillNodeState is the failure state of a single backfill node.
+	backfillNodeState struct {
+		failures  int
+		skipUntil time.Time
+	}
+)
+
+func newBackfillNodeTracker(maxFailures int, skipWindow time.Duration) *backfillNodeTracker {
+	return &backfillNodeTracker{
+		maxFailures: maxFailures,
+		skipWindow:  skipWindow,
+		nodes:       make(map[string]*backfillNodeState),
+		now:         time.Now,
+	}
+}
+
+// skip returns true if the node has recently failed too often and should not be used.
+// Once the skip window has passed, the node's failure count is reset.
+func (t *backfillNodeTracker) skip(node string) bool {
+	if t == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, exists := t.nodes[node]
+	if !exists || state.skipUntil.IsZero() {
+		return false
+	}
+
+	if t.now().Before(state.skipUntil) {
+		return true
+	}
+
+	delete(t.nodes, node)
+	return false
+}
+
+// recordFailure records a bad response from the node. It returns true if the node is now being skipped.
+func (t *backfillNodeTracker) recordFailure(node string) bool {
+	if t == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, exists := t.nodes[node]
+	if !exists {
+		state = &backfillNodeState{}
+		t.nodes[node] = state
+	}
+
+	state.failures++
+	if state.failures >= t.maxFailures && state.skipUntil.IsZero() {
+		state.skipUntil = t.now().Add(t.skipWindow)
+		return true
+	}
+
+	return false
+}
+
+// recordSuccess clears the failure count of the node.
+func (t *backfillNodeTracker) recordSuccess(node string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.nodes, node)
+}
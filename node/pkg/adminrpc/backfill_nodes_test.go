@@ -0,0 +1,44 @@
+package adminrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackfillNodeTracker(t *testing.T) {
+	now := time.Now()
+	tracker := newBackfillNodeTracker(2, time.Minute)
+	tracker.now = func() time.Time { return now }
+
+	// A success resets the failure count.
+	assert.False(t, tracker.recordFailure("node1"))
+	tracker.recordSuccess("node1")
+	assert.False(t, tracker.recordFailure("node1"))
+	assert.False(t, tracker.skip("node1"))
+
+	// The second consecutive failure causes the node to be skipped, other nodes are unaffected.
+	assert.True(t, tracker.recordFailure("node1"))
+	assert.True(t, tracker.skip("node1"))
+	assert.False(t, tracker.skip("node2"))
+
+	// Further failures while skipped do not extend the window.
+	assert.False(t, tracker.recordFailure("node1"))
+
+	now = now.Add(59 * time.Second)
+	assert.True(t, tracker.skip("node1"))
+
+	// After the window the node is reset.
+	now = now.Add(time.Second)
+	assert.False(t, tracker.skip("node1"))
+	assert.False(t, tracker.recordFailure("node1"))
+	assert.False(t, tracker.skip("node1"))
+}
+
+func TestBackfillNodeTracker_Nil(t *testing.T) {
+	var tracker *backfillNodeTracker
+	assert.False(t, tracker.recordFailure("node1"))
+	tracker.recordSuccess("node1")
+	assert.False(t, tracker.skip("node1"))
+}
@@ -0,0 +1,50 @@
+package adminrpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parseDisabledMethods parses a comma separated list of NodePrivilegedService method names, such as "InjectGovernanceVAA",
+// into a set of full gRPC method names. Unknown method names are rejected so that typos do not silently leave a method enabled.
+func parseDisabledMethods(disabledMethods string) (map[string]struct{}, error) {
+	result := make(map[string]struct{})
+	if strings.TrimSpace(disabledMethods) == "" {
+		return result, nil
+	}
+
+	service := nodev1.File_node_v1_node_proto.Services().ByName("NodePrivilegedService")
+	for _, str := range strings.Split(disabledMethods, ",") {
+		name := strings.TrimSpace(str)
+		method := service.Methods().ByName(protoreflect.Name(name))
+		if method == nil {
+			return nil, fmt.Errorf("invalid value in `--adminDisabledMethods`: `%s` is not an admin RPC method", str)
+		}
+		result[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = struct{}{}
+	}
+
+	return result, nil
+}
+
+// NewDisabledMethodsInterceptor returns a unary server interceptor that rejects calls to the given comma separated list of
+// NodePrivilegedService methods with PermissionDenied. All other methods are passed through.
+func NewDisabledMethodsInterceptor(disabledMethods string) (grpc.UnaryServerInterceptor, error) {
+	disabled, err := parseDisabledMethods(disabledMethods)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, exists := disabled[info.FullMethod]; exists {
+			return nil, status.Errorf(codes.PermissionDenied, "method %s is disabled on this node", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}, nil
+}
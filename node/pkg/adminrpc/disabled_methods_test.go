@@ -0,0 +1,53 @@
+package adminrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDisabledMethodsInterceptor(t *testing.T) {
+	interceptor, err := NewDisabledMethodsInterceptor("InjectGovernanceVAA, InjectSignedVAA")
+	require.NoError(t, err)
+
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return "ok", nil
+	}
+
+	// A disabled method is blocked without calling the handler.
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/InjectGovernanceVAA"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.False(t, handlerCalled)
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/InjectSignedVAA"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.False(t, handlerCalled)
+
+	// Other methods pass through.
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/GetNodeVersion"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.True(t, handlerCalled)
+}
+
+func TestDisabledMethodsInterceptor_Empty(t *testing.T) {
+	interceptor, err := NewDisabledMethodsInterceptor("")
+	require.NoError(t, err)
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/node.v1.NodePrivilegedService/InjectGovernanceVAA"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil })
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestDisabledMethodsInterceptor_UnknownMethod(t *testing.T) {
+	_, err := NewDisabledMethodsInterceptor("InjectGovernanceVAA,InjectGovernanceVaa")
+	assert.ErrorContains(t, err, "`InjectGovernanceVaa` is not an admin RPC method")
+}
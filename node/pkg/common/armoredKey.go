@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"golang.org/x/crypto/openpgp/armor" //nolint
@@ -16,6 +17,10 @@ import (
 
 const (
 	GuardianKeyArmoredBlock = "WORMHOLE GUARDIAN PRIVATE KEY"
+
+	// GuardianKeyDirPrimary and GuardianKeyDirSecondary are the file names of the keys loaded by LoadGuardianKeyDir.
+	GuardianKeyDirPrimary   = "primary.key"
+	GuardianKeyDirSecondary = "secondary.key"
 )
 
 // LoadGuardianKey loads a serialized guardian key from disk.
@@ -23,6 +28,31 @@ func LoadGuardianKey(filename string, unsafeDevMode bool) (*ecdsa.PrivateKey, er
 	return LoadArmoredKey(filename, GuardianKeyArmoredBlock, unsafeDevMode)
 }
 
+// LoadGuardianKeyDir loads the guardian keys used during a key rotation from a directory. The primary key is required and is the
+// one the guardian signs with. The secondary key is optional and is nil if it does not exist. It is the key being rotated out,
+// which is never used for signing. It is only used to tell whether the guardian set still contains the old key.
+func LoadGuardianKeyDir(dir string, unsafeDevMode bool) (primary *ecdsa.PrivateKey, secondary *ecdsa.PrivateKey, err error) {
+	primary, err = LoadGuardianKey(filepath.Join(dir, GuardianKeyDirPrimary), unsafeDevMode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load primary guardian key: %w", err)
+	}
+
+	secondaryPath := filepath.Join(dir, GuardianKeyDirSecondary)
+	if _, err := os.Stat(secondaryPath); os.IsNotExist(err) {
+		return primary, nil, nil
+	}
+	secondary, err = LoadGuardianKey(secondaryPath, unsafeDevMode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load secondary guardian key: %w", err)
+	}
+
+	if ethcrypto.PubkeyToAddress(primary.PublicKey) == ethcrypto.PubkeyToAddress(secondary.PublicKey) {
+		return nil, nil, errors.New("primary and secondary guardian keys must be different")
+	}
+
+	return primary, secondary, nil
+}
+
 // LoadArmoredKey loads a serialized key from disk.
 func LoadArmoredKey(filename string, blockType string, unsafeDevMode bool) (*ecdsa.PrivateKey, error) {
 	f, err := os.Open(filename)
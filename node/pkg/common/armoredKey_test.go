@@ -0,0 +1,62 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadGuardianKeyDir(t *testing.T) {
+	primaryKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	secondaryKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	// The primary key is required.
+	_, _, err = LoadGuardianKeyDir(dir, false)
+	assert.ErrorContains(t, err, "failed to load primary guardian key")
+
+	// The secondary key is optional.
+	require.NoError(t, WriteArmoredKey(primaryKey, "", filepath.Join(dir, GuardianKeyDirPrimary), GuardianKeyArmoredBlock, false))
+	primary, secondary, err := LoadGuardianKeyDir(dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, ethcrypto.PubkeyToAddress(primaryKey.PublicKey), ethcrypto.PubkeyToAddress(primary.PublicKey))
+	assert.Nil(t, secondary)
+
+	require.NoError(t, WriteArmoredKey(secondaryKey, "", filepath.Join(dir, GuardianKeyDirSecondary), GuardianKeyArmoredBlock, false))
+	primary, secondary, err = LoadGuardianKeyDir(dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, ethcrypto.PubkeyToAddress(primaryKey.PublicKey), ethcrypto.PubkeyToAddress(primary.PublicKey))
+	require.NotNil(t, secondary)
+	assert.Equal(t, ethcrypto.PubkeyToAddress(secondaryKey.PublicKey), ethcrypto.PubkeyToAddress(secondary.PublicKey))
+}
+
+func TestLoadGuardianKeyDirRejectsSameKey(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, WriteArmoredKey(key, "", filepath.Join(dir, GuardianKeyDirPrimary), GuardianKeyArmoredBlock, false))
+	require.NoError(t, WriteArmoredKey(key, "", filepath.Join(dir, GuardianKeyDirSecondary), GuardianKeyArmoredBlock, false))
+
+	_, _, err = LoadGuardianKeyDir(dir, false)
+	assert.ErrorContains(t, err, "must be different")
+}
+
+func TestLoadGuardianKeyDirInvalidSecondary(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, WriteArmoredKey(key, "", filepath.Join(dir, GuardianKeyDirPrimary), GuardianKeyArmoredBlock, false))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, GuardianKeyDirSecondary), []byte("garbage"), 0600))
+
+	_, _, err = LoadGuardianKeyDir(dir, false)
+	assert.ErrorContains(t, err, "failed to load secondary guardian key")
+}
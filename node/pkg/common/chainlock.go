@@ -218,6 +218,27 @@ func (msg *MessagePublication) CreateVAA(gsIndex uint32) *vaa.VAA {
 	}
 }
 
+// MessagePublicationFromVAA builds a MessagePublication from the header and body of a VAA. A VAA does not
+// carry the transaction it originated from, so the TxHash is left zeroed.
+func MessagePublicationFromVAA(v *vaa.VAA) *MessagePublication {
+	return &MessagePublication{
+		TxHash:           common.Hash{},
+		Timestamp:        v.Timestamp,
+		Nonce:            v.Nonce,
+		Sequence:         v.Sequence,
+		ConsistencyLevel: v.ConsistencyLevel,
+		EmitterChain:     v.EmitterChain,
+		EmitterAddress:   v.EmitterAddress,
+		Payload:          v.Payload,
+		Unreliable:       false,
+	}
+}
+
+// ToVAAHeader is the inverse of MessagePublicationFromVAA. It returns an unsigned VAA with a zero guardian set index.
+func (msg *MessagePublication) ToVAAHeader() *vaa.VAA {
+	return msg.CreateVAA(0)
+}
+
 func (msg *MessagePublication) CreateDigest() string {
 	v := msg.CreateVAA(0) // The guardian set index is not part of the digest, so we can pass in zero.
 	db := v.SigningDigest()
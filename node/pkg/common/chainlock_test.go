@@ -220,3 +220,28 @@ func TestMessageID(t *testing.T) {
 		})
 	}
 }
+
+func TestMessagePublicationFromVAARoundTrip(t *testing.T) {
+	addr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 0,
+		Timestamp:        time.Unix(1654516425, 0),
+		Nonce:            123456,
+		Sequence:         789101112131415,
+		ConsistencyLevel: 32,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   addr,
+		Payload:          []byte{0x01, 0x02, 0x03},
+	}
+
+	msg := MessagePublicationFromVAA(v)
+	assert.Equal(t, eth_common.Hash{}, msg.TxHash)
+	assert.False(t, msg.Unreliable)
+	assert.False(t, msg.IsReobservation)
+
+	assert.Equal(t, v, msg.ToVAAHeader())
+	assert.Equal(t, msg, MessagePublicationFromVAA(msg.ToVAAHeader()))
+}
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"github.com/wormhole-foundation/wormhole/sdk"
+)
+
+// EmittersForEnv returns the list of well-known emitters for the specified environment. Callers that iterate
+// over all emitters (like the missing message finder and recovery tooling) should use this rather than picking
+// one of the SDK lists directly.
+func EmittersForEnv(env Environment) []sdk.EmitterInfo {
+	if env == MainNet {
+		return sdk.KnownEmitters
+	} else if env == TestNet {
+		return sdk.KnownTestnetEmitters
+	}
+
+	// Every other environment uses the devnet ones.
+	return sdk.KnownDevnetEmitters
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole/sdk"
+)
+
+func TestEmittersForEnv(t *testing.T) {
+	type test struct {
+		env      Environment
+		expected []sdk.EmitterInfo
+	}
+
+	tests := []test{
+		{env: MainNet, expected: sdk.KnownEmitters},
+		{env: TestNet, expected: sdk.KnownTestnetEmitters},
+		{env: UnsafeDevNet, expected: sdk.KnownDevnetEmitters},
+		{env: GoTest, expected: sdk.KnownDevnetEmitters},
+		{env: AccountantMock, expected: sdk.KnownDevnetEmitters},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.env), func(t *testing.T) {
+			emitters := EmittersForEnv(tc.env)
+			assert.NotEqual(t, 0, len(emitters))
+			assert.Equal(t, tc.expected, emitters)
+		})
+	}
+}
@@ -90,7 +90,15 @@ func requestPayloadServerInterceptor(ctx context.Context, req interface{}, info
 	return handler(ctx, req)
 }
 
-func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail) *grpc.Server {
+// NewInstrumentedGRPCServer creates a gRPC server with metrics and logging interceptors. Any extraUnaryInterceptors
+// are run after those, so that calls they reject are still logged and counted.
+func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail, extraUnaryInterceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
+	return NewInstrumentedGRPCServerWithOptions(logger, rpcLogDetail, nil, extraUnaryInterceptors...)
+}
+
+// NewInstrumentedGRPCServerWithOptions is the same as NewInstrumentedGRPCServer, but also applies the given server
+// options, such as transport credentials.
+func NewInstrumentedGRPCServerWithOptions(logger *zap.Logger, rpcLogDetail GrpcLogDetail, opts []grpc.ServerOption, extraUnaryInterceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
 	initMutex.Lock()
 	defer initMutex.Unlock()
 
@@ -122,10 +130,13 @@ func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail) *
 		)
 	}
 
-	server := grpc.NewServer(
+	unaryInterceptors = append(unaryInterceptors, extraUnaryInterceptors...)
+
+	opts = append([]grpc.ServerOption{
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
-	)
+	}, opts...)
+	server := grpc.NewServer(opts...)
 
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc_prometheus.Register(server)
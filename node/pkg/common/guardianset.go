@@ -46,11 +46,36 @@ const MaxNodesPerGuardian = 15
 // from the state by Cleanup().
 const MaxStateAge = 1 * time.Minute
 
+// GuardianSetExpiry is how long a replaced guardian set remains valid, matching the expiry set by the core contracts on a
+// guardian set upgrade.
+const GuardianSetExpiry = 24 * time.Hour
+
 type GuardianSet struct {
 	// Guardian's public key hashes truncated by the ETH standard hashing mechanism (20 bytes).
 	Keys []common.Address
 	// On-chain set index
 	Index uint32
+
+	// keyMap maps each key to its index in Keys. It is only set by NewGuardianSet, sets created as struct literals fall back
+	// to a linear search. Keys must not be modified once it is built.
+	keyMap map[common.Address]int
+}
+
+// NewGuardianSet returns a guardian set with an index of its keys, so that IndexOf is a map lookup.
+func NewGuardianSet(keys []common.Address, index uint32) *GuardianSet {
+	keyMap := make(map[common.Address]int, len(keys))
+	for n, k := range keys {
+		// Keep the first occurrence, like a linear search would.
+		if _, exists := keyMap[k]; !exists {
+			keyMap[k] = n
+		}
+	}
+
+	return &GuardianSet{
+		Keys:   keys,
+		Index:  index,
+		keyMap: keyMap,
+	}
 }
 
 func (g *GuardianSet) KeysAsHexStrings() []string {
@@ -63,9 +88,16 @@ func (g *GuardianSet) KeysAsHexStrings() []string {
 	return r
 }
 
-// KeyIndex returns a given address index from the guardian set. Returns (-1, false)
-// if the address wasn't found and (addr, true) otherwise.
-func (g *GuardianSet) KeyIndex(addr common.Address) (int, bool) {
+// IndexOf returns a given address index from the guardian set. Returns (-1, false)
+// if the address wasn't found and (index, true) otherwise.
+func (g *GuardianSet) IndexOf(addr common.Address) (int, bool) {
+	if g.keyMap != nil {
+		if n, exists := g.keyMap[addr]; exists {
+			return n, true
+		}
+		return -1, false
+	}
+
 	for n, k := range g.Keys {
 		if k == addr {
 			return n, true
@@ -75,9 +107,20 @@ func (g *GuardianSet) KeyIndex(addr common.Address) (int, bool) {
 	return -1, false
 }
 
+// KeyIndex is the same as IndexOf.
+func (g *GuardianSet) KeyIndex(addr common.Address) (int, bool) {
+	return g.IndexOf(addr)
+}
+
 type GuardianSetState struct {
 	mu      sync.Mutex
 	current *GuardianSet
+	// byIndex holds every guardian set that was set, including the current one, so that VAAs signed by older sets can be verified.
+	byIndex map[uint32]*GuardianSet
+	// replacedAt records when each guardian set in byIndex stopped being the current one.
+	replacedAt map[uint32]time.Time
+	// changedAt is when the current guardian set replaced the previous one, zero if there was no previous one.
+	changedAt time.Time
 
 	// Last heartbeat message received per guardian per p2p node. Maintained
 	// across guardian set updates - these values don't change.
@@ -91,6 +134,8 @@ type GuardianSetState struct {
 // but be aware that the channel will block guardian set updates if full.
 func NewGuardianSetState(guardianSetStateUpdateC chan *gossipv1.Heartbeat) *GuardianSetState {
 	return &GuardianSetState{
+		byIndex:        map[uint32]*GuardianSet{},
+		replacedAt:     map[uint32]time.Time{},
 		lastHeartbeats: map[common.Address]map[peer.ID]*gossipv1.Heartbeat{},
 		updateC:        guardianSetStateUpdateC,
 	}
@@ -102,7 +147,14 @@ func (st *GuardianSetState) Set(set *GuardianSet) {
 	gsSigners.Set(float64(len(set.Keys)))
 	defer st.mu.Unlock()
 
+	if st.current != nil && st.current.Index != set.Index {
+		now := time.Now()
+		st.replacedAt[st.current.Index] = now
+		st.changedAt = now
+	}
+	delete(st.replacedAt, set.Index)
 	st.current = set
+	st.byIndex[set.Index] = set
 }
 
 func (st *GuardianSetState) Get() *GuardianSet {
@@ -112,6 +164,35 @@ func (st *GuardianSetState) Get() *GuardianSet {
 	return st.current
 }
 
+// ChangedAt returns when the current guardian set replaced the previous one. It returns the zero time if the current
+// guardian set is the first one that was set.
+func (st *GuardianSetState) ChangedAt() time.Time {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.changedAt
+}
+
+// GetForIndex returns the guardian set with the given index, if it was ever set and has not expired. This allows verifying
+// VAAs that were signed by a guardian set that has been replaced less than GuardianSetExpiry ago.
+func (st *GuardianSetState) GetForIndex(index uint32) (*GuardianSet, bool) {
+	return st.getForIndexAt(index, time.Now())
+}
+
+func (st *GuardianSetState) getForIndexAt(index uint32, now time.Time) (*GuardianSet, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	gs, ok := st.byIndex[index]
+	if !ok {
+		return nil, false
+	}
+	if replacedAt, replaced := st.replacedAt[index]; replaced && now.Sub(replacedAt) > GuardianSetExpiry {
+		return nil, false
+	}
+	return gs, true
+}
+
 // LastHeartbeat returns the most recent heartbeat message received for
 // a given guardian node, or nil if none have been received.
 func (st *GuardianSetState) LastHeartbeat(addr common.Address) map[peer.ID]*gossipv1.Heartbeat {
@@ -1,10 +1,14 @@
 package common
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 )
 
 func TestKeyIndex(t *testing.T) {
@@ -39,6 +43,53 @@ func TestKeyIndex(t *testing.T) {
 	}
 }
 
+func guardianKeysForTest(n int) []common.Address {
+	keys := make([]common.Address, n)
+	for i := range keys {
+		keys[i] = common.HexToAddress(fmt.Sprintf("0x%040x", i+1))
+	}
+	return keys
+}
+
+func TestIndexOfMatchesSlicesIndex(t *testing.T) {
+	keys := guardianKeysForTest(19)
+	// A duplicate key resolves to its first occurrence.
+	keys = append(keys, keys[3])
+
+	indexed := NewGuardianSet(keys, 1)
+	// A set created as a struct literal falls back to a linear search.
+	literal := &GuardianSet{Keys: keys, Index: 1}
+
+	candidates := append(slices.Clone(keys), common.HexToAddress("0xdead"), common.Address{})
+	for _, addr := range candidates {
+		expected := slices.Index(keys, addr)
+		for _, gs := range []*GuardianSet{indexed, literal} {
+			idx, found := gs.IndexOf(addr)
+			assert.Equal(t, expected, idx, addr.Hex())
+			assert.Equal(t, expected != -1, found, addr.Hex())
+		}
+	}
+}
+
+func BenchmarkIndexOf(b *testing.B) {
+	keys := guardianKeysForTest(MaxGuardianCount)
+	last := keys[len(keys)-1]
+
+	b.Run("map", func(b *testing.B) {
+		gs := NewGuardianSet(keys, 1)
+		for i := 0; i < b.N; i++ {
+			gs.IndexOf(last)
+		}
+	})
+
+	b.Run("linear", func(b *testing.B) {
+		gs := &GuardianSet{Keys: keys, Index: 1}
+		for i := 0; i < b.N; i++ {
+			gs.IndexOf(last)
+		}
+	})
+}
+
 func TestKeysAsHexStrings(t *testing.T) {
 	gs := GuardianSet{
 		Keys: []common.Address{
@@ -92,3 +143,67 @@ func TestGet(t *testing.T) {
 	gss.Set(&gs)
 	assert.Equal(t, gss.Get(), &gs)
 }
+
+func TestGetForIndex(t *testing.T) {
+	gs1 := &GuardianSet{Keys: []common.Address{common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")}, Index: 1}
+	gs2 := &GuardianSet{Keys: []common.Address{common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee")}, Index: 2}
+
+	gss := NewGuardianSetState(nil)
+	_, ok := gss.GetForIndex(1)
+	assert.False(t, ok)
+
+	gss.Set(gs1)
+	gss.Set(gs2)
+	assert.Equal(t, gs2, gss.Get())
+
+	gs, ok := gss.GetForIndex(1)
+	assert.True(t, ok)
+	assert.Equal(t, gs1, gs)
+
+	gs, ok = gss.GetForIndex(2)
+	assert.True(t, ok)
+	assert.Equal(t, gs2, gs)
+
+	_, ok = gss.GetForIndex(3)
+	assert.False(t, ok)
+}
+
+func TestGetForIndexExpiry(t *testing.T) {
+	gs1 := &GuardianSet{Keys: []common.Address{common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")}, Index: 1}
+	gs2 := &GuardianSet{Keys: []common.Address{common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee")}, Index: 2}
+
+	gss := NewGuardianSetState(nil)
+	gss.Set(gs1)
+	gss.Set(gs2)
+	replacedAt := gss.replacedAt[1]
+	require.False(t, replacedAt.IsZero())
+
+	// The replaced set is valid until it expires.
+	gs, ok := gss.getForIndexAt(1, replacedAt.Add(GuardianSetExpiry))
+	assert.True(t, ok)
+	assert.Equal(t, gs1, gs)
+
+	_, ok = gss.getForIndexAt(1, replacedAt.Add(GuardianSetExpiry+time.Second))
+	assert.False(t, ok)
+
+	// The current set never expires.
+	gs, ok = gss.getForIndexAt(2, replacedAt.Add(365*24*time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, gs2, gs)
+}
+
+func TestChangedAt(t *testing.T) {
+	gs1 := &GuardianSet{Keys: []common.Address{common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")}, Index: 1}
+	gs2 := &GuardianSet{Keys: []common.Address{common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee")}, Index: 2}
+
+	gss := NewGuardianSetState(nil)
+	gss.Set(gs1)
+	assert.True(t, gss.ChangedAt().IsZero())
+
+	// Setting the current set again is not a change.
+	gss.Set(gs1)
+	assert.True(t, gss.ChangedAt().IsZero())
+
+	gss.Set(gs2)
+	assert.Equal(t, gss.replacedAt[1], gss.ChangedAt())
+}
@@ -52,3 +52,38 @@ func GetOrCreateNodeKey(logger *zap.Logger, path string) (crypto.PrivKey, error)
 
 	return priv, nil
 }
+
+// RotateNodeKey generates a new node key and writes it to path, replacing any existing key. The new key is written to
+// a temporary file first and then renamed into place so a failed rotation never leaves a truncated key behind.
+func RotateNodeKey(logger *zap.Logger, path string) (crypto.PrivKey, error) {
+	priv, _, err := crypto.GenerateKeyPair(crypto.Ed25519, -1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate node key: %w", err)
+	}
+
+	s, err := crypto.MarshalPrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal node key: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, s, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write node key: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, fmt.Errorf("failed to replace node key: %w", err)
+	}
+
+	peerID, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive peer ID from node key: %w", err)
+	}
+
+	logger.Info("Rotated node key",
+		zap.String("path", path),
+		zap.Stringer("peerID", peerID))
+
+	return priv, nil
+}
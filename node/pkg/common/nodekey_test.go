@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -49,3 +51,23 @@ func TestGetOrCreateNodeKeyWithPreExistingPath(t *testing.T) {
 	// Make sure we got the same key
 	assert.Equal(t, privKey1, privKey2)
 }
+
+func TestRotateNodeKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.key")
+
+	logger := zap.NewNop()
+	oldKey, err := GetOrCreateNodeKey(logger, path)
+	require.NoError(t, err)
+
+	newKey, err := RotateNodeKey(logger, path)
+	require.NoError(t, err)
+	assert.False(t, oldKey.Equals(newKey))
+
+	// The rotated key should be what is now persisted on disk.
+	persistedKey, err := GetOrCreateNodeKey(logger, path)
+	require.NoError(t, err)
+	assert.True(t, newKey.Equals(persistedKey))
+
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+}
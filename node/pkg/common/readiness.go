@@ -10,6 +10,7 @@ import (
 const (
 	ReadinessEthSyncing readiness.Component = "ethSyncing"
 	ReadinessIBCSyncing readiness.Component = "IBCSyncing"
+	ReadinessDB         readiness.Component = "db"
 )
 
 // MustRegisterReadinessSyncing registers the specified chain for readiness syncing. It panics if the chain ID is invalid so it should only be used during initialization.
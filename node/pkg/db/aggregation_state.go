@@ -0,0 +1,184 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// aggregationStatePrefix is the key prefix of persisted aggregation state entries. The digest is appended to it.
+const aggregationStatePrefix = "AGG:"
+
+// aggregationStateSignatureLength is the length of a guardian signature on an observation.
+const aggregationStateSignatureLength = 65
+
+// AggregationState is the persisted form of the signatures the processor collected for an observation that has not reached quorum yet.
+type AggregationState struct {
+	Digest        ethcommon.Hash
+	FirstObserved time.Time
+	Source        string
+	Signatures    map[ethcommon.Address][]byte
+}
+
+func aggregationStateKey(digest ethcommon.Hash) []byte {
+	return append([]byte(aggregationStatePrefix), digest.Bytes()...)
+}
+
+func (a *AggregationState) Marshal() ([]byte, error) {
+	if len(a.Source) > 255 {
+		return nil, fmt.Errorf("source is too long: %d", len(a.Source))
+	}
+	if len(a.Signatures) > 255 {
+		return nil, fmt.Errorf("too many signatures: %d", len(a.Signatures))
+	}
+
+	buf := new(bytes.Buffer)
+	buf.Write(a.Digest.Bytes())
+	vaa.MustWrite(buf, binary.BigEndian, a.FirstObserved.UnixNano())
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(a.Source)))
+	buf.WriteString(a.Source)
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(a.Signatures)))
+	for addr, sig := range a.Signatures {
+		if len(sig) != aggregationStateSignatureLength {
+			return nil, fmt.Errorf("invalid signature length %d for %s", len(sig), addr.Hex())
+		}
+		buf.Write(addr.Bytes())
+		buf.Write(sig)
+	}
+	return buf.Bytes(), nil
+}
+
+func UnmarshalAggregationState(data []byte) (*AggregationState, error) {
+	a := &AggregationState{}
+	reader := bytes.NewReader(data)
+
+	if n, err := io.ReadFull(reader, a.Digest[:]); err != nil {
+		return nil, fmt.Errorf("failed to read digest [%d]: %w", n, err)
+	}
+
+	var unixNanos int64
+	if err := binary.Read(reader, binary.BigEndian, &unixNanos); err != nil {
+		return nil, fmt.Errorf("failed to read first observed time: %w", err)
+	}
+	a.FirstObserved = time.Unix(0, unixNanos)
+
+	var sourceLen uint8
+	if err := binary.Read(reader, binary.BigEndian, &sourceLen); err != nil {
+		return nil, fmt.Errorf("failed to read source length: %w", err)
+	}
+	source := make([]byte, sourceLen)
+	if n, err := io.ReadFull(reader, source); err != nil {
+		return nil, fmt.Errorf("failed to read source [%d]: %w", n, err)
+	}
+	a.Source = string(source)
+
+	var numSigs uint8
+	if err := binary.Read(reader, binary.BigEndian, &numSigs); err != nil {
+		return nil, fmt.Errorf("failed to read number of signatures: %w", err)
+	}
+	a.Signatures = make(map[ethcommon.Address][]byte, numSigs)
+	for i := 0; i < int(numSigs); i++ {
+		var addr ethcommon.Address
+		if n, err := io.ReadFull(reader, addr[:]); err != nil {
+			return nil, fmt.Errorf("failed to read address of signature %d [%d]: %w", i, n, err)
+		}
+		sig := make([]byte, aggregationStateSignatureLength)
+		if n, err := io.ReadFull(reader, sig); err != nil {
+			return nil, fmt.Errorf("failed to read signature %d [%d]: %w", i, n, err)
+		}
+		a.Signatures[addr] = sig
+	}
+
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("unexpected %d bytes after aggregation state", reader.Len())
+	}
+
+	return a, nil
+}
+
+// StoreAggregationState replaces all of the persisted aggregation state with the specified entries.
+func (d *Database) StoreAggregationState(states []*AggregationState) error {
+	var oldKeys [][]byte
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = []byte(aggregationStatePrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			oldKeys = append(oldKeys, it.Item().KeyCopy(nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read old aggregation state: %w", err)
+	}
+
+	newDigests := make(map[ethcommon.Hash]struct{}, len(states))
+	for _, a := range states {
+		newDigests[a.Digest] = struct{}{}
+	}
+
+	wb := d.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range oldKeys {
+		if _, exists := newDigests[ethcommon.BytesToHash(key[len(aggregationStatePrefix):])]; exists {
+			continue
+		}
+		if err := wb.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete old aggregation state: %w", err)
+		}
+	}
+	for _, a := range states {
+		b, err := a.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal aggregation state for %s: %w", a.Digest.Hex(), err)
+		}
+		if err := wb.Set(aggregationStateKey(a.Digest), b); err != nil {
+			return fmt.Errorf("failed to write aggregation state for %s: %w", a.Digest.Hex(), err)
+		}
+	}
+	err = wb.Flush()
+	d.updateReadiness(err)
+	if err != nil {
+		return fmt.Errorf("failed to commit aggregation state: %w", err)
+	}
+
+	return nil
+}
+
+// GetAggregationState returns all of the persisted aggregation state.
+func (d *Database) GetAggregationState() ([]*AggregationState, error) {
+	states := []*AggregationState{}
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(aggregationStatePrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			a, err := UnmarshalAggregationState(val)
+			if err != nil {
+				return err
+			}
+			states = append(states, a)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationStateMarshalUnmarshal(t *testing.T) {
+	sig := make([]byte, aggregationStateSignatureLength)
+	sig[0] = 7
+
+	a := &AggregationState{
+		Digest:        ethcommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e2"),
+		FirstObserved: time.Unix(0, 1700000000123456789),
+		Source:        "solana",
+		Signatures: map[ethcommon.Address][]byte{
+			ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe"): sig,
+		},
+	}
+
+	b, err := a.Marshal()
+	require.NoError(t, err)
+
+	a2, err := UnmarshalAggregationState(b)
+	require.NoError(t, err)
+	assert.Equal(t, a.Digest, a2.Digest)
+	assert.True(t, a.FirstObserved.Equal(a2.FirstObserved))
+	assert.Equal(t, a.Source, a2.Source)
+	assert.Equal(t, a.Signatures, a2.Signatures)
+
+	_, err = UnmarshalAggregationState(b[:len(b)-1])
+	assert.Error(t, err)
+}
+
+func TestStoreAggregationStateReplacesOldEntries(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	first := &AggregationState{Digest: ethcommon.HexToHash("0x01"), FirstObserved: time.Now(), Signatures: map[ethcommon.Address][]byte{}}
+	second := &AggregationState{Digest: ethcommon.HexToHash("0x02"), FirstObserved: time.Now(), Signatures: map[ethcommon.Address][]byte{}}
+
+	require.NoError(t, db.StoreAggregationState([]*AggregationState{first, second}))
+	require.NoError(t, db.StoreAggregationState([]*AggregationState{second}))
+
+	states, err := db.GetAggregationState()
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, second.Digest, states[0].Digest)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// compressedVAAMarker is prepended to zstd compressed VAAs. Serialized VAAs always start with their version byte (1),
+// so entries written before compression was enabled are still read correctly.
+const compressedVAAMarker byte = 0xff
+
+var (
+	// The zstd encoder and decoder are safe for concurrent use with EncodeAll and DecodeAll.
+	vaaEncoder, _ = zstd.NewWriter(nil)
+	vaaDecoder, _ = zstd.NewReader(nil)
+)
+
+// SetVAACompression enables or disables zstd compression of newly stored VAAs. Existing entries are read
+// correctly regardless of this setting.
+func (d *Database) SetVAACompression(enabled bool) {
+	d.compressVAAs = enabled
+}
+
+// encodeVAAValue returns the value to be stored for the given serialized VAA.
+func (d *Database) encodeVAAValue(b []byte) []byte {
+	if !d.compressVAAs {
+		return b
+	}
+	return vaaEncoder.EncodeAll(b, []byte{compressedVAAMarker})
+}
+
+// decodeVAAValue returns the serialized VAA for a stored value, decompressing it if necessary.
+func decodeVAAValue(val []byte) ([]byte, error) {
+	if len(val) == 0 {
+		return nil, errors.New("empty VAA value")
+	}
+	if val[0] != compressedVAAMarker {
+		return val, nil
+	}
+	b, err := vaaDecoder.DecodeAll(val[1:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress VAA: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVAACompression(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	// Store an uncompressed VAA at sequence 1.
+	uncompressedVaa := getVAA()
+	uncompressedVaa.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&uncompressedVaa))
+
+	// Enable compression and store another VAA at sequence 3.
+	db.SetVAACompression(true)
+	compressedVaa := getVAA()
+	compressedVaa.Sequence = 3
+	compressedVaa.Payload = make([]byte, 1000)
+	compressedVaa.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&compressedVaa))
+
+	// The raw values should only be compressed for the second VAA.
+	rawValue := func(id *VAAID) []byte {
+		var val []byte
+		require.NoError(t, db.db.View(func(txn *badger.Txn) error {
+			item, err := txn.Get(id.Bytes())
+			if err != nil {
+				return err
+			}
+			val, err = item.ValueCopy(nil)
+			return err
+		}))
+		return val
+	}
+	uncompressedBytes, err := uncompressedVaa.Marshal()
+	require.NoError(t, err)
+	compressedBytes, err := compressedVaa.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, uncompressedBytes, rawValue(VaaIDFromVAA(&uncompressedVaa)))
+	compressedRaw := rawValue(VaaIDFromVAA(&compressedVaa))
+	assert.Equal(t, compressedVAAMarker, compressedRaw[0])
+	assert.Less(t, len(compressedRaw), len(compressedBytes))
+
+	// Both entries read back identically, regardless of the current setting.
+	for _, enabled := range []bool{true, false} {
+		db.SetVAACompression(enabled)
+
+		b, err := db.GetSignedVAABytes(*VaaIDFromVAA(&uncompressedVaa))
+		require.NoError(t, err)
+		assert.Equal(t, uncompressedBytes, b)
+
+		b, err = db.GetSignedVAABytes(*VaaIDFromVAA(&compressedVaa))
+		require.NoError(t, err)
+		assert.Equal(t, compressedBytes, b)
+	}
+
+	// Iterating over the entries decodes both formats.
+	gaps, firstSeq, lastSeq, err := db.FindEmitterSequenceGap(*VaaIDFromVAA(&uncompressedVaa))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 2}, gaps)
+	assert.Equal(t, uint64(0), firstSeq)
+	assert.Equal(t, uint64(3), lastSeq)
+}
+
+func TestDecodeVAAValue(t *testing.T) {
+	_, err := decodeVAAValue(nil)
+	assert.ErrorContains(t, err, "empty VAA value")
+
+	_, err = decodeVAAValue([]byte{compressedVAAMarker, 1, 2, 3})
+	assert.ErrorContains(t, err, "failed to decompress VAA")
+}
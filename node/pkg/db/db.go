@@ -1,10 +1,12 @@
 package db
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/prometheus/client_golang/prometheus"
@@ -20,6 +22,15 @@ var storedVaaTotal = promauto.NewCounter(
 
 type Database struct {
 	db *badger.DB
+
+	// readinessRegistered is set once the database has been registered as a readiness component.
+	readinessRegistered atomic.Bool
+
+	// compressVAAs enables zstd compression of stored VAAs. See SetVAACompression.
+	compressVAAs bool
+
+	// sizeLimit is the optional limit on the size of the database. See SetSizeLimit.
+	sizeLimit *sizeLimit
 }
 
 type VAAID struct {
@@ -28,35 +39,48 @@ type VAAID struct {
 	Sequence       uint64
 }
 
-// VaaIDFromString parses a <chain>/<address>/<sequence> string into a VAAID.
-func VaaIDFromString(s string) (*VAAID, error) {
+// ParseVAAID parses a <chain>/<address>/<sequence> string, as produced by VAAID.String, into a VAAID. The chain must be
+// a decimal uint16, the address exactly 32 bytes of hex and the sequence a decimal uint64.
+func ParseVAAID(s string) (VAAID, error) {
 	parts := strings.Split(s, "/")
 	if len(parts) != 3 {
-		return nil, errors.New("invalid message id")
+		return VAAID{}, fmt.Errorf("invalid message id %q, expected chainId/emitterAddress/sequence", s)
 	}
 
 	emitterChain, err := strconv.ParseUint(parts[0], 10, 16)
 	if err != nil {
-		return nil, fmt.Errorf("invalid emitter chain: %s", err)
+		return VAAID{}, fmt.Errorf("invalid emitter chain: %s", err)
 	}
 
-	emitterAddress, err := vaa.StringToAddress(parts[1])
+	if len(parts[1]) != 2*len(vaa.Address{}) {
+		return VAAID{}, fmt.Errorf("invalid emitter address: must be %d hex characters, got %d", 2*len(vaa.Address{}), len(parts[1]))
+	}
+	b, err := hex.DecodeString(parts[1])
 	if err != nil {
-		return nil, fmt.Errorf("invalid emitter address: %s", err)
+		return VAAID{}, fmt.Errorf("invalid emitter address: %s", err)
 	}
+	var emitterAddress vaa.Address
+	copy(emitterAddress[:], b)
 
 	sequence, err := strconv.ParseUint(parts[2], 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("invalid sequence: %s", err)
+		return VAAID{}, fmt.Errorf("invalid sequence: %s", err)
 	}
 
-	msgId := &VAAID{
+	return VAAID{
 		EmitterChain:   vaa.ChainID(emitterChain),
 		EmitterAddress: emitterAddress,
 		Sequence:       sequence,
-	}
+	}, nil
+}
 
-	return msgId, nil
+// VaaIDFromString is like ParseVAAID, but returns a pointer.
+func VaaIDFromString(s string) (*VAAID, error) {
+	id, err := ParseVAAID(s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
 }
 
 func VaaIDFromVAA(v *vaa.VAA) *VAAID {
@@ -72,8 +96,14 @@ var (
 	nullAddr       = vaa.Address{}
 )
 
+// String returns the <chain>/<address>/<sequence> form of the ID, with the chain and sequence in decimal and the address
+// in lowercase hex. It is the inverse of ParseVAAID.
+func (i *VAAID) String() string {
+	return fmt.Sprintf("%d/%s/%d", uint16(i.EmitterChain), i.EmitterAddress, i.Sequence)
+}
+
 func (i *VAAID) Bytes() []byte {
-	return []byte(fmt.Sprintf("signed/%d/%s/%d", i.EmitterChain, i.EmitterAddress, i.Sequence))
+	return []byte("signed/" + i.String())
 }
 
 func (i *VAAID) EmitterPrefixBytes() []byte {
@@ -89,9 +119,14 @@ func Open(path string) (*Database, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
-	return &Database{
+	d := &Database{
 		db: db,
-	}, nil
+	}
+	if err := d.migrateToCurrent(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+	return d, nil
 }
 
 func (d *Database) Close() error {
@@ -103,6 +138,10 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 		panic("StoreSignedVAA called for unsigned VAA")
 	}
 
+	if err := d.checkSizeLimit(v); err != nil {
+		return err
+	}
+
 	b, _ := v.Marshal()
 
 	// We allow overriding of existing VAAs, since there are multiple ways to
@@ -112,13 +151,23 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 	//
 	// TODO: panic on non-identical signing digest?
 
+	// The timestamp index entry is written in the same transaction so that the index stays consistent with the VAAs.
+	// A VAA that is overwritten with a different timestamp has its old index entry removed.
+	key := VaaIDFromVAA(v).Bytes()
 	err := d.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Set(VaaIDFromVAA(v).Bytes(), b); err != nil {
+		if err := deleteReplacedVaaTimestampIndexKey(txn, key, v.Timestamp); err != nil {
+			return err
+		}
+		if err := txn.Set(key, d.encodeVAAValue(b)); err != nil {
+			return err
+		}
+		if err := txn.Set(vaaTimestampIndexKey(v.Timestamp, key), nil); err != nil {
 			return err
 		}
 		return nil
 	})
 
+	d.updateReadiness(err)
 	if err != nil {
 		return fmt.Errorf("failed to commit tx: %w", err)
 	}
@@ -148,12 +197,12 @@ func (d *Database) GetSignedVAABytes(id VAAID) (b []byte, err error) {
 		if err != nil {
 			return err
 		}
-		if val, err := item.ValueCopy(nil); err != nil {
+		val, err := item.ValueCopy(nil)
+		if err != nil {
 			return err
-		} else {
-			b = val
 		}
-		return nil
+		b, err = decodeVAAValue(val)
+		return err
 	}); err != nil {
 		if err == badger.ErrKeyNotFound {
 			return nil, ErrVAANotFound
@@ -177,7 +226,11 @@ func (d *Database) FindEmitterSequenceGap(prefix VAAID) (resp []uint64, firstSeq
 			item := it.Item()
 			key := item.Key()
 			err := item.Value(func(val []byte) error {
-				v, err := vaa.Unmarshal(val)
+				b, err := decodeVAAValue(val)
+				if err != nil {
+					return fmt.Errorf("failed to decode VAA for %s: %v", string(key), err)
+				}
+				v, err := vaa.Unmarshal(b)
 				if err != nil {
 					return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(key), err)
 				}
@@ -219,3 +272,108 @@ func (d *Database) FindEmitterSequenceGap(prefix VAAID) (resp []uint64, firstSeq
 	}
 	return
 }
+
+// GapReport describes the stored sequence range for a single emitter and the sequences missing from it.
+type GapReport struct {
+	FirstSeq    uint64
+	LastSeq     uint64
+	MissingSeqs []uint64
+}
+
+// FindAllSequenceGaps does a single pass over the stored VAAs and returns a gap report for each of the specified emitters,
+// keyed by "<chain>/<address>". Only the emitter chain and address of the VAA IDs are used. Emitters with no stored VAAs
+// are not included in the result.
+func (d *Database) FindAllSequenceGaps(emitters []VAAID) (map[string]GapReport, error) {
+	wanted := make(map[string]struct{}, len(emitters))
+	for _, e := range emitters {
+		wanted[fmt.Sprintf("%d/%s", e.EmitterChain, e.EmitterAddress)] = struct{}{}
+	}
+
+	seqsByEmitter := make(map[string]map[uint64]struct{})
+	if err := d.db.View(func(txn *badger.Txn) error {
+		// The sequence is part of the key, so there is no need to fetch the values.
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		prefix := []byte("signed/")
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := string(it.Item().Key())
+			id, err := VaaIDFromString(strings.TrimPrefix(key, "signed/"))
+			if err != nil {
+				return fmt.Errorf("failed to parse key %s: %w", key, err)
+			}
+
+			emitter := fmt.Sprintf("%d/%s", id.EmitterChain, id.EmitterAddress)
+			if _, exists := wanted[emitter]; !exists {
+				continue
+			}
+
+			seqs, exists := seqsByEmitter[emitter]
+			if !exists {
+				seqs = make(map[uint64]struct{})
+				seqsByEmitter[emitter] = seqs
+			}
+			seqs[id.Sequence] = struct{}{}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	resp := make(map[string]GapReport, len(seqsByEmitter))
+	for emitter, seqs := range seqsByEmitter {
+		report := GapReport{MissingSeqs: make([]uint64, 0)}
+		first := true
+		for seq := range seqs {
+			if first || seq < report.FirstSeq {
+				report.FirstSeq = seq
+			}
+			if first || seq > report.LastSeq {
+				report.LastSeq = seq
+			}
+			first = false
+		}
+
+		for seq := report.FirstSeq; seq <= report.LastSeq; seq++ {
+			if _, exists := seqs[seq]; !exists {
+				report.MissingSeqs = append(report.MissingSeqs, seq)
+			}
+		}
+
+		resp[emitter] = report
+	}
+
+	return resp, nil
+}
+
+// CountVAAsByEmitter does a single pass over the stored VAAs and returns the number of VAAs stored for each emitter, keyed
+// by "<chain>/<address>".
+func (d *Database) CountVAAsByEmitter() (map[string]uint64, error) {
+	counts := make(map[string]uint64)
+	if err := d.db.View(func(txn *badger.Txn) error {
+		// The emitter is part of the key, so there is no need to fetch the values.
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		prefix := []byte("signed/")
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := string(it.Item().Key())
+			idx := strings.LastIndex(key, "/")
+			if idx <= len(prefix) {
+				return fmt.Errorf("invalid key %s", key)
+			}
+			counts[key[len(prefix):idx]]++
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
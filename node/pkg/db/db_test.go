@@ -60,6 +60,60 @@ func TestVaaIDFromVAA(t *testing.T) {
 	assert.Equal(t, uint64(1), vaaID.Sequence)
 }
 
+func TestParseVAAID(t *testing.T) {
+	const emitter = "000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7"
+	expectedEmitter, err := vaa.StringToAddress(emitter)
+	require.NoError(t, err)
+
+	id, err := ParseVAAID("2/" + emitter + "/12345")
+	require.NoError(t, err)
+	assert.Equal(t, VAAID{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: expectedEmitter, Sequence: 12345}, id)
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "empty", input: "", err: "expected chainId/emitterAddress/sequence"},
+		{name: "too few parts", input: "2/" + emitter, err: "expected chainId/emitterAddress/sequence"},
+		{name: "too many parts", input: "2/" + emitter + "/1/2", err: "expected chainId/emitterAddress/sequence"},
+		{name: "chain not decimal", input: "0x2/" + emitter + "/1", err: "invalid emitter chain"},
+		{name: "chain out of range", input: "65536/" + emitter + "/1", err: "invalid emitter chain"},
+		{name: "negative chain", input: "-1/" + emitter + "/1", err: "invalid emitter chain"},
+		{name: "emitter too short", input: "2/b6f6d86a8f9879a9c87f643768d9efc38c1da6e7/1", err: "must be 64 hex characters"},
+		{name: "emitter with 0x prefix", input: "2/0x" + emitter[2:] + "/1", err: "invalid emitter address"},
+		{name: "emitter not hex", input: "2/" + emitter[:63] + "z/1", err: "invalid emitter address"},
+		{name: "sequence not decimal", input: "2/" + emitter + "/abc", err: "invalid sequence"},
+		{name: "sequence out of range", input: "2/" + emitter + "/18446744073709551616", err: "invalid sequence"},
+		{name: "empty sequence", input: "2/" + emitter + "/", err: "invalid sequence"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseVAAID(tc.input)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestVaaIDStringRoundTrip(t *testing.T) {
+	emitter, err := vaa.StringToAddress("000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7")
+	require.NoError(t, err)
+	id := VAAID{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, Sequence: 12345}
+
+	s := id.String()
+	assert.Equal(t, "2/000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7/12345", s)
+
+	parsed, err := VaaIDFromString(s)
+	require.NoError(t, err)
+	assert.Equal(t, id, *parsed)
+
+	// The database key and the message ID of the corresponding VAA use the same format.
+	assert.Equal(t, "signed/"+s, string(id.Bytes()))
+	v := &vaa.VAA{EmitterChain: id.EmitterChain, EmitterAddress: id.EmitterAddress, Sequence: id.Sequence}
+	assert.Equal(t, v.MessageID(), s)
+}
+
 func TestBytes(t *testing.T) {
 	vaaIdString := "1/0000000000000000000000000000000000000000000000000000000000000004/1"
 	vaaID, _ := VaaIDFromString(vaaIdString)
@@ -172,6 +226,90 @@ func TestFindEmitterSequenceGap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFindAllSequenceGaps(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	storeSeqs := func(chain vaa.ChainID, addr vaa.Address, seqs ...uint64) {
+		for _, seq := range seqs {
+			v := getVAA()
+			v.EmitterChain = chain
+			v.EmitterAddress = addr
+			v.Sequence = seq
+			v.AddSignature(privKey, 0)
+			require.NoError(t, db.StoreSignedVAA(&v))
+		}
+	}
+
+	addr1 := vaa.Address{1}
+	addr2 := vaa.Address{2}
+	addr3 := vaa.Address{3}
+
+	// Sequences 9 and 10 sort before 2 lexicographically, which the scan must handle.
+	storeSeqs(vaa.ChainIDSolana, addr1, 2, 3, 5, 9, 10)
+	storeSeqs(vaa.ChainIDEthereum, addr2, 100, 104)
+	storeSeqs(vaa.ChainIDEthereum, addr1, 7, 8)
+
+	// This one is not requested, so should not show up in the results.
+	storeSeqs(vaa.ChainIDBSC, addr3, 1, 3)
+
+	emitters := []VAAID{
+		{EmitterChain: vaa.ChainIDSolana, EmitterAddress: addr1},
+		{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: addr2},
+		{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: addr1},
+		{EmitterChain: vaa.ChainIDPolygon, EmitterAddress: addr1},
+	}
+
+	resp, err := db.FindAllSequenceGaps(emitters)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp))
+
+	assert.Equal(t, GapReport{FirstSeq: 2, LastSeq: 10, MissingSeqs: []uint64{4, 6, 7, 8}}, resp[fmt.Sprintf("%d/%s", vaa.ChainIDSolana, addr1)])
+	assert.Equal(t, GapReport{FirstSeq: 100, LastSeq: 104, MissingSeqs: []uint64{101, 102, 103}}, resp[fmt.Sprintf("%d/%s", vaa.ChainIDEthereum, addr2)])
+	assert.Equal(t, GapReport{FirstSeq: 7, LastSeq: 8, MissingSeqs: []uint64{}}, resp[fmt.Sprintf("%d/%s", vaa.ChainIDEthereum, addr1)])
+}
+
+func TestCountVAAsByEmitter(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	counts, err := db.CountVAAsByEmitter()
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	storeSeqs := func(chain vaa.ChainID, addr vaa.Address, seqs ...uint64) {
+		for _, seq := range seqs {
+			v := getVAA()
+			v.EmitterChain = chain
+			v.EmitterAddress = addr
+			v.Sequence = seq
+			v.AddSignature(privKey, 0)
+			require.NoError(t, db.StoreSignedVAA(&v))
+		}
+	}
+
+	addr1 := vaa.Address{1}
+	addr2 := vaa.Address{2}
+
+	storeSeqs(vaa.ChainIDSolana, addr1, 1, 2, 3)
+	storeSeqs(vaa.ChainIDEthereum, addr1, 1)
+	storeSeqs(vaa.ChainIDEthereum, addr2, 5, 6)
+	// Overwriting a VAA does not count twice.
+	storeSeqs(vaa.ChainIDEthereum, addr2, 6)
+
+	counts, err = db.CountVAAsByEmitter()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{
+		fmt.Sprintf("%d/%s", vaa.ChainIDSolana, addr1):   3,
+		fmt.Sprintf("%d/%s", vaa.ChainIDEthereum, addr1): 1,
+		fmt.Sprintf("%d/%s", vaa.ChainIDEthereum, addr2): 2,
+	}, counts)
+}
+
 // BenchmarkVaaLookup benchmarks db.GetSignedVAABytes
 // You need to set the environment variable WH_DBPATH to a path with a populated BadgerDB.
 // You may want to play with the CONCURRENCY parameter.
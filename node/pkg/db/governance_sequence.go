@@ -0,0 +1,92 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// governanceSequenceKey stores the highest sequence used for a governance VAA injected on this node as a big endian uint64.
+var governanceSequenceKey = []byte("GOVSEQ:last")
+
+func getGovernanceSequence(txn *badger.Txn) (uint64, error) {
+	item, err := txn.Get(governanceSequenceKey)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	val, err := item.ValueCopy(nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(val) != 8 {
+		return 0, fmt.Errorf("invalid governance sequence length: %d", len(val))
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+func setGovernanceSequence(txn *badger.Txn, sequence uint64) error {
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, sequence)
+	return txn.Set(governanceSequenceKey, val)
+}
+
+// ReserveGovernanceSequences reserves count consecutive governance sequences above any previously reserved or recorded one
+// in a single transaction and returns the first of them. If check is not nil, it is called with the first sequence before the
+// reservation is written, and an error it returns aborts the reservation and is returned as is.
+func (d *Database) ReserveGovernanceSequences(count int, check func(first uint64) error) (uint64, error) {
+	if count <= 0 {
+		return 0, fmt.Errorf("invalid governance sequence count: %d", count)
+	}
+
+	var (
+		first    uint64
+		checkErr error
+	)
+	err := d.db.Update(func(txn *badger.Txn) error {
+		last, err := getGovernanceSequence(txn)
+		if err != nil {
+			return err
+		}
+		if last > ^uint64(0)-uint64(count) {
+			return errors.New("governance sequence exhausted")
+		}
+		first = last + 1
+		if check != nil {
+			if checkErr = check(first); checkErr != nil {
+				return checkErr
+			}
+		}
+		return setGovernanceSequence(txn, last+uint64(count))
+	})
+	if checkErr != nil {
+		return 0, checkErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve governance sequences: %w", err)
+	}
+	return first, nil
+}
+
+// RecordGovernanceSequence records an explicitly chosen governance sequence, so that ReserveGovernanceSequences does not hand
+// it out again. Sequences below the last one used do not change the counter.
+func (d *Database) RecordGovernanceSequence(sequence uint64) error {
+	err := d.db.Update(func(txn *badger.Txn) error {
+		last, err := getGovernanceSequence(txn)
+		if err != nil {
+			return err
+		}
+		if sequence <= last {
+			return nil
+		}
+		return setGovernanceSequence(txn, sequence)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record governance sequence: %w", err)
+	}
+	return nil
+}
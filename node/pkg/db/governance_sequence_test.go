@@ -0,0 +1,53 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceSequence(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	require.NoError(t, err)
+
+	seq, err := db.ReserveGovernanceSequences(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), seq)
+
+	// A batch is reserved as a consecutive range.
+	seq, err = db.ReserveGovernanceSequences(3, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), seq)
+
+	_, err = db.ReserveGovernanceSequences(0, nil)
+	require.Error(t, err)
+
+	// A failed check leaves the counter unchanged.
+	checkErr := errors.New("check failed")
+	_, err = db.ReserveGovernanceSequences(2, func(first uint64) error {
+		assert.Equal(t, uint64(5), first)
+		return checkErr
+	})
+	require.ErrorIs(t, err, checkErr)
+
+	// An explicit sequence moves the counter forward, a lower one does not move it back.
+	require.NoError(t, db.RecordGovernanceSequence(100))
+	require.NoError(t, db.RecordGovernanceSequence(50))
+
+	seq, err = db.ReserveGovernanceSequences(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(101), seq)
+	require.NoError(t, db.Close())
+
+	// The counter survives a restart.
+	db, err = Open(dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	seq, err = db.ReserveGovernanceSequences(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(102), seq)
+}
@@ -55,6 +55,10 @@ type Transfer struct {
 	Hash           string
 	TargetAddress  vaa.Address
 	TargetChain    vaa.ChainID
+
+	// HashWithoutConsistencyLevel is the hash of the message with its consistency level zeroed, which is the same for a
+	// transfer observed at different consistency levels. It is empty for transfers stored before it was added.
+	HashWithoutConsistencyLevel string
 }
 
 func (t *Transfer) Marshal() ([]byte, error) {
@@ -76,6 +80,11 @@ func (t *Transfer) Marshal() ([]byte, error) {
 	}
 	vaa.MustWrite(buf, binary.BigEndian, t.TargetChain)
 	buf.Write(t.TargetAddress[:])
+	// Optional trailing field, older versions ignore it.
+	if len(t.HashWithoutConsistencyLevel) > 0 {
+		vaa.MustWrite(buf, binary.BigEndian, uint16(len(t.HashWithoutConsistencyLevel)))
+		buf.Write([]byte(t.HashWithoutConsistencyLevel))
+	}
 	return buf.Bytes(), nil
 }
 
@@ -152,6 +161,20 @@ func UnmarshalTransfer(data []byte) (*Transfer, error) {
 	}
 	t.TargetAddress = targetAddress
 
+	if reader.Len() != 0 {
+		hashWithoutConsistencyLevelLen := uint16(0)
+		if err := binary.Read(reader, binary.BigEndian, &hashWithoutConsistencyLevelLen); err != nil {
+			return nil, fmt.Errorf("failed to read hash without consistency level length: %w", err)
+		}
+
+		hash := make([]byte, hashWithoutConsistencyLevelLen)
+		n, err := reader.Read(hash)
+		if err != nil || n != int(hashWithoutConsistencyLevelLen) {
+			return nil, fmt.Errorf("failed to read hash without consistency level [%d]: %w", n, err)
+		}
+		t.HashWithoutConsistencyLevel = string(hash[:n])
+	}
+
 	return t, nil
 }
 
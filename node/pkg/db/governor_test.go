@@ -58,6 +58,15 @@ func TestSerializeAndDeserializeOfTransfer(t *testing.T) {
 
 	expectedTransferKey := "GOV:XFER3:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
 	assert.Equal(t, expectedTransferKey, string(TransferMsgID(xfer2)))
+
+	// The hash without consistency level is optional, so transfers stored without it can still be read.
+	xfer1.HashWithoutConsistencyLevel = "Hash2"
+	bytesWithHash, err := xfer1.Marshal()
+	require.NoError(t, err)
+
+	xfer3, err := UnmarshalTransfer(bytesWithHash)
+	require.NoError(t, err)
+	assert.Equal(t, xfer1, xfer3)
 }
 
 func TestPendingMsgID(t *testing.T) {
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/dgraph-io/badger/v3"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var ErrGuardianSetNotFound = errors.New("no guardian set found in store")
+
+// guardianSetPrefix is the key prefix of stored guardian sets. The index is appended in big endian so that keys sort by index.
+const guardianSetPrefix = "GS:"
+
+func guardianSetKey(index uint32) []byte {
+	key := make([]byte, len(guardianSetPrefix)+4)
+	copy(key, guardianSetPrefix)
+	binary.BigEndian.PutUint32(key[len(guardianSetPrefix):], index)
+	return key
+}
+
+// StoreGuardianSet persists a guardian set, keyed by its index. Storing a set with an existing index overwrites it.
+func (d *Database) StoreGuardianSet(gs *common.GuardianSet) error {
+	b := make([]byte, 0, len(gs.Keys)*ethcommon.AddressLength)
+	for _, k := range gs.Keys {
+		b = append(b, k.Bytes()...)
+	}
+
+	err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(guardianSetKey(gs.Index), b)
+	})
+	d.updateReadiness(err)
+	if err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// GetLatestGuardianSet returns the stored guardian set with the highest index, or ErrGuardianSetNotFound if none is stored.
+func (d *Database) GetLatestGuardianSet() (*common.GuardianSet, error) {
+	var gs *common.GuardianSet
+
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Reverse = true
+		opts.Prefix = []byte(guardianSetPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		// In reverse mode, seek to the largest possible key with this prefix.
+		it.Seek(guardianSetKey(^uint32(0)))
+		if !it.ValidForPrefix(opts.Prefix) {
+			return ErrGuardianSetNotFound
+		}
+
+		item := it.Item()
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		if len(val)%ethcommon.AddressLength != 0 {
+			return fmt.Errorf("invalid guardian set length %d", len(val))
+		}
+
+		keys := make([]ethcommon.Address, 0, len(val)/ethcommon.AddressLength)
+		for i := 0; i < len(val); i += ethcommon.AddressLength {
+			keys = append(keys, ethcommon.BytesToAddress(val[i:i+ethcommon.AddressLength]))
+		}
+
+		gs = common.NewGuardianSet(keys, binary.BigEndian.Uint32(item.Key()[len(guardianSetPrefix):]))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
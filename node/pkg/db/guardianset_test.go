@@ -0,0 +1,33 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreAndGetLatestGuardianSet(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.GetLatestGuardianSet()
+	assert.ErrorIs(t, err, ErrGuardianSetNotFound)
+
+	gs1 := common.NewGuardianSet([]ethcommon.Address{ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")}, 1)
+	gs2 := common.NewGuardianSet([]ethcommon.Address{
+		ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe"),
+		ethcommon.HexToAddress("0x88D7D8B32a9105d228100E72dFFe2Fae0705D31c"),
+	}, 256)
+
+	// Store out of order to make sure the highest index is returned rather than the last written.
+	require.NoError(t, db.StoreGuardianSet(gs2))
+	require.NoError(t, db.StoreGuardianSet(gs1))
+
+	latest, err := db.GetLatestGuardianSet()
+	require.NoError(t, err)
+	assert.Equal(t, gs2, latest)
+}
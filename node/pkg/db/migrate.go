@@ -0,0 +1,96 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// CurrentSchemaVersion is the version of the key layout written by this release. Bump it and add an entry to
+// migrations whenever the layout changes.
+const CurrentSchemaVersion = 2
+
+// schemaVersionKey stores the schema version of the database as a big endian uint32.
+// Databases created before versioning was introduced have no such key and are treated as version 0.
+var schemaVersionKey = []byte("SCHEMA:version")
+
+// migrations maps a schema version to the function that migrates the database from that version to the next one.
+var migrations = map[int]func(d *Database) error{
+	// Version 1 only introduces the schema version key itself, so there is nothing to re-key.
+	0: func(d *Database) error { return nil },
+	// Version 2 adds the timestamp index of stored VAAs.
+	1: (*Database).buildVaaTimestampIndex,
+}
+
+// SchemaVersion returns the schema version stored in the database, or zero if none is stored.
+func (d *Database) SchemaVersion() (int, error) {
+	var version int
+	err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(schemaVersionKey)
+		if err != nil {
+			return err
+		}
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		if len(val) != 4 {
+			return fmt.Errorf("invalid schema version length: %d", len(val))
+		}
+		version = int(binary.BigEndian.Uint32(val))
+		return nil
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+func (d *Database) storeSchemaVersion(version int) error {
+	val := make([]byte, 4)
+	binary.BigEndian.PutUint32(val, uint32(version))
+	return d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(schemaVersionKey, val)
+	})
+}
+
+// Migrate runs the migrations required to bring the database from fromVersion to toVersion, recording the new
+// schema version after each step so that an interrupted migration resumes where it left off. It is a no-op if
+// the versions are equal. Downgrades are not supported.
+func (d *Database) Migrate(fromVersion, toVersion int) error {
+	if fromVersion > toVersion {
+		return fmt.Errorf("cannot migrate database from schema version %d down to %d", fromVersion, toVersion)
+	}
+
+	for version := fromVersion; version < toVersion; version++ {
+		migrate, exists := migrations[version]
+		if !exists {
+			return fmt.Errorf("no migration from schema version %d", version)
+		}
+		if err := migrate(d); err != nil {
+			return fmt.Errorf("failed to migrate from schema version %d: %w", version, err)
+		}
+		if err := d.storeSchemaVersion(version + 1); err != nil {
+			return fmt.Errorf("failed to store schema version %d: %w", version+1, err)
+		}
+	}
+
+	return nil
+}
+
+// migrateToCurrent brings the database to CurrentSchemaVersion. It is called whenever the database is opened.
+func (d *Database) migrateToCurrent() error {
+	version, err := d.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if version > CurrentSchemaVersion {
+		return fmt.Errorf("database schema version %d is newer than the supported version %d", version, CurrentSchemaVersion)
+	}
+	return d.Migrate(version, CurrentSchemaVersion)
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOpenDbWritesSchemaVersion(t *testing.T) {
+	db := OpenDb(zap.NewNop(), nil, DefaultCompression)
+	defer db.Close()
+
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, CurrentSchemaVersion, version)
+}
+
+func TestMigrateLegacyDatabase(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	require.NoError(t, err)
+
+	// Simulate a database created before schema versioning.
+	require.NoError(t, db.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(schemaVersionKey)
+	}))
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+	require.NoError(t, db.Close())
+
+	// Reopening it migrates it to the current version.
+	db, err = Open(dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+	version, err = db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, CurrentSchemaVersion, version)
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, db.Migrate(CurrentSchemaVersion, CurrentSchemaVersion))
+		require.NoError(t, db.migrateToCurrent())
+
+		version, err := db.SchemaVersion()
+		require.NoError(t, err)
+		assert.Equal(t, CurrentSchemaVersion, version)
+	}
+}
+
+func TestMigrateErrors(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	assert.ErrorContains(t, db.Migrate(CurrentSchemaVersion, CurrentSchemaVersion-1), "cannot migrate database from schema version")
+	assert.ErrorContains(t, db.Migrate(CurrentSchemaVersion, CurrentSchemaVersion+1), "no migration from schema version")
+
+	require.NoError(t, db.storeSchemaVersion(CurrentSchemaVersion+1))
+	assert.ErrorContains(t, db.migrateToCurrent(), "is newer than the supported version")
+}
@@ -6,6 +6,7 @@ import (
 	"path"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/badger/v3/options"
 	"go.uber.org/zap"
 )
 
@@ -29,28 +30,78 @@ func (l badgerZapLogger) Debugf(f string, v ...interface{}) {
 	l.Debug(fmt.Sprintf(f, v...))
 }
 
-func OpenDb(logger *zap.Logger, dataDir *string) *Database {
-	var options badger.Options
+// DefaultCompression is the block compression badger uses unless configured otherwise.
+const DefaultCompression = options.Snappy
+
+// ParseCompression parses "none", "snappy" or "zstd" into a badger compression type.
+func ParseCompression(s string) (options.CompressionType, error) {
+	switch s {
+	case "none":
+		return options.None, nil
+	case "snappy":
+		return options.Snappy, nil
+	case "zstd":
+		return options.ZSTD, nil
+	default:
+		return 0, fmt.Errorf(`invalid compression %q, must be "none", "snappy" or "zstd"`, s)
+	}
+}
+
+func OpenDb(logger *zap.Logger, dataDir *string, compression options.CompressionType) *Database {
+	d, err := TryOpenDb(logger, dataDir, compression)
+	if err != nil {
+		logger.Fatal("failed to open database", zap.Error(err))
+	}
+	return d
+}
+
+// TryOpenDb is like OpenDb but returns an error instead of exiting if the database cannot be opened.
+func TryOpenDb(logger *zap.Logger, dataDir *string, compression options.CompressionType) (*Database, error) {
+	var opts badger.Options
 
 	if dataDir != nil {
 		dbPath := path.Join(*dataDir, "db")
 		if err := os.MkdirAll(dbPath, 0700); err != nil {
-			logger.Fatal("failed to create database directory", zap.Error(err))
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
 		}
 
-		options = badger.DefaultOptions(dbPath)
+		opts = badger.DefaultOptions(dbPath)
 	} else {
-		options = badger.DefaultOptions("").WithInMemory(true)
+		opts = badger.DefaultOptions("").WithInMemory(true)
 	}
 
-	options = options.WithLogger(badgerZapLogger{logger})
+	opts = opts.WithLogger(badgerZapLogger{logger}).WithCompression(compression)
 
-	db, err := badger.Open(options)
+	db, err := badger.Open(opts)
 	if err != nil {
-		logger.Fatal("failed to open database", zap.Error(err))
+		return nil, err
 	}
 
-	return &Database{
+	d := &Database{
 		db: db,
 	}
+	if err := d.migrateToCurrent(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	return d, nil
+}
+
+// OpenReadOnlyDb opens the existing database in dataDir without modifying it: the database directory is not created and
+// the schema is not migrated. It fails if the database is in use by a running guardian.
+func OpenReadOnlyDb(logger *zap.Logger, dataDir string) (*Database, error) {
+	dbPath := path.Join(dataDir, "db")
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("failed to find database: %w", err)
+	}
+
+	db, err := badger.Open(badger.DefaultOptions(dbPath).WithLogger(badgerZapLogger{logger}).WithReadOnly(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Database{
+		db: db,
+	}, nil
 }
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/badger/v3/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseCompression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected options.CompressionType
+	}{
+		{input: "none", expected: options.None},
+		{input: "snappy", expected: options.Snappy},
+		{input: "zstd", expected: options.ZSTD},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			compression, err := ParseCompression(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, compression)
+		})
+	}
+
+	_, err := ParseCompression("lz4")
+	assert.ErrorContains(t, err, `invalid compression "lz4"`)
+}
+
+func TestOpenDbAppliesCompression(t *testing.T) {
+	for _, compression := range []options.CompressionType{options.None, options.Snappy, options.ZSTD} {
+		dataDir := t.TempDir()
+		db := OpenDb(zap.NewNop(), &dataDir, compression)
+		assert.Equal(t, compression, db.db.Opts().Compression)
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestTryOpenDbFailsIfLocked(t *testing.T) {
+	dataDir := t.TempDir()
+	db, err := TryOpenDb(zap.NewNop(), &dataDir, DefaultCompression)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = TryOpenDb(zap.NewNop(), &dataDir, DefaultCompression)
+	assert.Error(t, err)
+}
+
+func TestOpenReadOnlyDb(t *testing.T) {
+	dataDir := t.TempDir()
+
+	// A missing database is not created.
+	_, err := OpenReadOnlyDb(zap.NewNop(), dataDir)
+	assert.ErrorContains(t, err, "failed to find database")
+	_, err = os.Stat(path.Join(dataDir, "db"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Make the database look like it predates the schema version.
+	db := OpenDb(zap.NewNop(), &dataDir, DefaultCompression)
+	require.NoError(t, db.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(schemaVersionKey)
+	}))
+
+	// The database cannot be opened while it is in use.
+	_, err = OpenReadOnlyDb(zap.NewNop(), dataDir)
+	assert.Error(t, err)
+	require.NoError(t, db.Close())
+
+	// The schema is not migrated.
+	db, err = OpenReadOnlyDb(zap.NewNop(), dataDir)
+	require.NoError(t, err)
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+	require.NoError(t, db.Close())
+}
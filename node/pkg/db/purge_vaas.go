@@ -1,6 +1,8 @@
 package db
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,16 +13,53 @@ import (
 // This function deletes all VAAs for either the specified chain or specified chain / emitter address
 // that are older than the specified time. If the logOnly flag is specified, it does not delete anything,
 // just counts up what it would have deleted.
+//
+// If the database maintains the timestamp index, only the index entries older than the specified time are visited.
+// Otherwise every VAA for the prefix is read and its timestamp checked.
 
 func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (string, error) {
 	if prefix.Sequence != 0 {
 		return "", fmt.Errorf("may not specify a sequence number on the prefix")
 	}
 
-	numDeleted := 0
-	numKept := 0
+	hasIndex, err := d.hasVaaTimestampIndex()
+	if err != nil {
+		return "", err
+	}
+
+	var numDeleted, numKept int
+	if hasIndex {
+		numDeleted, numKept, err = d.purgeVaasByIndex(prefix, oldestTime, logOnly)
+	} else {
+		numDeleted, numKept, err = d.purgeVaasByScan(prefix, oldestTime, logOnly)
+	}
+	if err != nil {
+		return "", err
+	}
 
-	if err := d.db.View(func(txn *badger.Txn) error {
+	ret := ""
+	if logOnly {
+		ret = fmt.Sprintf("Would purge VAAs for chain %s older than %v.\n", prefix.EmitterChain, oldestTime.String())
+		if numDeleted != 0 {
+			ret += fmt.Sprintf("Would have deleted %v items and kept %v.", numDeleted, numKept)
+		} else {
+			ret += fmt.Sprintf("Would not have deleted anything and kept %v items", numKept)
+		}
+	} else {
+		ret = fmt.Sprintf("Purging VAAs for chain %s older than %v.\n", prefix.EmitterChain, oldestTime.String())
+		if numDeleted != 0 {
+			ret += fmt.Sprintf("Deleted %v items and kept %v items", numDeleted, numKept)
+		} else {
+			ret += fmt.Sprintf("Did not delete anything, kept %v items", numKept)
+		}
+	}
+
+	return ret, nil
+}
+
+// purgeVaasByScan implements PurgeVaas by reading every VAA for the prefix.
+func (d *Database) purgeVaasByScan(prefix VAAID, oldestTime time.Time, logOnly bool) (numDeleted int, numKept int, err error) {
+	err = d.db.View(func(txn *badger.Txn) error {
 		it := txn.NewIterator(badger.DefaultIteratorOptions)
 		defer it.Close()
 		prefix := prefix.EmitterPrefixBytes()
@@ -28,7 +67,11 @@ func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (
 			item := it.Item()
 			key := item.Key()
 			err := item.Value(func(val []byte) error {
-				v, err := vaa.Unmarshal(val)
+				b, err := decodeVAAValue(val)
+				if err != nil {
+					return fmt.Errorf("failed to decode VAA for %s: %v", string(key), err)
+				}
+				v, err := vaa.Unmarshal(b)
 				if err != nil {
 					return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(key), err)
 				}
@@ -37,8 +80,10 @@ func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (
 					numDeleted++
 					if !logOnly {
 						if err := d.db.Update(func(txn *badger.Txn) error {
-							err := txn.Delete(key)
-							return err
+							if err := txn.Delete(key); err != nil {
+								return err
+							}
+							return txn.Delete(vaaTimestampIndexKey(v.Timestamp, key))
 						}); err != nil {
 							return fmt.Errorf("failed to delete vaa for key [%v]: %w", key, err)
 						}
@@ -55,26 +100,70 @@ func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (
 		}
 
 		return nil
-	}); err != nil {
-		return "", err
-	}
+	})
+	return
+}
 
-	ret := ""
-	if logOnly {
-		ret = fmt.Sprintf("Would purge VAAs for chain %s older than %v.\n", prefix.EmitterChain, oldestTime.String())
-		if numDeleted != 0 {
-			ret += fmt.Sprintf("Would have deleted %v items and kept %v.", numDeleted, numKept)
-		} else {
-			ret += fmt.Sprintf("Would not have deleted anything and kept %v items", numKept)
+// purgeVaasByIndex implements PurgeVaas using the timestamp index. Only the keys of the VAAs for the prefix are read
+// to count the ones that are kept.
+func (d *Database) purgeVaasByIndex(prefix VAAID, oldestTime time.Time, logOnly bool) (numDeleted int, numKept int, err error) {
+	vaaPrefix := prefix.EmitterPrefixBytes()
+	var toDelete [][]byte
+
+	err = d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+
+		indexPrefix := []byte(vaaTimestampIndexPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(indexPrefix); it.ValidForPrefix(indexPrefix); it.Next() {
+			indexKey := it.Item().KeyCopy(nil)
+			timestamp, vaaKey, err := parseVaaTimestampIndexKey(indexKey)
+			if err != nil {
+				return err
+			}
+			if !timestamp.Before(oldestTime) {
+				// The index is sorted by timestamp, so all remaining entries are newer.
+				break
+			}
+			if !bytes.HasPrefix(vaaKey, vaaPrefix) {
+				continue
+			}
+			if _, err := txn.Get(vaaKey); errors.Is(err, badger.ErrKeyNotFound) {
+				// Drop stale index entries without counting them.
+				toDelete = append(toDelete, indexKey)
+				continue
+			} else if err != nil {
+				return err
+			}
+			numDeleted++
+			toDelete = append(toDelete, vaaKey, indexKey)
 		}
-	} else {
-		ret = fmt.Sprintf("Purging VAAs for chain %s older than %v.\n", prefix.EmitterChain, oldestTime.String())
-		if numDeleted != 0 {
-			ret += fmt.Sprintf("Deleted %v items and kept %v items", numDeleted, numKept)
-		} else {
-			ret += fmt.Sprintf("Did not delete anything, kept %v items", numKept)
+
+		total := 0
+		vaaIt := txn.NewIterator(opts)
+		defer vaaIt.Close()
+		for vaaIt.Seek(vaaPrefix); vaaIt.ValidForPrefix(vaaPrefix); vaaIt.Next() {
+			total++
 		}
+		numKept = total - numDeleted
+
+		return nil
+	})
+	if err != nil || logOnly {
+		return
 	}
 
-	return ret, nil
+	wb := d.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range toDelete {
+		if err = wb.Delete(key); err != nil {
+			return 0, 0, fmt.Errorf("failed to delete key [%v]: %w", key, err)
+		}
+	}
+	if err = wb.Flush(); err != nil {
+		return 0, 0, fmt.Errorf("failed to delete vaas: %w", err)
+	}
+	return
 }
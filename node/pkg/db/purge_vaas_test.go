@@ -29,7 +29,8 @@ func countVAAs(d *Database, chainId vaa.ChainID) (numThisChain int, numOtherChai
 		opts.PrefetchSize = 10
 		it := txn.NewIterator(opts)
 		defer it.Close()
-		for it.Rewind(); it.Valid(); it.Next() {
+		prefix := []byte("signed/")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
 			item := it.Item()
 			key := item.Key()
 			err := item.Value(func(val []byte) error {
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/dgraph-io/badger/v3"
+)
+
+// readinessProbeKey is the key used for the write/read round-trip of the readiness check.
+var readinessProbeKey = []byte("READINESS:probe")
+
+// RegisterReadiness registers the database as a readiness component and marks it ready once a write/read round-trip
+// succeeds. From then on, database write errors mark the component as not ready, and the next successful write marks
+// it ready again.
+func (d *Database) RegisterReadiness() error {
+	readiness.RegisterComponent(common.ReadinessDB)
+	d.readinessRegistered.Store(true)
+	return d.CheckReadiness()
+}
+
+// CheckReadiness performs a write/read round-trip against the database and updates the readiness component accordingly.
+func (d *Database) CheckReadiness() error {
+	err := d.readinessRoundTrip()
+	d.updateReadiness(err)
+	return err
+}
+
+func (d *Database) readinessRoundTrip() error {
+	value := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(readinessProbeKey, value)
+	}); err != nil {
+		return fmt.Errorf("failed to write readiness probe: %w", err)
+	}
+
+	var readBack []byte
+	if err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(readinessProbeKey)
+		if err != nil {
+			return err
+		}
+		readBack, err = item.ValueCopy(nil)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to read readiness probe: %w", err)
+	}
+
+	if !bytes.Equal(value, readBack) {
+		return fmt.Errorf("readiness probe mismatch, wrote %s, read %s", value, readBack)
+	}
+
+	return nil
+}
+
+// updateReadiness sets the database readiness component based on the outcome of a database operation.
+// It is a no-op if RegisterReadiness was never called.
+func (d *Database) updateReadiness(err error) {
+	if !d.readinessRegistered.Load() {
+		return
+	}
+	if err != nil {
+		readiness.SetNotReady(common.ReadinessDB)
+	} else {
+		readiness.SetReady(common.ReadinessDB)
+	}
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseReadiness(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+
+	assert.False(t, readiness.IsReady(common.ReadinessDB))
+
+	require.NoError(t, db.RegisterReadiness())
+	assert.True(t, readiness.IsReady(common.ReadinessDB))
+
+	db.updateReadiness(errors.New("transient error"))
+	assert.False(t, readiness.IsReady(common.ReadinessDB))
+
+	// The next successful write marks the database as ready again.
+	testVaa := getVAA()
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	testVaa.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&testVaa))
+	assert.True(t, readiness.IsReady(common.ReadinessDB))
+
+	// Once the database stops working, the readiness check flips back to not ready.
+	require.NoError(t, db.Close())
+	require.Error(t, db.CheckReadiness())
+	assert.False(t, readiness.IsReady(common.ReadinessDB))
+}
@@ -0,0 +1,274 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// SizeLimitMode determines what happens when the database exceeds its configured size limit.
+type SizeLimitMode int
+
+const (
+	// SizeLimitReject makes StoreSignedVAA return ErrSizeLimitExceeded without writing.
+	SizeLimitReject SizeLimitMode = iota
+	// SizeLimitPurge makes the size limit monitor delete the oldest VAAs until the database is back under the limit.
+	SizeLimitPurge
+)
+
+// sizeLimitCheckInterval is how often the size limit monitor measures the database. Badger only refreshes its size
+// estimate periodically, so checking more often would not help and purging more often would delete more than needed.
+const sizeLimitCheckInterval = time.Minute
+
+// sizeLimitPurgeBatchSize is the maximum number of VAAs deleted by a single purge.
+const sizeLimitPurgeBatchSize = 10000
+
+// ErrSizeLimitExceeded is returned by StoreSignedVAA in SizeLimitReject mode if the database is over its size limit.
+var ErrSizeLimitExceeded = errors.New("database size limit exceeded")
+
+var (
+	dbSizeLimitExceeded = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_db_size_limit_exceeded_total",
+			Help: "Total number of size checks that found the database over its size limit",
+		}, []string{"mode"})
+	dbSizeLimitRejectedVAAs = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_db_size_limit_rejected_vaas_total",
+			Help: "Total number of signed VAAs that were not stored because the database was over its size limit",
+		})
+	dbSizeLimitPurgedVAAs = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_db_size_limit_purged_vaas_total",
+			Help: "Total number of VAAs deleted because the database was over its size limit",
+		})
+)
+
+func (m SizeLimitMode) String() string {
+	switch m {
+	case SizeLimitReject:
+		return "reject"
+	case SizeLimitPurge:
+		return "purge"
+	default:
+		return fmt.Sprintf("SizeLimitMode(%d)", int(m))
+	}
+}
+
+// ParseSizeLimitMode parses "reject" or "purge" into a SizeLimitMode.
+func ParseSizeLimitMode(s string) (SizeLimitMode, error) {
+	switch s {
+	case "reject":
+		return SizeLimitReject, nil
+	case "purge":
+		return SizeLimitPurge, nil
+	default:
+		return 0, fmt.Errorf(`invalid size limit mode %q, must be "reject" or "purge"`, s)
+	}
+}
+
+// sizeLimit holds the size limit configuration of a Database.
+type sizeLimit struct {
+	logger   *zap.Logger
+	maxBytes int64
+	mode     SizeLimitMode
+	// size returns the current size of the database in bytes. It can be overridden in tests.
+	size func() int64
+	// purgeBatchSize is the maximum number of VAAs deleted by a single purge. It can be overridden in tests.
+	purgeBatchSize int
+
+	// lastSize is the size measured by the last check. StoreSignedVAA only reads it, so writes never wait on a check.
+	lastSize atomic.Int64
+	exceeded atomic.Bool
+
+	// purgedAtSize is the size that triggered the last purge, or zero if the database has been under the limit since.
+	// Badger's size estimate only drops once the deleted data has been garbage collected, so no further purge is done
+	// until the size is below it. Otherwise every check would purge another batch. It is only used by refreshSizeLimit.
+	purgedAtSize int64
+}
+
+// SetSizeLimit limits the on-disk size of the database, as last measured by badger, to maxBytes. The size is checked
+// by RunSizeLimit. If it is exceeded, mode determines whether VAA writes are rejected or the oldest VAAs are purged to
+// make room. A maxBytes of zero disables the limit.
+func (d *Database) SetSizeLimit(logger *zap.Logger, maxBytes int64, mode SizeLimitMode) {
+	if maxBytes <= 0 {
+		d.sizeLimit = nil
+		return
+	}
+	d.sizeLimit = &sizeLimit{
+		logger:         logger,
+		maxBytes:       maxBytes,
+		mode:           mode,
+		purgeBatchSize: sizeLimitPurgeBatchSize,
+		size: func() int64 {
+			lsm, vlog := d.db.Size()
+			return lsm + vlog
+		},
+	}
+}
+
+// RunSizeLimit is a supervisor runnable that checks the size limit set by SetSizeLimit, if any, every sizeLimitCheckInterval
+// until ctx is done.
+func (d *Database) RunSizeLimit(ctx context.Context) error {
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+	if d.sizeLimit == nil {
+		supervisor.Signal(ctx, supervisor.SignalDone)
+		return nil
+	}
+	d.refreshSizeLimit()
+
+	t := time.NewTicker(sizeLimitCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			d.refreshSizeLimit()
+		}
+	}
+}
+
+// refreshSizeLimit measures the database and, in SizeLimitPurge mode, purges the oldest VAAs if it is over the limit.
+func (d *Database) refreshSizeLimit() {
+	l := d.sizeLimit
+	size := l.size()
+	l.lastSize.Store(size)
+	exceeded := size > l.maxBytes
+	l.exceeded.Store(exceeded)
+	if !exceeded {
+		l.purgedAtSize = 0
+		return
+	}
+
+	dbSizeLimitExceeded.WithLabelValues(l.mode.String()).Inc()
+	if l.mode != SizeLimitPurge {
+		l.logger.Error("database size limit exceeded, rejecting VAA writes", zap.Int64("size", size), zap.Int64("maxBytes", l.maxBytes))
+		return
+	}
+
+	if l.purgedAtSize != 0 && size >= l.purgedAtSize {
+		l.logger.Warn("database size limit exceeded, waiting for the last purge to free up space before purging more",
+			zap.Int64("size", size),
+			zap.Int64("maxBytes", l.maxBytes),
+			zap.Int64("purgedAtSize", l.purgedAtSize),
+		)
+		return
+	}
+
+	numDeleted, err := d.purgeOldestVaas(l.purgeBatchSize)
+	if err != nil {
+		l.logger.Error("database size limit exceeded, emergency purge failed", zap.Int64("size", size), zap.Int64("maxBytes", l.maxBytes), zap.Error(err))
+		return
+	}
+	l.purgedAtSize = size
+	dbSizeLimitPurgedVAAs.Add(float64(numDeleted))
+	l.logger.Warn("database size limit exceeded, purged oldest VAAs",
+		zap.Int64("size", size),
+		zap.Int64("maxBytes", l.maxBytes),
+		zap.Int("numDeleted", numDeleted),
+	)
+
+	d.runValueLogGC(l.logger)
+}
+
+// runValueLogGC rewrites value log files until there is nothing left to reclaim, so that deleted VAAs free up disk space.
+func (d *Database) runValueLogGC(logger *zap.Logger) {
+	for {
+		err := d.db.RunValueLogGC(0.5)
+		if errors.Is(err, badger.ErrNoRewrite) {
+			return
+		}
+		if err != nil {
+			logger.Warn("value log garbage collection failed", zap.Error(err))
+			return
+		}
+	}
+}
+
+// checkSizeLimit enforces the size limit, if any, before v is written. It only looks at the result of the last check.
+func (d *Database) checkSizeLimit(v *vaa.VAA) error {
+	l := d.sizeLimit
+	if l == nil || l.mode != SizeLimitReject || !l.exceeded.Load() {
+		return nil
+	}
+
+	size := l.lastSize.Load()
+	dbSizeLimitRejectedVAAs.Inc()
+	l.logger.Error("database size limit exceeded, not storing signed VAA",
+		zap.String("message_id", v.MessageID()),
+		zap.Int64("size", size),
+		zap.Int64("maxBytes", l.maxBytes),
+	)
+	return fmt.Errorf("%w: size %d, limit %d", ErrSizeLimitExceeded, size, l.maxBytes)
+}
+
+// purgeOldestVaas deletes up to batchSize VAAs, oldest first, using the timestamp index. Governance VAAs, including
+// guardian set upgrades, are never purged.
+func (d *Database) purgeOldestVaas(batchSize int) (numDeleted int, err error) {
+	var toDelete [][]byte
+
+	err = d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+
+		indexPrefix := []byte(vaaTimestampIndexPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(indexPrefix); it.ValidForPrefix(indexPrefix) && numDeleted < batchSize; it.Next() {
+			indexKey := it.Item().KeyCopy(nil)
+			_, vaaKey, err := parseVaaTimestampIndexKey(indexKey)
+			if err != nil {
+				return err
+			}
+			if isGovernanceVaaKey(vaaKey) {
+				continue
+			}
+			if _, err := txn.Get(vaaKey); errors.Is(err, badger.ErrKeyNotFound) {
+				// Drop stale index entries without counting them.
+				toDelete = append(toDelete, indexKey)
+				continue
+			} else if err != nil {
+				return err
+			}
+			numDeleted++
+			toDelete = append(toDelete, vaaKey, indexKey)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	wb := d.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range toDelete {
+		if err := wb.Delete(key); err != nil {
+			return 0, fmt.Errorf("failed to delete key [%v]: %w", key, err)
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to delete vaas: %w", err)
+	}
+
+	return numDeleted, nil
+}
+
+// governanceVaaPrefix is the key prefix of the VAAs emitted by the governance emitter.
+var governanceVaaPrefix = append((&VAAID{EmitterChain: vaa.GovernanceChain, EmitterAddress: vaa.GovernanceEmitter}).EmitterPrefixBytes(), '/')
+
+// isGovernanceVaaKey returns true if key is the key of a VAA emitted by the governance emitter.
+func isGovernanceVaaKey(key []byte) bool {
+	return bytes.HasPrefix(key, governanceVaaPrefix)
+}
@@ -0,0 +1,236 @@
+package db
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// openWithSizeLimit returns a database with a size limit of 1000 bytes whose reported size is controlled by the returned pointer.
+func openWithSizeLimit(t *testing.T, mode SizeLimitMode) (*Database, *int64) {
+	t.Helper()
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	db.SetSizeLimit(zap.NewNop(), 1000, mode)
+	size := new(int64)
+	db.sizeLimit.size = func() int64 { return *size }
+	db.sizeLimit.purgeBatchSize = 2
+	return db, size
+}
+
+// signedVAAWithSequence returns a signed VAA from a non-governance emitter.
+func signedVAAWithSequence(t *testing.T, seq uint64) *vaa.VAA {
+	t.Helper()
+	v := signedGovernanceVAAWithSequence(t, seq)
+	v.EmitterChain = vaa.ChainIDEthereum
+	return v
+}
+
+func signedGovernanceVAAWithSequence(t *testing.T, seq uint64) *vaa.VAA {
+	t.Helper()
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	v := getVAA()
+	v.Sequence = seq
+	v.Timestamp = time.Unix(int64(1700000000+seq), 0)
+	v.AddSignature(privKey, 0)
+	return &v
+}
+
+func getCounterValue(t *testing.T, c prometheus.Counter) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, c.Write(m))
+	return m.Counter.GetValue()
+}
+
+func TestParseSizeLimitMode(t *testing.T) {
+	mode, err := ParseSizeLimitMode("reject")
+	require.NoError(t, err)
+	assert.Equal(t, SizeLimitReject, mode)
+
+	mode, err = ParseSizeLimitMode("purge")
+	require.NoError(t, err)
+	assert.Equal(t, SizeLimitPurge, mode)
+
+	_, err = ParseSizeLimitMode("delete")
+	assert.Error(t, err)
+}
+
+func TestSizeLimitReject(t *testing.T) {
+	db, size := openWithSizeLimit(t, SizeLimitReject)
+
+	// Exactly at the limit is still allowed.
+	*size = 1000
+	db.refreshSizeLimit()
+	v1 := signedVAAWithSequence(t, 1)
+	require.NoError(t, db.StoreSignedVAA(v1))
+
+	// Writes only see the size measured by the last check.
+	*size = 1001
+	v2 := signedVAAWithSequence(t, 2)
+	require.NoError(t, db.StoreSignedVAA(v2))
+
+	db.refreshSizeLimit()
+	rejectedBefore := getCounterValue(t, dbSizeLimitRejectedVAAs)
+	v3 := signedVAAWithSequence(t, 3)
+	err := db.StoreSignedVAA(v3)
+	require.ErrorIs(t, err, ErrSizeLimitExceeded)
+	assert.Equal(t, rejectedBefore+1, getCounterValue(t, dbSizeLimitRejectedVAAs))
+
+	found, err := db.HasVAA(*VaaIDFromVAA(v3))
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// Nothing was purged.
+	found, err = db.HasVAA(*VaaIDFromVAA(v1))
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	// Writes are accepted again once the database is back under the limit.
+	*size = 1000
+	db.refreshSizeLimit()
+	require.NoError(t, db.StoreSignedVAA(v3))
+}
+
+func TestSizeLimitPurge(t *testing.T) {
+	db, size := openWithSizeLimit(t, SizeLimitPurge)
+
+	*size = 1000
+	var stored []*vaa.VAA
+	for seq := uint64(1); seq <= 20; seq++ {
+		v := signedVAAWithSequence(t, seq)
+		require.NoError(t, db.StoreSignedVAA(v))
+		stored = append(stored, v)
+	}
+
+	// Nothing is purged while under the limit.
+	db.refreshSizeLimit()
+	found, err := db.HasVAA(*VaaIDFromVAA(stored[0]))
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	// Writes are never rejected in purge mode, the purge happens on the next check.
+	*size = 1001
+	v := signedVAAWithSequence(t, 21)
+	require.NoError(t, db.StoreSignedVAA(v))
+	db.refreshSizeLimit()
+
+	// The oldest batch of VAAs is purged.
+	for i, s := range stored {
+		found, err := db.HasVAA(*VaaIDFromVAA(s))
+		require.NoError(t, err)
+		assert.Equal(t, i >= 2, found, "sequence %d", s.Sequence)
+	}
+	found, err = db.HasVAA(*VaaIDFromVAA(v))
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestSizeLimitPurgeKeepsGovernanceVAAs(t *testing.T) {
+	db, size := openWithSizeLimit(t, SizeLimitPurge)
+
+	// The governance VAA is the oldest one.
+	gov := signedGovernanceVAAWithSequence(t, 1)
+	require.NoError(t, db.StoreSignedVAA(gov))
+	var stored []*vaa.VAA
+	for seq := uint64(2); seq <= 4; seq++ {
+		v := signedVAAWithSequence(t, seq)
+		require.NoError(t, db.StoreSignedVAA(v))
+		stored = append(stored, v)
+	}
+
+	// The size drops after the first purge, but not enough to get back under the limit.
+	*size = 1002
+	db.refreshSizeLimit()
+	*size = 1001
+	db.refreshSizeLimit()
+
+	found, err := db.HasVAA(*VaaIDFromVAA(gov))
+	require.NoError(t, err)
+	assert.True(t, found)
+	for _, s := range stored {
+		found, err := db.HasVAA(*VaaIDFromVAA(s))
+		require.NoError(t, err)
+		assert.False(t, found, "sequence %d", s.Sequence)
+	}
+}
+
+func TestSizeLimitPurgeWaitsForSizeToDrop(t *testing.T) {
+	db, size := openWithSizeLimit(t, SizeLimitPurge)
+
+	var stored []*vaa.VAA
+	for seq := uint64(1); seq <= 6; seq++ {
+		v := signedVAAWithSequence(t, seq)
+		require.NoError(t, db.StoreSignedVAA(v))
+		stored = append(stored, v)
+	}
+
+	countStored := func() int {
+		n := 0
+		for _, s := range stored {
+			found, err := db.HasVAA(*VaaIDFromVAA(s))
+			require.NoError(t, err)
+			if found {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The size does not drop after a purge, so only the first check purges.
+	*size = 1010
+	for i := 0; i < 5; i++ {
+		db.refreshSizeLimit()
+	}
+	assert.Equal(t, 4, countStored())
+
+	// Once the size drops, but is still over the limit, the next batch is purged.
+	*size = 1005
+	db.refreshSizeLimit()
+	assert.Equal(t, 2, countStored())
+}
+
+func TestSizeLimitDisabled(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	db.SetSizeLimit(zap.NewNop(), 0, SizeLimitReject)
+	assert.Nil(t, db.sizeLimit)
+	require.NoError(t, db.StoreSignedVAA(signedVAAWithSequence(t, 1)))
+}
+
+func TestRunSizeLimitUnderSupervisor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, size := openWithSizeLimit(t, SizeLimitReject)
+	*size = 1001
+
+	supervisor.New(ctx, zap.NewNop(), func(ctx context.Context) error {
+		if err := supervisor.Run(ctx, "dbsizelimit", db.RunSizeLimit); err != nil {
+			return err
+		}
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		<-ctx.Done()
+		return nil
+	})
+
+	// The runnable checks the size as soon as it starts.
+	require.Eventually(t, func() bool { return db.sizeLimit.exceeded.Load() }, 5*time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, db.StoreSignedVAA(signedVAAWithSequence(t, 1)), ErrSizeLimitExceeded)
+}
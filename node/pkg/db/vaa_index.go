@@ -0,0 +1,107 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// vaaTimestampIndexPrefix is the key prefix of the secondary index of stored VAAs by timestamp. The keys are the prefix,
+// followed by the VAA timestamp in seconds as a big endian uint64 and the key of the VAA itself, so they sort by time.
+// The values are empty.
+const vaaTimestampIndexPrefix = "TS:"
+
+// vaaTimestampIndexSchemaVersion is the schema version that introduced the timestamp index.
+const vaaTimestampIndexSchemaVersion = 2
+
+func vaaTimestampIndexKey(timestamp time.Time, vaaKey []byte) []byte {
+	key := make([]byte, len(vaaTimestampIndexPrefix)+8, len(vaaTimestampIndexPrefix)+8+len(vaaKey))
+	copy(key, vaaTimestampIndexPrefix)
+	binary.BigEndian.PutUint64(key[len(vaaTimestampIndexPrefix):], uint64(timestamp.Unix()))
+	return append(key, vaaKey...)
+}
+
+// parseVaaTimestampIndexKey returns the timestamp and VAA key encoded in a timestamp index key.
+func parseVaaTimestampIndexKey(key []byte) (time.Time, []byte, error) {
+	if len(key) <= len(vaaTimestampIndexPrefix)+8 || !bytes.HasPrefix(key, []byte(vaaTimestampIndexPrefix)) {
+		return time.Time{}, nil, fmt.Errorf("invalid timestamp index key: %q", key)
+	}
+	ts := binary.BigEndian.Uint64(key[len(vaaTimestampIndexPrefix):])
+	return time.Unix(int64(ts), 0), key[len(vaaTimestampIndexPrefix)+8:], nil
+}
+
+// deleteReplacedVaaTimestampIndexKey deletes the timestamp index entry of the VAA stored under vaaKey, if there is one
+// and its timestamp differs from timestamp. It is called before a VAA is overwritten, so that the old entry cannot make a
+// purge delete the new VAA.
+func deleteReplacedVaaTimestampIndexKey(txn *badger.Txn, vaaKey []byte, timestamp time.Time) error {
+	item, err := txn.Get(vaaKey)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return item.Value(func(val []byte) error {
+		b, err := decodeVAAValue(val)
+		if err != nil {
+			return fmt.Errorf("failed to decode existing VAA for %s: %w", string(vaaKey), err)
+		}
+		old, err := vaa.Unmarshal(b)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal existing VAA for %s: %w", string(vaaKey), err)
+		}
+		if old.Timestamp.Unix() == timestamp.Unix() {
+			return nil
+		}
+		return txn.Delete(vaaTimestampIndexKey(old.Timestamp, vaaKey))
+	})
+}
+
+// hasVaaTimestampIndex returns true if the database has been migrated to a schema version that maintains the timestamp index.
+func (d *Database) hasVaaTimestampIndex() (bool, error) {
+	version, err := d.SchemaVersion()
+	if err != nil {
+		return false, err
+	}
+	return version >= vaaTimestampIndexSchemaVersion, nil
+}
+
+// buildVaaTimestampIndex adds a timestamp index entry for every stored VAA. It is used to migrate existing databases.
+func (d *Database) buildVaaTimestampIndex() error {
+	wb := d.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	if err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte("signed/")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			err := item.Value(func(val []byte) error {
+				b, err := decodeVAAValue(val)
+				if err != nil {
+					return fmt.Errorf("failed to decode VAA for %s: %v", string(key), err)
+				}
+				v, err := vaa.Unmarshal(b)
+				if err != nil {
+					return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(key), err)
+				}
+				return wb.Set(vaaTimestampIndexKey(v.Timestamp, key), nil)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return wb.Flush()
+}
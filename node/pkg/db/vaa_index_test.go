@@ -0,0 +1,227 @@
+package db
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// storeVAAsForIndexTest stores one VAA per hour of age from zero to 47 hours for each of PythNet and Solana.
+func storeVAAsForIndexTest(t *testing.T, db *Database, now time.Time) {
+	t.Helper()
+	for age := 0; age < 48; age++ {
+		for _, chainID := range []vaa.ChainID{vaa.ChainIDPythNet, vaa.ChainIDSolana} {
+			v := getVAA()
+			v.EmitterChain = chainID
+			v.Sequence = uint64(age)
+			v.Timestamp = now.Add(-time.Duration(age) * time.Hour)
+			require.NoError(t, storeVAA(db, &v))
+		}
+	}
+}
+
+// listKeys returns all keys with the given prefix, sorted.
+func listKeys(t *testing.T, db *Database, prefix string) []string {
+	t.Helper()
+	var keys []string
+	require.NoError(t, db.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek([]byte(prefix)); it.ValidForPrefix([]byte(prefix)); it.Next() {
+			keys = append(keys, string(it.Item().KeyCopy(nil)))
+		}
+		return nil
+	}))
+	sort.Strings(keys)
+	return keys
+}
+
+// requireIndexConsistent verifies that there is exactly one index entry for every stored VAA, with the right timestamp.
+func requireIndexConsistent(t *testing.T, db *Database) {
+	t.Helper()
+	vaaKeys := listKeys(t, db, "signed/")
+	indexKeys := listKeys(t, db, vaaTimestampIndexPrefix)
+	require.Equal(t, len(vaaKeys), len(indexKeys))
+
+	indexed := make(map[string]time.Time, len(indexKeys))
+	for _, indexKey := range indexKeys {
+		timestamp, vaaKey, err := parseVaaTimestampIndexKey([]byte(indexKey))
+		require.NoError(t, err)
+		indexed[string(vaaKey)] = timestamp
+	}
+
+	for _, vaaKey := range vaaKeys {
+		timestamp, exists := indexed[vaaKey]
+		require.True(t, exists, "missing index entry for %s", vaaKey)
+
+		id, err := VaaIDFromString(vaaKey[len("signed/"):])
+		require.NoError(t, err)
+		b, err := db.GetSignedVAABytes(*id)
+		require.NoError(t, err)
+		v, err := vaa.Unmarshal(b)
+		require.NoError(t, err)
+		assert.Equal(t, v.Timestamp.Unix(), timestamp.Unix())
+	}
+}
+
+func TestVaaTimestampIndexConsistentAcrossStores(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Unix(1700000000, 0)
+	storeVAAsForIndexTest(t, db, now)
+
+	// Storing the same VAAs again must not add more index entries.
+	storeVAAsForIndexTest(t, db, now)
+
+	// Neither must compressed entries.
+	db.SetVAACompression(true)
+	storeVAAsForIndexTest(t, db, now)
+
+	assert.Equal(t, 96, len(listKeys(t, db, "signed/")))
+	requireIndexConsistent(t, db)
+}
+
+func TestVaaTimestampIndexOverwriteWithNewerTimestamp(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Unix(1700000000, 0)
+	v := getVAA()
+	v.EmitterChain = vaa.ChainIDPythNet
+	v.Timestamp = now.Add(-48 * time.Hour)
+	require.NoError(t, storeVAA(db, &v))
+
+	// Overwrite it with a newer timestamp. Only the new index entry may remain.
+	v.Timestamp = now
+	require.NoError(t, storeVAA(db, &v))
+	requireIndexConsistent(t, db)
+
+	// Purging everything older than a day must not delete the overwritten VAA, neither by emitter nor by age.
+	_, err = db.PurgeVaas(VAAID{EmitterChain: vaa.ChainIDPythNet}, now.Add(-24*time.Hour), false)
+	require.NoError(t, err)
+	exists, err := db.HasVAA(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	numDeleted, err := db.purgeOldestVaas(1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, numDeleted)
+	exists, err = db.HasVAA(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.Empty(t, listKeys(t, db, vaaTimestampIndexPrefix))
+}
+
+func TestPurgeVaasByIndexMatchesScan(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	oldestTime := now.Add(-24*time.Hour - 30*time.Minute)
+	prefix := VAAID{EmitterChain: vaa.ChainIDPythNet}
+
+	indexDb, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer indexDb.Close()
+	storeVAAsForIndexTest(t, indexDb, now)
+
+	scanDb, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer scanDb.Close()
+	storeVAAsForIndexTest(t, scanDb, now)
+
+	// A log only run should not delete anything.
+	numDeleted, numKept, err := indexDb.purgeVaasByIndex(prefix, oldestTime, true)
+	require.NoError(t, err)
+	assert.Equal(t, 23, numDeleted)
+	assert.Equal(t, 25, numKept)
+	assert.Equal(t, 96, len(listKeys(t, indexDb, "signed/")))
+
+	indexDeleted, indexKept, err := indexDb.purgeVaasByIndex(prefix, oldestTime, false)
+	require.NoError(t, err)
+	scanDeleted, scanKept, err := scanDb.purgeVaasByScan(prefix, oldestTime, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 23, indexDeleted)
+	assert.Equal(t, 25, indexKept)
+	assert.Equal(t, scanDeleted, indexDeleted)
+	assert.Equal(t, scanKept, indexKept)
+
+	assert.Equal(t, listKeys(t, scanDb, "signed/"), listKeys(t, indexDb, "signed/"))
+	assert.Equal(t, listKeys(t, scanDb, vaaTimestampIndexPrefix), listKeys(t, indexDb, vaaTimestampIndexPrefix))
+	requireIndexConsistent(t, indexDb)
+	requireIndexConsistent(t, scanDb)
+
+	numPythnet, numOther, err := countVAAs(indexDb, vaa.ChainIDPythNet)
+	require.NoError(t, err)
+	assert.Equal(t, 25, numPythnet)
+	assert.Equal(t, 48, numOther)
+}
+
+func TestPurgeVaasUsesIndex(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Unix(1700000000, 0)
+	storeVAAsForIndexTest(t, db, now)
+
+	// Drop the index entry of the oldest PythNet VAA. Since the purge only looks at the index, it should be kept.
+	v := getVAA()
+	v.EmitterChain = vaa.ChainIDPythNet
+	v.Sequence = 47
+	v.Timestamp = now.Add(-47 * time.Hour)
+	vaaKey := VaaIDFromVAA(&v).Bytes()
+	require.NoError(t, db.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(vaaTimestampIndexKey(v.Timestamp, vaaKey))
+	}))
+
+	_, err = db.PurgeVaas(VAAID{EmitterChain: vaa.ChainIDPythNet}, now.Add(-24*time.Hour-30*time.Minute), false)
+	require.NoError(t, err)
+
+	exists, err := db.HasVAA(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	numPythnet, _, err := countVAAs(db, vaa.ChainIDPythNet)
+	require.NoError(t, err)
+	assert.Equal(t, 26, numPythnet)
+}
+
+func TestVaaTimestampIndexMigration(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	storeVAAsForIndexTest(t, db, now)
+
+	// Simulate a database from before the timestamp index was introduced.
+	for _, key := range listKeys(t, db, vaaTimestampIndexPrefix) {
+		require.NoError(t, db.db.Update(func(txn *badger.Txn) error {
+			return txn.Delete([]byte(key))
+		}))
+	}
+	require.NoError(t, db.storeSchemaVersion(vaaTimestampIndexSchemaVersion-1))
+	hasIndex, err := db.hasVaaTimestampIndex()
+	require.NoError(t, err)
+	assert.False(t, hasIndex)
+	require.NoError(t, db.Close())
+
+	// Reopening the database builds the index.
+	db, err = Open(dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	hasIndex, err = db.hasVaaTimestampIndex()
+	require.NoError(t, err)
+	assert.True(t, hasIndex)
+	requireIndexConsistent(t, db)
+}
@@ -49,6 +49,9 @@ const (
 
 const maxEnqueuedTime = time.Hour * 24
 
+// DefaultMaxReleaseDelay is the default upper bound on the delay an admin can set when resetting the release timer of a pending VAA.
+const DefaultMaxReleaseDelay = time.Hour * 24 * 7
+
 type (
 	// Layout of the config data for each token
 	tokenConfigEntry struct {
@@ -103,13 +106,79 @@ type (
 
 		transfers []*db.Transfer
 		pending   []*pendingEntry
+
+		// transferHashes indexes the hashes without consistency level of the entries in transfers by message ID. It must be
+		// kept in sync with transfers using addTransfer, trimTransfers and clearTransfers.
+		transferHashes map[string][]string
 	}
 )
 
+// updatePendingMetric publishes the number of VAAs pending for the chain. It must be called whenever ce.pending changes.
+func (ce *chainEntry) updatePendingMetric() {
+	metricPendingCount.WithLabelValues(ce.emitterChainId.String()).Set(float64(len(ce.pending)))
+}
+
 func (ce *chainEntry) isBigTransfer(value uint64) bool {
 	return value >= ce.bigTransactionSize && ce.checkForBigTransactions
 }
 
+func (ce *chainEntry) addTransfer(xfer *db.Transfer) {
+	ce.transfers = append(ce.transfers, xfer)
+	if xfer.HashWithoutConsistencyLevel == "" {
+		return
+	}
+	if ce.transferHashes == nil {
+		ce.transferHashes = make(map[string][]string)
+	}
+	ce.transferHashes[xfer.MsgID] = append(ce.transferHashes[xfer.MsgID], xfer.HashWithoutConsistencyLevel)
+}
+
+// trimTransfers removes the first n transfers, which are the oldest ones.
+func (ce *chainEntry) trimTransfers(n int) {
+	for _, t := range ce.transfers[:n] {
+		if t.HashWithoutConsistencyLevel == "" {
+			continue
+		}
+		hashes := ce.transferHashes[t.MsgID]
+		if len(hashes) <= 1 {
+			delete(ce.transferHashes, t.MsgID)
+			continue
+		}
+		for i, h := range hashes {
+			if h == t.HashWithoutConsistencyLevel {
+				ce.transferHashes[t.MsgID] = append(hashes[:i:i], hashes[i+1:]...)
+				break
+			}
+		}
+	}
+	ce.transfers = ce.transfers[n:]
+}
+
+func (ce *chainEntry) clearTransfers() {
+	ce.transfers = nil
+	ce.transferHashes = nil
+}
+
+// findSameTransfer looks for the given message at a different consistency level in the rolling window and in the pending
+// list. The same transfer observed by the confirmed and the finalized watcher hashes differently, but a message that reuses
+// a message ID with a different payload is a different transfer. Transfers stored without a hash without consistency level
+// are not found.
+func (ce *chainEntry) findSameTransfer(msg *common.MessagePublication) (xferComplete bool, found bool) {
+	msgID := msg.MessageIDString()
+	hash := hashWithoutConsistencyLevel(msg)
+	for _, h := range ce.transferHashes[msgID] {
+		if h == hash {
+			return transferComplete, true
+		}
+	}
+	for _, pe := range ce.pending {
+		if pe.dbData.Msg.MessageIDString() == msgID && hashWithoutConsistencyLevel(&pe.dbData.Msg) == hash {
+			return transferEnqueued, true
+		}
+	}
+	return false, false
+}
+
 type ChainGovernor struct {
 	db                    db.GovernorDB // protected by `mutex`
 	logger                *zap.Logger
@@ -126,6 +195,16 @@ type ChainGovernor struct {
 	nextConfigPublishTime time.Time
 	statusPublishCounter  int64
 	configPublishCounter  int64
+
+	// minConsistencyLevel is the lowest consistency level at which a message is counted toward the notional value.
+	// Messages below it are still subject to the limits, but are not counted if published. Zero counts all messages.
+	minConsistencyLevel uint8
+
+	// releaseSinkC receives the VAAs released by ReleasePendingVAA instead of the normal publishing path, if set.
+	releaseSinkC chan<- *common.MessagePublication
+
+	// maxReleaseDelay is the furthest into the future that ResetReleaseTimer may push the release of a pending VAA.
+	maxReleaseDelay time.Duration
 }
 
 func NewChainGovernor(
@@ -141,7 +220,36 @@ func NewChainGovernor(
 		chains:              make(map[vaa.ChainID]*chainEntry),
 		msgsSeen:            make(map[string]bool),
 		env:                 env,
+		maxReleaseDelay:     DefaultMaxReleaseDelay,
+	}
+}
+
+// SetMinConsistencyLevel configures the lowest consistency level at which a message is counted toward the notional value.
+// This avoids counting a transfer twice when it is observed at both a confirmed and a finalized consistency level.
+// It must be called before Run.
+func (gov *ChainGovernor) SetMinConsistencyLevel(level uint8) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+	gov.minConsistencyLevel = level
+}
+
+// SetMaxReleaseDelay configures the largest delay accepted by ResetReleaseTimer. It must be called before Run.
+func (gov *ChainGovernor) SetMaxReleaseDelay(d time.Duration) error {
+	if d < maxEnqueuedTime {
+		return fmt.Errorf("max release delay must be at least %s, got %s", maxEnqueuedTime, d)
 	}
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+	gov.maxReleaseDelay = d
+	return nil
+}
+
+// SetReleaseSink makes ReleasePendingVAA send released VAAs to the given channel instead of queuing them for the processor.
+// This allows tests to observe exactly what was released. It must be called before Run.
+func (gov *ChainGovernor) SetReleaseSink(c chan<- *common.MessagePublication) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+	gov.releaseSinkC = c
 }
 
 func (gov *ChainGovernor) Run(ctx context.Context) error {
@@ -273,6 +381,7 @@ func (gov *ChainGovernor) initConfig() error {
 		}
 
 		gov.chains[cc.emitterChainID] = ce
+		ce.updatePendingMetric()
 	}
 
 	if len(gov.chains) == 0 {
@@ -310,6 +419,10 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 		return true, nil
 	}
 
+	// The emitter chooses the consistency level, so messages below the minimum still go through the limit checks and may be
+	// enqueued. They are only left out of the notional value if they are published.
+	belowMinConsistencyLevel := msg.ConsistencyLevel < gov.minConsistencyLevel
+
 	hash := gov.HashFromMsg(msg)
 	xferComplete, alreadySeen := gov.msgsSeen[hash]
 	if alreadySeen {
@@ -342,6 +455,24 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 		return false, err
 	}
 
+	if xferComplete, found := ce.findSameTransfer(msg); found {
+		if !xferComplete {
+			gov.logger.Info("ignoring vaa because the same transfer is enqueued at a different consistency level",
+				zap.String("msgID", msg.MessageIDString()),
+				zap.String("hash", hash),
+				zap.Stringer("txHash", msg.TxHash),
+			)
+			return false, nil
+		}
+
+		gov.logger.Info("allowing vaa to be published, but not adding it to the notional value because the same transfer was already counted at a different consistency level",
+			zap.String("msgID", msg.MessageIDString()),
+			zap.String("hash", hash),
+			zap.Stringer("txHash", msg.TxHash),
+		)
+		return true, nil
+	}
+
 	value, err := computeValue(payload.Amount, token)
 	if err != nil {
 		gov.logger.Error("failed to compute value of transfer",
@@ -408,10 +539,24 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 		}
 
 		ce.pending = append(ce.pending, &pendingEntry{token: token, amount: payload.Amount, hash: hash, dbData: dbData})
+		ce.updatePendingMetric()
 		gov.msgsSeen[hash] = transferEnqueued
 		return false, nil
 	}
 
+	if belowMinConsistencyLevel {
+		gov.logger.Info("posting vaa below the minimum consistency level without adding it to the notional value",
+			zap.Uint64("value", value),
+			zap.Uint64("prevTotalValue", prevTotalValue),
+			zap.Uint8("consistencyLevel", msg.ConsistencyLevel),
+			zap.Uint8("minConsistencyLevel", gov.minConsistencyLevel),
+			zap.String("msgID", msg.MessageIDString()),
+			zap.String("hash", hash),
+			zap.Stringer("txHash", msg.TxHash),
+		)
+		return true, nil
+	}
+
 	gov.logger.Info("posting vaa",
 		zap.Uint64("value", value),
 		zap.Uint64("prevTotalValue", prevTotalValue),
@@ -422,15 +567,16 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 	)
 
 	xfer := db.Transfer{Timestamp: now,
-		Value:          value,
-		OriginChain:    token.token.chain,
-		OriginAddress:  token.token.addr,
-		EmitterChain:   msg.EmitterChain,
-		EmitterAddress: msg.EmitterAddress,
-		TargetChain:    payload.TargetChain,
-		TargetAddress:  payload.TargetAddress,
-		MsgID:          msg.MessageIDString(),
-		Hash:           hash,
+		Value:                       value,
+		OriginChain:                 token.token.chain,
+		OriginAddress:               token.token.addr,
+		EmitterChain:                msg.EmitterChain,
+		EmitterAddress:              msg.EmitterAddress,
+		TargetChain:                 payload.TargetChain,
+		TargetAddress:               payload.TargetAddress,
+		MsgID:                       msg.MessageIDString(),
+		Hash:                        hash,
+		HashWithoutConsistencyLevel: hashWithoutConsistencyLevel(msg),
 	}
 	err = gov.db.StoreTransfer(&xfer)
 	if err != nil {
@@ -442,7 +588,7 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 		return false, err
 	}
 
-	ce.transfers = append(ce.transfers, &xfer)
+	ce.addTransfer(&xfer)
 	gov.msgsSeen[hash] = transferComplete
 	return true, nil
 }
@@ -581,6 +727,14 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 						zap.String("msgID", pe.dbData.Msg.MessageIDString()))
 				}
 
+				if countsTowardsTransfers && pe.dbData.Msg.ConsistencyLevel < gov.minConsistencyLevel {
+					countsTowardsTransfers = false
+					gov.logger.Info("not adding pending vaa to the notional value because it is below the minimum consistency level",
+						zap.Uint8("consistencyLevel", pe.dbData.Msg.ConsistencyLevel),
+						zap.Uint8("minConsistencyLevel", gov.minConsistencyLevel),
+						zap.String("msgID", pe.dbData.Msg.MessageIDString()))
+				}
+
 				payload, err := vaa.DecodeTransferPayloadHdr(pe.dbData.Msg.Payload)
 				if err != nil {
 					gov.logger.Error("failed to decode payload for pending VAA, dropping it",
@@ -595,15 +749,16 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 
 					if countsTowardsTransfers {
 						xfer := db.Transfer{Timestamp: now,
-							Value:          value,
-							OriginChain:    pe.token.token.chain,
-							OriginAddress:  pe.token.token.addr,
-							EmitterChain:   pe.dbData.Msg.EmitterChain,
-							EmitterAddress: pe.dbData.Msg.EmitterAddress,
-							TargetChain:    payload.TargetChain,
-							TargetAddress:  payload.TargetAddress,
-							MsgID:          pe.dbData.Msg.MessageIDString(),
-							Hash:           pe.hash,
+							Value:                       value,
+							OriginChain:                 pe.token.token.chain,
+							OriginAddress:               pe.token.token.addr,
+							EmitterChain:                pe.dbData.Msg.EmitterChain,
+							EmitterAddress:              pe.dbData.Msg.EmitterAddress,
+							TargetChain:                 payload.TargetChain,
+							TargetAddress:               payload.TargetAddress,
+							MsgID:                       pe.dbData.Msg.MessageIDString(),
+							Hash:                        pe.hash,
+							HashWithoutConsistencyLevel: hashWithoutConsistencyLevel(&pe.dbData.Msg),
 						}
 
 						if err := gov.db.StoreTransfer(&xfer); err != nil {
@@ -611,7 +766,7 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 							return nil, err
 						}
 
-						ce.transfers = append(ce.transfers, &xfer)
+						ce.addTransfer(&xfer)
 						gov.msgsSeen[pe.hash] = transferComplete
 					} else {
 						delete(gov.msgsSeen, pe.hash)
@@ -624,6 +779,7 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 				}
 
 				ce.pending = append(ce.pending[:idx], ce.pending[idx+1:]...)
+				ce.updatePendingMetric()
 				foundOne = true
 				break // We messed up our loop indexing, so we have to break out and start over.
 			}
@@ -657,7 +813,9 @@ func computeValue(amount *big.Int, token *tokenEntry) (uint64, error) {
 }
 
 func (gov *ChainGovernor) TrimAndSumValueForChain(ce *chainEntry, startTime time.Time) (sum uint64, err error) {
-	sum, ce.transfers, err = gov.TrimAndSumValue(ce.transfers, startTime)
+	var remaining []*db.Transfer
+	sum, remaining, err = gov.TrimAndSumValue(ce.transfers, startTime)
+	ce.trimTransfers(len(ce.transfers) - len(remaining))
 	return sum, err
 }
 
@@ -697,7 +855,19 @@ func (tk tokenKey) String() string {
 }
 
 func (gov *ChainGovernor) HashFromMsg(msg *common.MessagePublication) string {
+	return hashFromMsg(msg)
+}
+
+func hashFromMsg(msg *common.MessagePublication) string {
 	v := msg.CreateVAA(0) // We can pass zero in as the guardian set index because it is not part of the digest.
 	digest := v.SigningDigest()
 	return hex.EncodeToString(digest.Bytes())
 }
+
+// hashWithoutConsistencyLevel returns the hash of msg with its consistency level zeroed, which is the same for a transfer
+// observed at different consistency levels.
+func hashWithoutConsistencyLevel(msg *common.MessagePublication) string {
+	candidate := *msg
+	candidate.ConsistencyLevel = 0
+	return hashFromMsg(&candidate)
+}
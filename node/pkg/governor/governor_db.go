@@ -153,6 +153,7 @@ func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer) {
 	)
 
 	ce.pending = append(ce.pending, &pendingEntry{token: token, amount: payload.Amount, hash: hash, dbData: *pending})
+	ce.updatePendingMetric()
 	gov.msgsSeen[hash] = transferEnqueued
 }
 
@@ -226,5 +227,5 @@ func (gov *ChainGovernor) reloadTransfer(xfer *db.Transfer) {
 		)
 	}
 
-	ce.transfers = append(ce.transfers, xfer)
+	ce.addTransfer(xfer)
 }
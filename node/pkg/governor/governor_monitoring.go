@@ -82,6 +82,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/db"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -121,6 +122,50 @@ func (gov *ChainGovernor) Status() string {
 	return resp
 }
 
+// Admin command to dump the chain and token configuration currently loaded by the governor.
+func (gov *ChainGovernor) DumpConfig() *nodev1.ChainGovernorDumpConfigResponse {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	chains := make([]*nodev1.ChainGovernorDumpConfigResponse_Chain, 0, len(gov.chains))
+	for _, ce := range gov.chains {
+		chains = append(chains, &nodev1.ChainGovernorDumpConfigResponse_Chain{
+			ChainId:            uint32(ce.emitterChainId),
+			DailyLimit:         ce.dailyLimit,
+			BigTransactionSize: ce.bigTransactionSize,
+		})
+	}
+	sort.Slice(chains, func(i, j int) bool {
+		return chains[i].ChainId < chains[j].ChainId
+	})
+
+	tokens := make([]*nodev1.ChainGovernorDumpConfigResponse_Token, 0, len(gov.tokens))
+	for tk, te := range gov.tokens {
+		price, _ := te.price.Float64()
+		cfgPrice, _ := te.cfgPrice.Float64()
+		tokens = append(tokens, &nodev1.ChainGovernorDumpConfigResponse_Token{
+			OriginChainId: uint32(tk.chain),
+			OriginAddress: "0x" + tk.addr.String(),
+			Symbol:        te.symbol,
+			CoinGeckoId:   te.coinGeckoId,
+			Decimals:      int64(len(te.decimals.String()) - 1), // te.decimals is 10^decimals
+			Price:         price,
+			CfgPrice:      cfgPrice,
+		})
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].OriginChainId != tokens[j].OriginChainId {
+			return tokens[i].OriginChainId < tokens[j].OriginChainId
+		}
+		return tokens[i].OriginAddress < tokens[j].OriginAddress
+	})
+
+	return &nodev1.ChainGovernorDumpConfigResponse{
+		Chains: chains,
+		Tokens: tokens,
+	}
+}
+
 // Admin command to reload the governor state from the database.
 func (gov *ChainGovernor) Reload() (string, error) {
 	gov.mutex.Lock()
@@ -131,8 +176,9 @@ func (gov *ChainGovernor) Reload() (string, error) {
 	}
 
 	for _, ce := range gov.chains {
-		ce.transfers = nil
+		ce.clearTransfers()
 		ce.pending = nil
+		ce.updatePendingMetric()
 	}
 
 	if err := gov.loadFromDBAlreadyLocked(); err != nil {
@@ -164,6 +210,7 @@ func (gov *ChainGovernor) DropPendingVAA(vaaId string) (string, error) {
 				}
 
 				ce.pending = append(ce.pending[:idx], ce.pending[idx+1:]...)
+				ce.updatePendingMetric()
 				str := fmt.Sprintf("vaa \"%v\" has been dropped from the pending list", msgId)
 				return str, nil
 			}
@@ -189,7 +236,15 @@ func (gov *ChainGovernor) ReleasePendingVAA(vaaId string) (string, error) {
 					zap.Stringer("timeStamp", pe.dbData.Msg.Timestamp),
 				)
 
-				gov.msgsToPublish = append(gov.msgsToPublish, &pe.dbData.Msg)
+				if gov.releaseSinkC != nil {
+					select {
+					case gov.releaseSinkC <- &pe.dbData.Msg:
+					default:
+						return "", fmt.Errorf("release sink is full")
+					}
+				} else {
+					gov.msgsToPublish = append(gov.msgsToPublish, &pe.dbData.Msg)
+				}
 
 				// We delete the pending message from the database, but we don't add it to the transfers
 				// because released messages do not apply to the limit.
@@ -199,6 +254,7 @@ func (gov *ChainGovernor) ReleasePendingVAA(vaaId string) (string, error) {
 				}
 
 				ce.pending = append(ce.pending[:idx], ce.pending[idx+1:]...)
+				ce.updatePendingMetric()
 				str := fmt.Sprintf("pending vaa \"%v\" has been released and will be published soon", msgId)
 				return str, nil
 			}
@@ -208,20 +264,31 @@ func (gov *ChainGovernor) ReleasePendingVAA(vaaId string) (string, error) {
 	return "", fmt.Errorf("vaa not found in the pending list")
 }
 
-// Admin command to reset the release timer for a pending VAA, extending it to the configured limit.
-func (gov *ChainGovernor) ResetReleaseTimer(vaaId string) (string, error) {
-	return gov.resetReleaseTimerForTime(vaaId, time.Now())
+// Admin command to reset the release timer for a pending VAA to the specified delay from now.
+// A zero delay uses the standard enqueue time. The delay may not exceed the configured maximum.
+func (gov *ChainGovernor) ResetReleaseTimer(vaaId string, delay time.Duration) (string, error) {
+	return gov.resetReleaseTimerForTime(vaaId, delay, time.Now())
 }
 
-func (gov *ChainGovernor) resetReleaseTimerForTime(vaaId string, now time.Time) (string, error) {
+func (gov *ChainGovernor) resetReleaseTimerForTime(vaaId string, delay time.Duration, now time.Time) (string, error) {
 	gov.mutex.Lock()
 	defer gov.mutex.Unlock()
 
+	if delay == 0 {
+		delay = maxEnqueuedTime
+	}
+	if delay < 0 {
+		return "", fmt.Errorf("release delay must not be negative, got %s", delay)
+	}
+	if delay > gov.maxReleaseDelay {
+		return "", fmt.Errorf("release delay %s exceeds the maximum of %s", delay, gov.maxReleaseDelay)
+	}
+
 	for _, ce := range gov.chains {
 		for _, pe := range ce.pending {
 			msgId := pe.dbData.Msg.MessageIDString()
 			if msgId == vaaId {
-				pe.dbData.ReleaseTime = now.Add(maxEnqueuedTime)
+				pe.dbData.ReleaseTime = now.Add(delay)
 				gov.logger.Info("updating the release time due to admin command",
 					zap.String("msgId", msgId),
 					zap.Stringer("timeStamp", pe.dbData.Msg.Timestamp),
@@ -396,6 +463,13 @@ var (
 			Name: "guardian_governor_total_enqueued_vaas",
 			Help: "Chain governor total number of VAAs enqueued due to limiting across all chains",
 		})
+
+	// wormhole_governor_pending_count{chain_name="polygon"} 0
+	metricPendingCount = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_governor_pending_count",
+			Help: "Chain governor number of pending VAAs per chain, updated whenever a VAA is enqueued or released",
+		}, []string{"chain_name"})
 )
 
 func (gov *ChainGovernor) CollectMetrics(hb *gossipv1.Heartbeat, sendC chan<- []byte, gk *ecdsa.PrivateKey, ourAddr ethCommon.Address) {
@@ -1,11 +1,16 @@
 package governor
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
@@ -16,3 +21,209 @@ func TestIsVAAEnqueuedNilMessageID(t *testing.T) {
 	require.EqualError(t, err, "no message ID specified")
 	assert.Equal(t, false, enqueued)
 }
+
+func TestDumpConfigDevnet(t *testing.T) {
+	gov := NewChainGovernor(zap.NewNop(), nil, common.UnsafeDevNet)
+	require.NoError(t, gov.initConfig())
+
+	cfg := gov.DumpConfig()
+
+	require.Equal(t, 2, len(cfg.Chains))
+	assert.Equal(t, uint32(vaa.ChainIDSolana), cfg.Chains[0].ChainId)
+	assert.Equal(t, uint64(100), cfg.Chains[0].DailyLimit)
+	assert.Equal(t, uint64(75), cfg.Chains[0].BigTransactionSize)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), cfg.Chains[1].ChainId)
+	assert.Equal(t, uint64(100000), cfg.Chains[1].DailyLimit)
+
+	require.Equal(t, 2, len(cfg.Tokens))
+	sol := cfg.Tokens[0]
+	assert.Equal(t, uint32(vaa.ChainIDSolana), sol.OriginChainId)
+	assert.Equal(t, "0x069b8857feab8184fb687f634618c035dac439dc1aeb3b5598a0f00000000001", sol.OriginAddress)
+	assert.Equal(t, "SOL", sol.Symbol)
+	assert.Equal(t, "wrapped-solana", sol.CoinGeckoId)
+	assert.Equal(t, int64(8), sol.Decimals)
+	assert.Equal(t, 34.94, sol.Price)
+	assert.Equal(t, 34.94, sol.CfgPrice)
+
+	weth := cfg.Tokens[1]
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), weth.OriginChainId)
+	assert.Equal(t, "WETH", weth.Symbol)
+}
+
+func TestDumpConfigMainnet(t *testing.T) {
+	gov := NewChainGovernor(zap.NewNop(), nil, common.MainNet)
+	require.NoError(t, gov.initConfig())
+
+	cfg := gov.DumpConfig()
+	assert.Equal(t, len(chainList()), len(cfg.Chains))
+	assert.Equal(t, len(gov.tokens), len(cfg.Tokens))
+}
+
+// getPendingCount returns the value of the pending count gauge for the chain.
+func getPendingCount(t *testing.T, chainID vaa.ChainID) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, metricPendingCount.WithLabelValues(chainID.String()).Write(m))
+	return m.Gauge.GetValue()
+}
+
+// enqueueTransfersForTest configures a governor with a low daily limit and submits transfers that all get enqueued.
+func enqueueTransfersForTest(t *testing.T, gov *ChainGovernor, txHashes []string, checkEnqueued func(i int)) []*common.MessagePublication {
+	t.Helper()
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 10000, 100000))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+
+	// The transfers exceed the daily limit, so they get enqueued.
+	now, _ := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Jun 1, 2022 at 12:00pm (CST)")
+	msgs := make([]*common.MessagePublication, len(txHashes))
+	for i := range msgs {
+		msgs[i] = &common.MessagePublication{
+			TxHash:           hashFromString(txHashes[i]),
+			Timestamp:        time.Unix(int64(1654543099), 0),
+			Nonce:            uint32(1),
+			Sequence:         uint64(i + 1),
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddr,
+			ConsistencyLevel: uint8(32),
+			Payload: buildMockTransferPayloadBytes(1,
+				vaa.ChainIDEthereum,
+				tokenAddrStr,
+				vaa.ChainIDPolygon,
+				toAddrStr,
+				50,
+			),
+		}
+
+		canPost, err := gov.ProcessMsgForTime(msgs[i], now)
+		require.NoError(t, err)
+		require.False(t, canPost)
+		if checkEnqueued != nil {
+			checkEnqueued(i)
+		}
+	}
+
+	return msgs
+}
+
+func TestPendingCountMetric(t *testing.T) {
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+
+	txHashes := []string{
+		"0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063",
+		"0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4064",
+	}
+	msgs := enqueueTransfersForTest(t, gov, txHashes, func(i int) {
+		assert.Equal(t, float64(i+1), getPendingCount(t, vaa.ChainIDEthereum))
+	})
+
+	_, err = gov.ReleasePendingVAA(msgs[0].MessageIDString())
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), getPendingCount(t, vaa.ChainIDEthereum))
+
+	_, err = gov.DropPendingVAA(msgs[1].MessageIDString())
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), getPendingCount(t, vaa.ChainIDEthereum))
+}
+
+func TestReleasePendingVAAToSink(t *testing.T) {
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+
+	sinkC := make(chan *common.MessagePublication, 1)
+	gov.SetReleaseSink(sinkC)
+
+	msgs := enqueueTransfersForTest(t, gov, []string{
+		"0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063",
+		"0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4064",
+	}, nil)
+
+	_, err = gov.ReleasePendingVAA(msgs[1].MessageIDString())
+	require.NoError(t, err)
+
+	// The released VAA shows up on the sink rather than in the normal publishing path.
+	require.Equal(t, 1, len(sinkC))
+	released := <-sinkC
+	assert.Equal(t, msgs[1].MessageIDString(), released.MessageIDString())
+	assert.Equal(t, msgs[1].TxHash, released.TxHash)
+	assert.Equal(t, msgs[1].Sequence, released.Sequence)
+	assert.Equal(t, msgs[1].EmitterChain, released.EmitterChain)
+	assert.Equal(t, msgs[1].EmitterAddress, released.EmitterAddress)
+	assert.Equal(t, msgs[1].Payload, released.Payload)
+	assert.Empty(t, gov.msgsToPublish)
+
+	enqueued, err := gov.IsVAAEnqueued(&publicrpcv1.MessageID{
+		EmitterChain:   publicrpcv1.ChainID(msgs[1].EmitterChain),
+		EmitterAddress: msgs[1].EmitterAddress.String(),
+		Sequence:       msgs[1].Sequence,
+	})
+	require.NoError(t, err)
+	assert.False(t, enqueued)
+
+	// If the sink is full, the VAA stays pending.
+	sinkC <- &common.MessagePublication{}
+	_, err = gov.ReleasePendingVAA(msgs[0].MessageIDString())
+	require.ErrorContains(t, err, "release sink is full")
+	_, _, numPending, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 1, numPending)
+}
+
+func TestResetReleaseTimerWithDelay(t *testing.T) {
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, gov.SetMaxReleaseDelay(72*time.Hour))
+
+	msgs := enqueueTransfersForTest(t, gov, []string{
+		"0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063",
+	}, nil)
+	msgId := msgs[0].MessageIDString()
+
+	now, _ := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Jun 2, 2022 at 12:00pm (CST)")
+	_, err = gov.resetReleaseTimerForTime(msgId, 48*time.Hour, now)
+	require.NoError(t, err)
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	require.Equal(t, 1, len(ce.pending))
+	assert.Equal(t, now.Add(48*time.Hour), ce.pending[0].dbData.ReleaseTime)
+
+	// A zero delay falls back to the standard enqueue time.
+	_, err = gov.resetReleaseTimerForTime(msgId, 0, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(maxEnqueuedTime), ce.pending[0].dbData.ReleaseTime)
+}
+
+func TestResetReleaseTimerExceedsMax(t *testing.T) {
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, gov.SetMaxReleaseDelay(72*time.Hour))
+
+	msgs := enqueueTransfersForTest(t, gov, []string{
+		"0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063",
+	}, nil)
+	msgId := msgs[0].MessageIDString()
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	require.Equal(t, 1, len(ce.pending))
+	origReleaseTime := ce.pending[0].dbData.ReleaseTime
+
+	now, _ := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Jun 2, 2022 at 12:00pm (CST)")
+	_, err = gov.resetReleaseTimerForTime(msgId, 73*time.Hour, now)
+	require.ErrorContains(t, err, "exceeds the maximum")
+
+	_, err = gov.resetReleaseTimerForTime(msgId, -time.Hour, now)
+	require.ErrorContains(t, err, "must not be negative")
+
+	// The release time is unchanged by the rejected resets.
+	assert.Equal(t, origReleaseTime, ce.pending[0].dbData.ReleaseTime)
+
+	// The configured maximum may not be below the standard enqueue time.
+	require.Error(t, gov.SetMaxReleaseDelay(time.Hour))
+}
@@ -1232,7 +1232,7 @@ func TestLargeTransactionGetsEnqueuedAndReleasedWhenTheTimerExpires(t *testing.T
 	assert.Equal(t, 1, len(gov.msgsSeen))
 
 	// But then the operator resets the release time.
-	_, err = gov.resetReleaseTimerForTime(msg3.MessageIDString(), now)
+	_, err = gov.resetReleaseTimerForTime(msg3.MessageIDString(), 0, now)
 	require.NoError(t, err)
 
 	// So now, 12 hours later the big transaction is enqueued, it still won't get released.
@@ -1373,6 +1373,57 @@ func TestIsBigTransfer(t *testing.T) {
 	assert.Equal(t, true, ce.isBigTransfer(uint64(5_000_001)))
 }
 
+func TestChainEntryFindSameTransfer(t *testing.T) {
+	ce := chainEntry{emitterChainId: vaa.ChainIDEthereum}
+
+	emitterAddr := vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}
+	msg1 := common.MessagePublication{
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(1),
+		Payload:          []byte{1},
+	}
+	msg2 := msg1
+	msg2.Sequence = uint64(2)
+
+	ce.addTransfer(&db.Transfer{MsgID: msg1.MessageIDString(), Hash: hashFromMsg(&msg1), HashWithoutConsistencyLevel: hashWithoutConsistencyLevel(&msg1)})
+	ce.addTransfer(&db.Transfer{MsgID: msg2.MessageIDString(), Hash: hashFromMsg(&msg2), HashWithoutConsistencyLevel: hashWithoutConsistencyLevel(&msg2)})
+
+	finalized1 := msg1
+	finalized1.ConsistencyLevel = uint8(32)
+	xferComplete, found := ce.findSameTransfer(&finalized1)
+	assert.True(t, found)
+	assert.Equal(t, transferComplete, xferComplete)
+
+	// Reusing the message ID with a different payload is a different transfer.
+	reused1 := finalized1
+	reused1.Payload = []byte{2}
+	_, found = ce.findSameTransfer(&reused1)
+	assert.False(t, found)
+
+	// Trimming the oldest transfer removes it from the index as well.
+	ce.trimTransfers(1)
+	require.Equal(t, 1, len(ce.transfers))
+	_, found = ce.findSameTransfer(&finalized1)
+	assert.False(t, found)
+	_, found = ce.findSameTransfer(&msg2)
+	assert.True(t, found)
+
+	ce.clearTransfers()
+	_, found = ce.findSameTransfer(&msg2)
+	assert.False(t, found)
+
+	// A transfer stored without a hash without consistency level is not found.
+	ce.addTransfer(&db.Transfer{MsgID: msg1.MessageIDString(), Hash: hashFromMsg(&msg1)})
+	_, found = ce.findSameTransfer(&finalized1)
+	assert.False(t, found)
+	ce.trimTransfers(1)
+	assert.Equal(t, 0, len(ce.transfers))
+}
+
 func TestTransferPayloadTooShort(t *testing.T) {
 	ctx := context.Background()
 	gov, err := newChainGovernorForTest(ctx)
@@ -1936,3 +1987,249 @@ func TestPendingTransferWithBadPayloadGetsDroppedNotReleased(t *testing.T) {
 	_, exists = gov.msgsSeen[gov.HashFromMsg(&msg2)]
 	assert.False(t, exists)
 }
+
+func TestMsgBelowMinConsistencyLevelIsNotCounted(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 1000000, 0)
+	require.NoError(t, err)
+	err = gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62)
+	require.NoError(t, err)
+
+	gov.SetMinConsistencyLevel(32)
+
+	payloadBytes := buildMockTransferPayloadBytes(1,
+		vaa.ChainIDEthereum,
+		tokenAddrStr,
+		vaa.ChainIDPolygon,
+		toAddrStr,
+		1.25,
+	)
+
+	msg := common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(1),
+		Payload:          payloadBytes,
+	}
+
+	// A confirmed-level message within the limits is published but not counted.
+	canPost, err := gov.ProcessMsgForTime(&msg, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	numTrans, valueTrans, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 0, numTrans)
+	assert.Equal(t, uint64(0), valueTrans)
+	assert.Equal(t, 0, numPending)
+	assert.Equal(t, uint64(0), valuePending)
+	assert.Equal(t, 0, len(gov.msgsSeen))
+
+	// The same transfer at the threshold is counted.
+	msg.ConsistencyLevel = uint8(32)
+	canPost, err = gov.ProcessMsgForTime(&msg, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	numTrans, valueTrans, _, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 1, numTrans)
+	assert.Equal(t, uint64(2218), valueTrans)
+}
+
+func TestMsgBelowMinConsistencyLevelIsStillGoverned(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 1000000, 100000)
+	require.NoError(t, err)
+	err = gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62)
+	require.NoError(t, err)
+
+	gov.SetMinConsistencyLevel(32)
+
+	// 100 WETH is a big transaction, so it must be enqueued even though it is below the minimum consistency level.
+	payloadBytes := buildMockTransferPayloadBytes(1,
+		vaa.ChainIDEthereum,
+		tokenAddrStr,
+		vaa.ChainIDPolygon,
+		toAddrStr,
+		100,
+	)
+
+	msg := common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(1),
+		Payload:          payloadBytes,
+	}
+
+	canPost, err := gov.ProcessMsgForTime(&msg, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	numTrans, valueTrans, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 0, numTrans)
+	assert.Equal(t, uint64(0), valueTrans)
+	assert.Equal(t, 1, numPending)
+	assert.Equal(t, uint64(177461), valuePending)
+}
+
+func TestPendingMsgBelowMinConsistencyLevelIsNotCountedOnRelease(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(60)
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 4000, 100000)
+	require.NoError(t, err)
+	err = gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62)
+	require.NoError(t, err)
+
+	gov.SetMinConsistencyLevel(32)
+
+	// A finalized transfer of 1 WETH is counted.
+	msg1 := common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(32),
+		Payload:          buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, tokenAddrStr, vaa.ChainIDPolygon, toAddrStr, 1),
+	}
+
+	// A confirmed transfer of 2 WETH would exceed the daily limit, so it is enqueued.
+	msg2 := common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4064"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(2),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(1),
+		Payload:          buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, tokenAddrStr, vaa.ChainIDPolygon, toAddrStr, 2),
+	}
+
+	now := time.Now()
+	canPost, err := gov.ProcessMsgForTime(&msg1, now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(&msg2, now)
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	numTrans, valueTrans, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 1, numTrans)
+	assert.Equal(t, uint64(1774), valueTrans)
+	assert.Equal(t, 1, numPending)
+	assert.Equal(t, uint64(3549), valuePending)
+
+	// Once the first transfer leaves the window, the pending one fits and is released without being counted.
+	toBePublished, err := gov.CheckPendingForTime(now.Add(61 * time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(toBePublished))
+	assert.Equal(t, msg2.Sequence, toBePublished[0].Sequence)
+
+	numTrans, valueTrans, numPending, valuePending = gov.getStatsForAllChains()
+	assert.Equal(t, 0, numTrans)
+	assert.Equal(t, uint64(0), valueTrans)
+	assert.Equal(t, 0, numPending)
+	assert.Equal(t, uint64(0), valuePending)
+	assert.Equal(t, 0, len(gov.msgsSeen))
+}
+
+func TestSameTransferAtDifferentConsistencyLevelsIsCountedOnce(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 1000000, 0)
+	require.NoError(t, err)
+	err = gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62)
+	require.NoError(t, err)
+
+	payloadBytes := buildMockTransferPayloadBytes(1,
+		vaa.ChainIDEthereum,
+		tokenAddrStr,
+		vaa.ChainIDPolygon,
+		toAddrStr,
+		1.25,
+	)
+
+	confirmed := common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(1),
+		Payload:          payloadBytes,
+	}
+
+	finalized := confirmed
+	finalized.ConsistencyLevel = uint8(32)
+	require.NotEqual(t, gov.HashFromMsg(&confirmed), gov.HashFromMsg(&finalized))
+
+	canPost, err := gov.ProcessMsgForTime(&confirmed, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(&finalized, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	numTrans, valueTrans, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 1, numTrans)
+	assert.Equal(t, uint64(2218), valueTrans)
+	assert.Equal(t, 0, numPending)
+	assert.Equal(t, uint64(0), valuePending)
+}
@@ -12,13 +12,17 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/certusone/wormhole/node/pkg/publicrpc"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/reflection"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -29,15 +33,27 @@ func adminServiceRunnable(
 	socketPath string,
 	injectC chan<- *common.MessagePublication,
 	signedInC chan<- *gossipv1.SignedVAAWithQuorum,
+	gossipSendC chan<- []byte,
 	obsvReqSendC chan<- *gossipv1.ObservationRequest,
 	db *db.Database,
 	gst *common.GuardianSetState,
 	gov *governor.ChainGovernor,
+	aggStats *processor.AggregationStatsState,
 	gk *ecdsa.PrivateKey,
 	ethRpc *string,
 	ethContract *string,
 	rpcMap map[string]string,
+	env common.Environment,
+	nodeKeyRotateC chan<- libp2p_crypto.PrivKey,
+	watchedChains map[vaa.ChainID]struct{},
+	auditLogger *zap.Logger,
+	cfg adminrpc.AdminServiceConfig,
 ) (supervisor.Runnable, error) {
+	disabledMethodsInterceptor, err := adminrpc.NewDisabledMethodsInterceptor(cfg.DisabledMethods)
+	if err != nil {
+		return nil, err
+	}
+
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
 	if err == nil {
@@ -87,17 +103,33 @@ func adminServiceRunnable(
 		obsvReqSendC,
 		logger.Named("adminservice"),
 		signedInC,
+		gossipSendC,
+		gst,
 		gov,
 		evmConnector,
 		gk,
 		ethcrypto.PubkeyToAddress(gk.PublicKey),
 		rpcMap,
+		cfg.GuardianSetSoftMax,
+		env,
+		cfg.NodeKeyPath,
+		nodeKeyRotateC,
+		cfg.DevBuild,
+		watchedChains,
+		cfg.ObserverMode,
+		aggStats,
 	)
 
 	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
 
-	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailMinimal)
+	// The audit interceptor runs first so that calls rejected because the method is disabled are audited as well.
+	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailMinimal, adminrpc.NewAuditInterceptor(auditLogger), disabledMethodsInterceptor)
 	nodev1.RegisterNodePrivilegedServiceServer(grpcServer, nodeService)
 	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, publicrpcService)
+	if cfg.GrpcReflection {
+		// Lets tools like grpcurl list and describe the admin methods.
+		reflection.Register(grpcServer)
+		logger.Info("gRPC reflection enabled on the admin socket")
+	}
 	return supervisor.GRPCServer(grpcServer, l, false), nil
 }
@@ -0,0 +1,68 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/adminrpc"
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// listAdminServices starts the admin service with the given reflection setting and lists its services using reflection.
+func listAdminServices(t *testing.T, grpcReflection bool) ([]string, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	socketPath := filepath.Join(t.TempDir(), "admin.sock")
+
+	adminService, err := adminServiceRunnable(zap.NewNop(), socketPath, nil, nil, nil, nil, nil, nil, nil, nil, gk, nil, nil, nil,
+		common.UnsafeDevNet, nil, nil, zap.NewNop(), adminrpc.AdminServiceConfig{DevBuild: true, GrpcReflection: grpcReflection})
+	require.NoError(t, err)
+	supervisor.New(ctx, zap.NewNop(), adminService)
+
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("unix:///%s", socketPath), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		services = append(services, svc.Name)
+	}
+	return services, nil
+}
+
+func TestAdminServiceReflection(t *testing.T) {
+	services, err := listAdminServices(t, true)
+	require.NoError(t, err)
+	assert.Contains(t, services, "node.v1.NodePrivilegedService")
+	assert.Contains(t, services, "publicrpc.v1.PublicRPCService")
+
+	_, err = listAdminServices(t, false)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,133 @@
+package node
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// runnableDiedMessage is the message the supervisor logs when a runnable returns or fails. Do not modify the supervisor message without updating this.
+const runnableDiedMessage = "Runnable died"
+
+// ErrNodeKeyRotated is returned by the p2p runnable to have the supervisor restart it with a rotated node key. The restart is
+// expected, so it is not recorded in the failure summary.
+var ErrNodeKeyRotated = errors.New("node key rotated, restarting p2p")
+
+// RunnableFailure describes the failures of a single supervised runnable.
+type RunnableFailure struct {
+	// Runnable is the distinguished name of the runnable in the supervision tree.
+	Runnable    string
+	Count       int
+	LastError   string
+	LastFailure time.Time
+}
+
+// FailureSummary records the runnables that died while the supervisor was running so that they can be logged as one structured
+// summary when the node exits. It is fed by teeing Core() into the logger passed to supervisor.New.
+type FailureSummary struct {
+	mu       sync.Mutex
+	failures map[string]*RunnableFailure
+}
+
+func NewFailureSummary() *FailureSummary {
+	return &FailureSummary{
+		failures: make(map[string]*RunnableFailure),
+	}
+}
+
+// Failures returns the recorded failures, ordered by the time of their last failure.
+func (fs *FailureSummary) Failures() []RunnableFailure {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	ret := make([]RunnableFailure, 0, len(fs.failures))
+	for _, f := range fs.failures {
+		ret = append(ret, *f)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].LastFailure.Before(ret[j].LastFailure)
+	})
+	return ret
+}
+
+// Log logs the recorded failures. Nothing is logged if no runnable failed.
+func (fs *FailureSummary) Log(logger *zap.Logger) {
+	failures := fs.Failures()
+	if len(failures) == 0 {
+		return
+	}
+
+	runnables := make([]string, 0, len(failures))
+	for _, f := range failures {
+		runnables = append(runnables, f.Runnable)
+		logger.Error("runnable failure summary",
+			zap.String("runnable", f.Runnable),
+			zap.Int("failures", f.Count),
+			zap.Time("lastFailure", f.LastFailure),
+			zap.String("lastError", f.LastError),
+		)
+	}
+	logger.Error("guardian exited after runnable failures", zap.Int("numFailedRunnables", len(failures)), zap.Strings("runnables", runnables))
+}
+
+func (fs *FailureSummary) record(entry zapcore.Entry, fields []zapcore.Field) {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		if err, ok := f.Interface.(error); ok && f.Type == zapcore.ErrorType && errors.Is(err, ErrNodeKeyRotated) {
+			return
+		}
+		f.AddTo(enc)
+	}
+	dn, _ := enc.Fields["dn"].(string)
+	errStr, _ := enc.Fields["error"].(string)
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	f, exists := fs.failures[dn]
+	if !exists {
+		f = &RunnableFailure{Runnable: dn}
+		fs.failures[dn] = f
+	}
+	f.Count++
+	f.LastError = errStr
+	f.LastFailure = entry.Time
+}
+
+// Core returns a zapcore.Core that records the runnable failures logged by the supervisor.
+func (fs *FailureSummary) Core() zapcore.Core {
+	return &failureSummaryCore{fs: fs}
+}
+
+type failureSummaryCore struct {
+	fs     *FailureSummary
+	fields []zapcore.Field
+}
+
+func (c *failureSummaryCore) Enabled(lvl zapcore.Level) bool {
+	return lvl >= zapcore.ErrorLevel
+}
+
+func (c *failureSummaryCore) With(fields []zapcore.Field) zapcore.Core {
+	return &failureSummaryCore{
+		fs:     c.fs,
+		fields: append(append([]zapcore.Field{}, c.fields...), fields...),
+	}
+}
+
+func (c *failureSummaryCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) && entry.Message == runnableDiedMessage {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+func (c *failureSummaryCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	c.fs.record(entry, append(append([]zapcore.Field{}, c.fields...), fields...))
+	return nil
+}
+
+func (c *failureSummaryCore) Sync() error { return nil }
@@ -0,0 +1,78 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFailureSummaryRecordsFailingRunnable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	failureSummary := NewFailureSummary()
+	supervisorLogger := zap.New(zapcore.NewTee(zap.NewNop().Core(), failureSummary.Core()))
+
+	supervisor.New(ctx, supervisorLogger, func(ctx context.Context) error {
+		if err := supervisor.Run(ctx, "failing", func(ctx context.Context) error {
+			return errors.New("something went wrong")
+		}); err != nil {
+			return err
+		}
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		<-ctx.Done()
+		return nil
+	})
+
+	require.Eventually(t, func() bool {
+		return len(failureSummary.Failures()) != 0
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+
+	failures := failureSummary.Failures()
+	require.Equal(t, 1, len(failures))
+	assert.Equal(t, "root.failing", failures[0].Runnable)
+	assert.GreaterOrEqual(t, failures[0].Count, 1)
+	assert.Contains(t, failures[0].LastError, "something went wrong")
+
+	observedCore, observedLogs := observer.New(zap.InfoLevel)
+	failureSummary.Log(zap.New(observedCore))
+
+	entries := observedLogs.FilterMessage("runnable failure summary").All()
+	require.Equal(t, 1, len(entries))
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "root.failing", fields["runnable"])
+	assert.Contains(t, fields["lastError"], "something went wrong")
+	assert.Equal(t, 1, observedLogs.FilterMessage("guardian exited after runnable failures").Len())
+}
+
+func TestFailureSummaryIgnoresOtherErrors(t *testing.T) {
+	failureSummary := NewFailureSummary()
+	logger := zap.New(failureSummary.Core())
+	logger.Error("some other error", zap.String("dn", "root.foo"), zap.Error(errors.New("boom")))
+	logger.Info(runnableDiedMessage, zap.String("dn", "root.foo"))
+	assert.Empty(t, failureSummary.Failures())
+
+	observedCore, observedLogs := observer.New(zap.InfoLevel)
+	failureSummary.Log(zap.New(observedCore))
+	assert.Equal(t, 0, observedLogs.Len())
+}
+
+func TestFailureSummaryIgnoresNodeKeyRotation(t *testing.T) {
+	failureSummary := NewFailureSummary()
+	logger := zap.New(failureSummary.Core())
+	logger.Error(runnableDiedMessage, zap.String("dn", "root.p2p"), zap.Error(fmt.Errorf("returned error when HEALTHY: %w", ErrNodeKeyRotated)))
+	assert.Empty(t, failureSummary.Failures())
+
+	logger.Error(runnableDiedMessage, zap.String("dn", "root.p2p"), zap.Error(errors.New("boom")))
+	require.Equal(t, 1, len(failureSummary.Failures()))
+}
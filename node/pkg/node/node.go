@@ -10,9 +10,11 @@ import (
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/gwrelayer"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"go.uber.org/zap"
@@ -58,6 +60,7 @@ type G struct {
 	// components
 	db              *db.Database
 	gst             *common.GuardianSetState
+	aggStats        *processor.AggregationStatsState
 	acct            *accountant.Accountant
 	gov             *governor.ChainGovernor
 	gatewayRelayer  *gwrelayer.GatewayRelayer
@@ -85,6 +88,11 @@ type G struct {
 	obsvReqSendC channelPair[*gossipv1.ObservationRequest]
 	// acctC is the channel where messages will be put after they reached quorum in the accountant.
 	acctC channelPair[*common.MessagePublication]
+	// nodeKeyRotateC carries freshly rotated p2p node keys from the admin service to the p2p runnable.
+	nodeKeyRotateC channelPair[libp2p_crypto.PrivKey]
+
+	// watchedChains is the set of chains that have a watcher configured. It is populated by GuardianOptionWatchers.
+	watchedChains map[vaa.ChainID]struct{}
 
 	// Cross Chain Query Handler channels
 	chainQueryReqC            map[vaa.ChainID]chan *query.PerChainQueryInternal
@@ -117,6 +125,7 @@ func (g *G) initializeBasic(rootCtxCancel context.CancelFunc) {
 	g.obsvReqC = makeChannelPair[*gossipv1.ObservationRequest](observationRequestInboundBufferSize)
 	g.obsvReqSendC = makeChannelPair[*gossipv1.ObservationRequest](observationRequestOutboundBufferSize)
 	g.acctC = makeChannelPair[*common.MessagePublication](accountant.MsgChannelCapacity)
+	g.nodeKeyRotateC = makeChannelPair[libp2p_crypto.PrivKey](1)
 	// Cross Chain Query Handler channels
 	g.chainQueryReqC = make(map[vaa.ChainID]chan *query.PerChainQueryInternal)
 	g.signedQueryReqC = makeChannelPair[*gossipv1.SignedQueryRequest](query.SignedQueryRequestChannelSize)
@@ -125,6 +134,8 @@ func (g *G) initializeBasic(rootCtxCancel context.CancelFunc) {
 
 	// Guardian set state managed by processor
 	g.gst = common.NewGuardianSetState(nil)
+	// Aggregation state stats published by the processor
+	g.aggStats = processor.NewAggregationStatsState()
 
 	// allocate maps
 	g.runnablesWithScissors = make(map[string]supervisor.Runnable)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -26,6 +27,8 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/devnet"
+	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/p2p"
 	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
@@ -148,7 +151,7 @@ func mockGuardianRunnable(t testing.TB, gs []*mockGuardian, mockGuardianIndex ui
 		logger := supervisor.Logger(ctx)
 
 		// setup db
-		db := db.OpenDb(logger, nil)
+		db := db.OpenDb(logger, nil, db.DefaultCompression)
 		defer db.Close()
 		gs[mockGuardianIndex].db = db
 
@@ -188,15 +191,15 @@ func mockGuardianRunnable(t testing.TB, gs []*mockGuardian, mockGuardianIndex ui
 			GuardianOptionDatabase(db),
 			GuardianOptionWatchers(watcherConfigs, nil),
 			GuardianOptionNoAccountant(), // disable accountant
-			GuardianOptionGovernor(true),
+			GuardianOptionGovernor(true, 0, governor.DefaultMaxReleaseDelay),
 			GuardianOptionGatewayRelayer("", nil), // disable gateway relayer
-			GuardianOptionP2P(gs[mockGuardianIndex].p2pKey, networkID, bootstrapPeers, nodeName, false, cfg.p2pPort, "", 0, "", func() string { return "" }),
+			GuardianOptionP2P(gs[mockGuardianIndex].p2pKey, networkID, bootstrapPeers, nodeName, false, cfg.p2pPort, p2p.LowWaterMarkDefault, p2p.HighWaterMarkDefault, true, "", 0, "", func() string { return "" }),
 			GuardianOptionPublicRpcSocket(cfg.publicSocket, publicRpcLogDetail),
-			GuardianOptionPublicrpcTcpService(cfg.publicRpc, publicRpcLogDetail),
-			GuardianOptionPublicWeb(cfg.publicWeb, cfg.publicSocket, "", false, ""),
-			GuardianOptionAdminService(cfg.adminSocket, nil, nil, rpcMap),
+			GuardianOptionPublicrpcTcpService(cfg.publicRpc, publicRpcLogDetail, "", "", ""),
+			GuardianOptionPublicWeb(cfg.publicWeb, cfg.publicSocket, "", false, "", tls.VersionTLS12, 0, 0),
+			GuardianOptionAdminService(cfg.adminSocket, nil, nil, rpcMap, adminrpc.AdminServiceConfig{DevBuild: true}),
 			GuardianOptionStatusServer(fmt.Sprintf("[::]:%d", cfg.statusPort)),
-			GuardianOptionProcessor(),
+			GuardianOptionProcessor(processor.Config{MaxPayloadSize: processor.DefaultMaxPayloadSize}, 0, 0),
 		}
 
 		guardianNode := NewGuardianNode(
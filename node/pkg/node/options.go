@@ -9,6 +9,7 @@ import (
 
 	"github.com/benbjohnson/clock"
 	"github.com/certusone/wormhole/node/pkg/accountant"
+	"github.com/certusone/wormhole/node/pkg/adminrpc"
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
@@ -39,54 +40,87 @@ type GuardianOption struct {
 
 // GuardianOptionP2P configures p2p networking.
 // Dependencies: Accountant, Governor
-func GuardianOptionP2P(p2pKey libp2p_crypto.PrivKey, networkId string, bootstrapPeers string, nodeName string, disableHeartbeatVerify bool, port uint, ccqBootstrapPeers string, ccqPort uint, ccqAllowedPeers string, ibcFeaturesFunc func() string) *GuardianOption {
+func GuardianOptionP2P(p2pKey libp2p_crypto.PrivKey, networkId string, bootstrapPeers string, nodeName string, disableHeartbeatVerify bool, port uint, connMgrLow int, connMgrHigh int, requireBootstrap bool, ccqBootstrapPeers string, ccqPort uint, ccqAllowedPeers string, ibcFeaturesFunc func() string) *GuardianOption {
 	return &GuardianOption{
 		name:         "p2p",
 		dependencies: []string{"accountant", "governor", "gateway-relayer"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			components := p2p.DefaultComponents()
 			components.Port = port
+			if err := components.SetConnMgrWatermarks(connMgrLow, connMgrHigh); err != nil {
+				return fmt.Errorf("failed to configure p2p connection manager: %w", err)
+			}
+			components.RequireBootstrap = requireBootstrap
+			if !requireBootstrap {
+				readiness.RegisterComponent(p2p.ReadinessBootstrapPeers)
+			}
 
 			if g.env == common.GoTest {
 				components.WarnChannelOverflow = true
 				components.SignedHeartbeatLogLevel = zapcore.InfoLevel
 			}
 
-			g.runnables["p2p"] = p2p.Run(
-				g.obsvC,
-				g.obsvReqC.writeC,
-				g.obsvReqSendC.readC,
-				g.gossipSendC,
-				g.signedInC.writeC,
-				p2pKey,
-				g.gk,
-				g.gst,
-				networkId,
-				bootstrapPeers,
-				nodeName,
-				disableHeartbeatVerify,
-				g.rootCtxCancel,
-				g.acct,
-				g.gov,
-				nil,
-				nil,
-				components,
-				ibcFeaturesFunc,
-				(g.gatewayRelayer != nil),
-				(g.queryHandler != nil),
-				g.signedQueryReqC.writeC,
-				g.queryResponsePublicationC.readC,
-				ccqBootstrapPeers,
-				ccqPort,
-				ccqAllowedPeers,
-			)
+			runP2P := func(p2pKey libp2p_crypto.PrivKey) supervisor.Runnable {
+				return p2p.Run(
+					g.obsvC,
+					g.obsvReqC.writeC,
+					g.obsvReqSendC.readC,
+					g.gossipSendC,
+					g.signedInC.writeC,
+					p2pKey,
+					g.gk,
+					g.gst,
+					networkId,
+					bootstrapPeers,
+					nodeName,
+					disableHeartbeatVerify,
+					g.rootCtxCancel,
+					g.acct,
+					g.gov,
+					nil,
+					nil,
+					components,
+					ibcFeaturesFunc,
+					(g.gatewayRelayer != nil),
+					(g.queryHandler != nil),
+					g.signedQueryReqC.writeC,
+					g.queryResponsePublicationC.readC,
+					ccqBootstrapPeers,
+					ccqPort,
+					ccqAllowedPeers,
+				)
+			}
+
+			// The p2p runnable is wrapped so that a node key rotated through the admin service restarts the p2p stack.
+			// Returning an error makes the supervisor restart this runnable, which then comes up with the new identity.
+			g.runnables["p2p"] = func(ctx context.Context) error {
+				p2pCtx, cancel := context.WithCancel(ctx)
+				defer cancel()
+
+				errC := make(chan error, 1)
+				go func() {
+					errC <- runP2P(p2pKey)(p2pCtx)
+				}()
+
+				select {
+				case err := <-errC:
+					return err
+				case newKey := <-g.nodeKeyRotateC.readC:
+					cancel()
+					<-errC
+					p2pKey = newKey
+					return ErrNodeKeyRotated
+				}
+			}
 
 			return nil
 		}}
 }
 
 // GuardianOptionQueryHandler configures the Cross Chain Query module.
-func GuardianOptionQueryHandler(ccqEnabled bool, allowedRequesters string) *GuardianOption {
+// cfg holds the optional query handler settings, see query.QueryHandlerConfig. Its Gst is set by the guardian.
+// A non-empty cfg.RequestPrefix replaces the query signing prefix of the environment and is not allowed in mainnet.
+func GuardianOptionQueryHandler(ccqEnabled bool, allowedRequesters string, cfg query.QueryHandlerConfig) *GuardianOption {
 	return &GuardianOption{
 		name: "query",
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
@@ -95,6 +129,16 @@ func GuardianOptionQueryHandler(ccqEnabled bool, allowedRequesters string) *Guar
 				return nil
 			}
 
+			if len(cfg.RequestPrefix) != 0 {
+				if g.env == common.MainNet {
+					return errors.New("a custom query request prefix is not allowed in mainnet")
+				}
+				if err := query.ValidateQueryRequestPrefix(string(cfg.RequestPrefix)); err != nil {
+					return err
+				}
+			}
+
+			cfg.Gst = g.gst
 			g.queryHandler = query.NewQueryHandler(
 				logger,
 				g.env,
@@ -103,6 +147,7 @@ func GuardianOptionQueryHandler(ccqEnabled bool, allowedRequesters string) *Guar
 				g.chainQueryReqC,
 				g.queryResponseC.readC,
 				g.queryResponsePublicationC.writeC,
+				cfg,
 			)
 
 			return nil
@@ -184,9 +229,10 @@ func GuardianOptionAccountant(
 		}}
 }
 
-// GuardianOptionGovernor enables or disables the governor.
+// GuardianOptionGovernor enables or disables the governor. Messages below minConsistencyLevel are still subject to the limits, but are not counted toward the notional value.
+// maxReleaseDelay bounds how far an admin can push back the release of a pending VAA.
 // Dependencies: db
-func GuardianOptionGovernor(governorEnabled bool) *GuardianOption {
+func GuardianOptionGovernor(governorEnabled bool, minConsistencyLevel uint8, maxReleaseDelay time.Duration) *GuardianOption {
 	return &GuardianOption{
 		name:         "governor",
 		dependencies: []string{"db"},
@@ -194,6 +240,10 @@ func GuardianOptionGovernor(governorEnabled bool) *GuardianOption {
 			if governorEnabled {
 				logger.Info("chain governor is enabled")
 				g.gov = governor.NewChainGovernor(logger, g.db, g.env)
+				g.gov.SetMinConsistencyLevel(minConsistencyLevel)
+				if err := g.gov.SetMaxReleaseDelay(maxReleaseDelay); err != nil {
+					return err
+				}
 			} else {
 				logger.Info("chain governor is disabled")
 			}
@@ -444,6 +494,11 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 				}
 			}
 
+			g.watchedChains = make(map[vaa.ChainID]struct{}, len(chainObsvReqC))
+			for chainID := range chainObsvReqC {
+				g.watchedChains[chainID] = struct{}{}
+			}
+
 			go handleReobservationRequests(ctx, clock.New(), logger, g.obsvReqC.readC, chainObsvReqC)
 
 			return nil
@@ -451,25 +506,42 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 }
 
 // GuardianOptionAdminService enables the admin rpc service on a unix socket.
-// Dependencies: db, governor
-func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *string, rpcMap map[string]string) *GuardianOption {
+// cfg holds the optional admin service settings, see adminrpc.AdminServiceConfig.
+// Dependencies: db, governor, watchers
+func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *string, rpcMap map[string]string, cfg adminrpc.AdminServiceConfig) *GuardianOption {
 	return &GuardianOption{
 		name:         "admin-service",
-		dependencies: []string{"governor", "db"},
+		dependencies: []string{"governor", "db", "watchers"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			auditLogger, closeAuditLog, err := adminrpc.NewAuditLogger(logger.Named("adminaudit"), cfg.AuditLogFile)
+			if err != nil {
+				return err
+			}
+			go func() {
+				<-ctx.Done()
+				_ = closeAuditLog()
+			}()
+
 			adminService, err := adminServiceRunnable(
 				logger,
 				socketPath,
 				g.msgC.writeC,
 				g.signedInC.writeC,
+				g.gossipSendC,
 				g.obsvReqSendC.writeC,
 				g.db,
 				g.gst,
 				g.gov,
+				g.aggStats,
 				g.gk,
 				ethRpc,
 				ethContract,
 				rpcMap,
+				g.env,
+				g.nodeKeyRotateC.writeC,
+				g.watchedChains,
+				auditLogger,
+				cfg,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to create admin service: %w", err)
@@ -499,14 +571,19 @@ func GuardianOptionPublicRpcSocket(publicGRPCSocketPath string, publicRpcLogDeta
 		}}
 }
 
-// GuardianOptionPublicrpcTcpService enables the public gRPC service on TCP.
+// GuardianOptionPublicrpcTcpService enables the public gRPC service on TCP. It is served over TLS if a certificate and key are given,
+// and additionally requires client certificates signed by clientCAFile if that is given.
 // Dependencies: db, governor, publicrpcsocket
-func GuardianOptionPublicrpcTcpService(publicRpc string, publicRpcLogDetail common.GrpcLogDetail) *GuardianOption {
+func GuardianOptionPublicrpcTcpService(publicRpc string, publicRpcLogDetail common.GrpcLogDetail, tlsCertFile string, tlsKeyFile string, clientCAFile string) *GuardianOption {
 	return &GuardianOption{
 		name:         "publicrpc",
 		dependencies: []string{"db", "governor", "publicrpcsocket"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
-			publicrpcService := publicrpcTcpServiceRunnable(logger, publicRpc, publicRpcLogDetail, g.db, g.gst, g.gov)
+			tlsConfig, err := NewPublicRpcTLSConfig(tlsCertFile, tlsKeyFile, clientCAFile)
+			if err != nil {
+				return fmt.Errorf("failed to configure public RPC TLS: %w", err)
+			}
+			publicrpcService := publicrpcTcpServiceRunnable(logger, publicRpc, publicRpcLogDetail, tlsConfig, g.db, g.gst, g.gov)
 			g.runnables["publicrpc"] = publicrpcService
 			return nil
 		}}
@@ -514,13 +591,13 @@ func GuardianOptionPublicrpcTcpService(publicRpc string, publicRpcLogDetail comm
 
 // GuardianOptionPublicWeb enables the public rpc service on http, i.e. gRPC-web and JSON-web.
 // Dependencies: db, governor, publicrpcsocket
-func GuardianOptionPublicWeb(listenAddr string, publicGRPCSocketPath string, tlsHostname string, tlsProdEnv bool, tlsCacheDir string) *GuardianOption {
+func GuardianOptionPublicWeb(listenAddr string, publicGRPCSocketPath string, tlsHostname string, tlsProdEnv bool, tlsCacheDir string, tlsMinVersion uint16, rateLimit float64, maxBodyBytes int64) *GuardianOption {
 	return &GuardianOption{
 		name:         "publicweb",
 		dependencies: []string{"db", "governor", "publicrpcsocket"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			publicwebService := publicwebServiceRunnable(logger, listenAddr, publicGRPCSocketPath, g.publicrpcServer,
-				tlsHostname, tlsProdEnv, tlsCacheDir)
+				tlsHostname, tlsProdEnv, tlsCacheDir, tlsMinVersion, rateLimit, maxBodyBytes)
 			g.runnables["publicweb"] = publicwebService
 			return nil
 		}}
@@ -533,19 +610,30 @@ func GuardianOptionDatabase(db *db.Database) *GuardianOption {
 		name: "db",
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			g.db = db
+			if db != nil {
+				// Enforces the size limit configured with db.SetSizeLimit, if any.
+				g.runnables["dbsizelimit"] = db.RunSizeLimit
+			}
 			return nil
 		}}
 }
 
 // GuardianOptionProcessor enables the default processor, which is required to make consensus on messages.
+// cfg holds the optional processor settings, see processor.Config. Its AggStats is set by the guardian.
+// govInterval and cleanupInterval override processor.GovInterval and processor.CleanupInterval, zero keeps the defaults.
 // Dependencies: db, governor, accountant
-func GuardianOptionProcessor() *GuardianOption {
+func GuardianOptionProcessor(cfg processor.Config, govInterval time.Duration, cleanupInterval time.Duration) *GuardianOption {
 	return &GuardianOption{
 		name: "processor",
 		// governor and accountant may be set to nil, but that choice needs to be made before the processor is configured
 		dependencies: []string{"db", "governor", "accountant", "gateway-relayer"},
 
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if err := setProcessorIntervals(govInterval, cleanupInterval); err != nil {
+				return err
+			}
+
+			cfg.AggStats = g.aggStats
 
 			g.runnables["processor"] = processor.NewProcessor(ctx,
 				g.db,
@@ -561,8 +649,26 @@ func GuardianOptionProcessor() *GuardianOption {
 				g.acct,
 				g.acctC.readC,
 				g.gatewayRelayer,
+				cfg,
 			).Run
 
 			return nil
 		}}
 }
+
+// setProcessorIntervals overrides the processor's governor check and cleanup intervals. Zero values keep the current ones.
+func setProcessorIntervals(govInterval time.Duration, cleanupInterval time.Duration) error {
+	if govInterval < 0 {
+		return fmt.Errorf("governor check interval must be positive, got %s", govInterval)
+	}
+	if cleanupInterval < 0 {
+		return fmt.Errorf("processor cleanup interval must be positive, got %s", cleanupInterval)
+	}
+	if govInterval != 0 {
+		processor.GovInterval = govInterval
+	}
+	if cleanupInterval != 0 {
+		processor.CleanupInterval = cleanupInterval
+	}
+	return nil
+}
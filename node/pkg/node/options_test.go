@@ -0,0 +1,88 @@
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/devnet"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSetProcessorIntervals(t *testing.T) {
+	origGovInterval, origCleanupInterval := processor.GovInterval, processor.CleanupInterval
+	t.Cleanup(func() {
+		processor.GovInterval, processor.CleanupInterval = origGovInterval, origCleanupInterval
+	})
+
+	// Zero keeps the defaults.
+	require.NoError(t, setProcessorIntervals(0, 0))
+	assert.Equal(t, origGovInterval, processor.GovInterval)
+	assert.Equal(t, origCleanupInterval, processor.CleanupInterval)
+
+	require.NoError(t, setProcessorIntervals(5*time.Second, 7*time.Second))
+	assert.Equal(t, 5*time.Second, processor.GovInterval)
+	assert.Equal(t, 7*time.Second, processor.CleanupInterval)
+
+	assert.ErrorContains(t, setProcessorIntervals(-time.Second, 0), "governor check interval must be positive")
+	assert.ErrorContains(t, setProcessorIntervals(0, -time.Second), "processor cleanup interval must be positive")
+	assert.Equal(t, 5*time.Second, processor.GovInterval)
+	assert.Equal(t, 7*time.Second, processor.CleanupInterval)
+}
+
+func TestP2PRestartsAfterNodeKeyRotation(t *testing.T) {
+	rootCtx, rootCtxCancel := context.WithCancel(context.Background())
+	defer rootCtxCancel()
+
+	observedCore, observedLogs := observer.New(zap.InfoLevel)
+	logger := zap.New(observedCore)
+
+	g := NewGuardianNode(common.GoTest, devnet.InsecureDeterministicEcdsaKeyByIndex(ethcrypto.S256(), 0))
+	g.initializeBasic(rootCtxCancel)
+
+	oldKey := devnet.DeterministicP2PPrivKeyByIndex(0)
+	newKey := devnet.DeterministicP2PPrivKeyByIndex(1)
+	oldPeerID, err := libp2p_peer.IDFromPublicKey(oldKey.GetPublic())
+	require.NoError(t, err)
+	newPeerID, err := libp2p_peer.IDFromPublicKey(newKey.GetPublic())
+	require.NoError(t, err)
+
+	// Port zero lets the host pick a free port, no bootstrap peers are needed for this test.
+	option := GuardianOptionP2P(oldKey, "/wormhole/test", "", "rotation", false, 0, p2p.LowWaterMarkDefault, p2p.HighWaterMarkDefault, false, "", 0, "", func() string { return "" })
+	require.NoError(t, option.f(rootCtx, logger, g))
+
+	supervisor.New(rootCtx, logger, func(ctx context.Context) error {
+		if err := supervisor.Run(ctx, "p2p", g.runnables["p2p"]); err != nil {
+			return err
+		}
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		<-ctx.Done()
+		return nil
+	})
+
+	started := func(peerID libp2p_peer.ID) func() bool {
+		return func() bool {
+			for _, entry := range observedLogs.FilterMessage("Node has been started").All() {
+				if entry.ContextMap()["peer_id"] == peerID.String() {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	require.Eventually(t, started(oldPeerID), 10*time.Second, 10*time.Millisecond)
+
+	g.nodeKeyRotateC.writeC <- newKey
+	require.Eventually(t, started(newPeerID), 20*time.Second, 10*time.Millisecond)
+	assert.NoError(t, rootCtx.Err())
+}
@@ -2,6 +2,9 @@ package node
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -14,20 +17,70 @@ import (
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
-func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor) supervisor.Runnable {
+// NewPublicRpcTLSConfig returns the TLS configuration of the public RPC TCP service, or nil if no certificate is configured.
+// If a client CA is given, clients must present a certificate signed by it (mutual TLS).
+func NewPublicRpcTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, errors.New("a client CA requires a TLS certificate and key")
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both a TLS certificate and key must be specified")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// gRPC clients require HTTP/2 to be negotiated via ALPN.
+		NextProtos: []string{"h2"},
+	}
+
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA %s", clientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// publicrpcServerOptions returns the gRPC server options of the public RPC TCP service. If tlsConfig is not nil, the
+// server terminates TLS with it.
+func publicrpcServerOptions(tlsConfig *tls.Config) []grpc.ServerOption {
+	if tlsConfig == nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}
+}
+
+func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRpcLogDetail common.GrpcLogDetail, tlsConfig *tls.Config, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor) supervisor.Runnable {
 	return func(ctx context.Context) error {
 		l, err := net.Listen("tcp", listenAddr)
-
 		if err != nil {
 			return fmt.Errorf("failed to listen: %w", err)
 		}
 
-		logger.Info("publicrpc server listening", zap.String("addr", l.Addr().String()))
+		logger.Info("publicrpc server listening", zap.String("addr", l.Addr().String()), zap.Bool("tls", tlsConfig != nil), zap.Bool("mtls", tlsConfig != nil && tlsConfig.ClientCAs != nil))
 
 		rpcServer := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
-		grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail)
+		grpcServer := common.NewInstrumentedGRPCServerWithOptions(logger, publicRpcLogDetail, publicrpcServerOptions(tlsConfig))
 
 		publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, rpcServer)
 
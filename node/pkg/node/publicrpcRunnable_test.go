@@ -0,0 +1,163 @@
+package node
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/publicrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCertificate creates a certificate for 127.0.0.1 signed by parent, or a self-signed CA if parent is nil.
+func newTestCertificate(t *testing.T, parent *testCertificate, isClient bool) *testCertificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "publicrpc test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+		if isClient {
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		} else {
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+			template.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCertificate{cert: cert, key: key, der: der}
+}
+
+// writePEM writes the certificate and key to dir and returns their paths.
+func (c *testCertificate) writePEM(t *testing.T, dir string, name string) (string, string) {
+	t.Helper()
+	certFile := filepath.Join(dir, name+".crt")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0600))
+
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+func (c *testCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+func TestNewPublicRpcTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCertificate(t, nil, false)
+	caFile, _ := ca.writePEM(t, dir, "ca")
+	certFile, keyFile := newTestCertificate(t, ca, false).writePEM(t, dir, "server")
+
+	tlsConfig, err := NewPublicRpcTLSConfig("", "", "")
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+
+	tlsConfig, err = NewPublicRpcTLSConfig(certFile, keyFile, "")
+	require.NoError(t, err)
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+
+	tlsConfig, err = NewPublicRpcTLSConfig(certFile, keyFile, caFile)
+	require.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+
+	_, err = NewPublicRpcTLSConfig(certFile, "", "")
+	assert.ErrorContains(t, err, "both a TLS certificate and key must be specified")
+	_, err = NewPublicRpcTLSConfig("", "", caFile)
+	assert.ErrorContains(t, err, "a client CA requires a TLS certificate and key")
+	_, err = NewPublicRpcTLSConfig(certFile, keyFile, keyFile)
+	assert.ErrorContains(t, err, "no certificates found in client CA")
+}
+
+func TestPublicrpcTcpMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCertificate(t, nil, false)
+	caFile, _ := ca.writePEM(t, dir, "ca")
+	certFile, keyFile := newTestCertificate(t, ca, false).writePEM(t, dir, "server")
+
+	tlsConfig, err := NewPublicRpcTLSConfig(certFile, keyFile, caFile)
+	require.NoError(t, err)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(common.NewGuardianSet(nil, 0))
+	grpcServer := grpc.NewServer(publicrpcServerOptions(tlsConfig)...)
+	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, publicrpc.NewPublicrpcServer(zap.NewNop(), nil, gst, nil))
+	go func() { _ = grpcServer.Serve(l) }()
+	defer grpcServer.Stop()
+
+	caPool := x509.NewCertPool()
+	caPool.AddCert(ca.cert)
+
+	call := func(clientCerts []tls.Certificate) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		conn, err := grpc.DialContext(ctx, l.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:      caPool,
+			Certificates: clientCerts,
+			MinVersion:   tls.VersionTLS12,
+		})))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = publicrpcv1.NewPublicRPCServiceClient(conn).GetLastHeartbeats(ctx, &publicrpcv1.GetLastHeartbeatsRequest{})
+		return err
+	}
+
+	// A client without a certificate is rejected.
+	assert.Error(t, call(nil))
+
+	// A client with a certificate from a different CA is rejected.
+	otherCA := newTestCertificate(t, nil, false)
+	assert.Error(t, call([]tls.Certificate{newTestCertificate(t, otherCA, true).tlsCertificate()}))
+
+	// A client with a certificate signed by the configured CA is accepted.
+	assert.NoError(t, call([]tls.Certificate{newTestCertificate(t, ca, true).tlsCertificate()}))
+}
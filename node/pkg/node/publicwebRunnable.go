@@ -1,11 +1,16 @@
 package node
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
@@ -15,6 +20,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -47,6 +53,121 @@ func corsPreflightHandler(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
 }
 
+const (
+	// publicwebLimiterIdleTimeout is how long a per-IP rate limiter is kept after the client was last seen.
+	publicwebLimiterIdleTimeout = 10 * time.Minute
+)
+
+// ipRateLimiter hands out a token bucket per client IP.
+type ipRateLimiter struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	limiters  map[string]*ipRateLimiterEntry
+	lastPrune time.Time
+}
+
+type ipRateLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newIPRateLimiter(requestsPerSecond float64) *ipRateLimiter {
+	burst := int(requestsPerSecond)
+	if burst < 1 {
+		burst = 1
+	}
+	return &ipRateLimiter{
+		limit:     rate.Limit(requestsPerSecond),
+		burst:     burst,
+		limiters:  make(map[string]*ipRateLimiterEntry),
+		lastPrune: time.Now(),
+	}
+}
+
+// allow reports whether a request from ip may proceed.
+func (l *ipRateLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	// Drop limiters of clients that went away so the map doesn't grow without bound.
+	if now.Sub(l.lastPrune) > publicwebLimiterIdleTimeout {
+		for k, e := range l.limiters {
+			if now.Sub(e.lastSeen) > publicwebLimiterIdleTimeout {
+				delete(l.limiters, k)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	e, ok := l.limiters[ip]
+	if !ok {
+		e = &ipRateLimiterEntry{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[ip] = e
+	}
+	e.lastSeen = now
+	return e.limiter.AllowN(now, 1)
+}
+
+// limitsWrapper rejects requests from clients exceeding requestsPerSecond with 429 and requests with a body larger
+// than maxBodyBytes with 413. A zero value disables the corresponding limit.
+func limitsWrapper(h http.Handler, requestsPerSecond float64, maxBodyBytes int64) http.Handler {
+	var limiter *ipRateLimiter
+	if requestsPerSecond > 0 {
+		limiter = newIPRateLimiter(requestsPerSecond)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limiter != nil {
+			ip, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				ip = r.RemoteAddr
+			}
+			if !limiter.allow(ip) {
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+		}
+
+		if maxBodyBytes > 0 {
+			if r.ContentLength > maxBodyBytes {
+				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				return
+			}
+			// Bodies without (or with a lying) Content-Length are buffered up to the limit before the handler sees them, so
+			// that the 413 does not depend on how the handler deals with a read error.
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				} else {
+					http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				}
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// ParseTLSMinVersion maps the value of the --tlsMinVersion flag to the corresponding crypto/tls version constant.
+// Only TLS 1.2 and 1.3 are accepted.
+func ParseTLSMinVersion(version string) (uint16, error) {
+	switch version {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported TLS version %q, must be one of 1.2, 1.3", version)
+	}
+}
+
 func publicwebServiceRunnable(
 	logger *zap.Logger,
 	listenAddr string,
@@ -55,6 +176,9 @@ func publicwebServiceRunnable(
 	tlsHostname string,
 	tlsProd bool,
 	tlsCacheDir string,
+	tlsMinVersion uint16,
+	rateLimit float64,
+	maxBodyBytes int64,
 ) supervisor.Runnable {
 	return func(ctx context.Context) error {
 		conn, err := grpc.DialContext(
@@ -74,13 +198,13 @@ func publicwebServiceRunnable(
 
 		mux := http.NewServeMux()
 		grpcWebServer := grpcweb.WrapServer(grpcServer)
-		mux.Handle("/", allowCORSWrapper(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		mux.Handle("/", limitsWrapper(allowCORSWrapper(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 			if grpcWebServer.IsGrpcWebRequest(req) {
 				grpcWebServer.ServeHTTP(resp, req)
 			} else {
 				gwmux.ServeHTTP(resp, req)
 			}
-		})))
+		})), rateLimit, maxBodyBytes))
 
 		srv := &http.Server{
 			Handler:           mux,
@@ -108,6 +232,7 @@ func publicwebServiceRunnable(
 			}
 
 			srv.TLSConfig = certManager.TLSConfig()
+			srv.TLSConfig.MinVersion = tlsMinVersion
 			logger.Info("certificate provisioning configured")
 		}
 
@@ -0,0 +1,89 @@
+package node
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTLSMinVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected uint16
+	}{
+		{"1.2", tls.VersionTLS12},
+		{"1.3", tls.VersionTLS13},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			v, err := ParseTLSMinVersion(tc.version)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+
+	for _, version := range []string{"", "1.0", "1.1", "tls1.3", "13"} {
+		_, err := ParseTLSMinVersion(version)
+		assert.Error(t, err, version)
+	}
+}
+
+func TestLimitsWrapper_RateLimit(t *testing.T) {
+	h := limitsWrapper(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), 2, 0)
+
+	doRequest := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/v1/guardianset/current", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The burst allows two requests, the third one in quick succession is rejected.
+	assert.Equal(t, http.StatusOK, doRequest("10.0.0.1:1234"))
+	assert.Equal(t, http.StatusOK, doRequest("10.0.0.1:1235"))
+	assert.Equal(t, http.StatusTooManyRequests, doRequest("10.0.0.1:1236"))
+
+	// Other clients are not affected.
+	assert.Equal(t, http.StatusOK, doRequest("10.0.0.2:1234"))
+}
+
+func TestLimitsWrapper_BodySize(t *testing.T) {
+	// The handler ignores read errors, so any 413 has to come from the wrapper.
+	h := limitsWrapper(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}), 0, 16)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(make([]byte, 16)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(make([]byte, 17)))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	// Without a Content-Length the body is still capped.
+	req = httptest.NewRequest(http.MethodPost, "/", io.NopCloser(bytes.NewReader(make([]byte, 16))))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/", io.NopCloser(bytes.NewReader(make([]byte, 17))))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
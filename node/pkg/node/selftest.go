@@ -0,0 +1,138 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/watchers/solana"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/zap"
+)
+
+// SelfTestCheck is a single startup check run by `guardiand node --selfTest`. On success, it returns a short detail for the report.
+type SelfTestCheck struct {
+	Name string
+	Run  func(ctx context.Context) (string, error)
+}
+
+type SelfTestResult struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type SelfTestReport struct {
+	Passed  bool             `json:"passed"`
+	Results []SelfTestResult `json:"results"`
+}
+
+// RunSelfTest runs all of the checks, even if some of them fail, and returns the report.
+func RunSelfTest(ctx context.Context, checks []SelfTestCheck) *SelfTestReport {
+	report := &SelfTestReport{
+		Passed:  true,
+		Results: make([]SelfTestResult, 0, len(checks)),
+	}
+	for _, check := range checks {
+		detail, err := check.Run(ctx)
+		result := SelfTestResult{Name: check.Name, Passed: err == nil, Detail: detail}
+		if err != nil {
+			result.Error = err.Error()
+			report.Passed = false
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// Write writes the report as indented JSON.
+func (r *SelfTestReport) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// SelfTestDatabase checks that the existing database can be opened, which fails if it is missing, corrupted or already in
+// use by a running guardian, and reports its schema version. The database is opened read-only, so it is not migrated.
+func SelfTestDatabase(logger *zap.Logger, dataDir string) SelfTestCheck {
+	return SelfTestCheck{
+		Name: "database",
+		Run: func(ctx context.Context) (string, error) {
+			d, err := db.OpenReadOnlyDb(logger, dataDir)
+			if err != nil {
+				return "", err
+			}
+			version, err := d.SchemaVersion()
+			if closeErr := d.Close(); err == nil && closeErr != nil {
+				err = fmt.Errorf("failed to close database: %w", closeErr)
+			}
+			if err != nil {
+				return "", err
+			}
+			if version > db.CurrentSchemaVersion {
+				return "", fmt.Errorf("database schema version %d is newer than the supported version %d", version, db.CurrentSchemaVersion)
+			}
+			return fmt.Sprintf("%s (schema version %d, current %d)", dataDir, version, db.CurrentSchemaVersion), nil
+		},
+	}
+}
+
+// SelfTestGuardianKey checks that the guardian key can be loaded.
+func SelfTestGuardianKey(path string, unsafeDevMode bool) SelfTestCheck {
+	return SelfTestCheck{
+		Name: "guardianKey",
+		Run: func(ctx context.Context) (string, error) {
+			gk, err := common.LoadGuardianKey(path, unsafeDevMode)
+			if err != nil {
+				return "", err
+			}
+			return ethcrypto.PubkeyToAddress(gk.PublicKey).String(), nil
+		},
+	}
+}
+
+// SelfTestSolanaRPC checks that the Solana RPC is reachable and healthy.
+func SelfTestSolanaRPC(rpcUrl string) SelfTestCheck {
+	return SelfTestCheck{
+		Name: "solanaRPC",
+		Run: func(ctx context.Context) (string, error) {
+			if err := solana.CheckRPCHealth(ctx, rpcUrl); err != nil {
+				return "", err
+			}
+			return rpcUrl, nil
+		},
+	}
+}
+
+// SelfTestP2PKey checks that the p2p node key is valid. Unlike common.GetOrCreateNodeKey, a missing key is not created,
+// since the guardian generates one on its first start.
+func SelfTestP2PKey(path string) SelfTestCheck {
+	return SelfTestCheck{
+		Name: "p2pKey",
+		Run: func(ctx context.Context) (string, error) {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return "no node key found, a new one will be generated on startup", nil
+				}
+				return "", fmt.Errorf("failed to read node key: %w", err)
+			}
+			priv, err := libp2p_crypto.UnmarshalPrivateKey(b)
+			if err != nil {
+				return "", fmt.Errorf("failed to unmarshal node key: %w", err)
+			}
+			id, err := peer.IDFromPrivateKey(priv)
+			if err != nil {
+				return "", fmt.Errorf("failed to derive peer ID: %w", err)
+			}
+			return id.String(), nil
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRunSelfTest(t *testing.T) {
+	checks := []SelfTestCheck{
+		{Name: "ok", Run: func(ctx context.Context) (string, error) { return "all good", nil }},
+		{Name: "broken", Run: func(ctx context.Context) (string, error) { return "", errors.New("it broke") }},
+	}
+
+	report := RunSelfTest(context.Background(), checks)
+	assert.False(t, report.Passed)
+	require.Equal(t, 2, len(report.Results))
+	assert.Equal(t, SelfTestResult{Name: "ok", Passed: true, Detail: "all good"}, report.Results[0])
+	assert.Equal(t, SelfTestResult{Name: "broken", Passed: false, Error: "it broke"}, report.Results[1])
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+	var decoded SelfTestReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, *report, decoded)
+
+	assert.True(t, RunSelfTest(context.Background(), checks[:1]).Passed)
+}
+
+func TestSelfTestDatabase(t *testing.T) {
+	dataDir := t.TempDir()
+	check := SelfTestDatabase(zap.NewNop(), dataDir)
+
+	// A missing database is an error and is not created.
+	_, err := check.Run(context.Background())
+	assert.Error(t, err)
+	_, err = os.Stat(filepath.Join(dataDir, "db"))
+	assert.True(t, os.IsNotExist(err))
+
+	// The database cannot be opened while it is in use, e.g. by a running guardian.
+	d := db.OpenDb(zap.NewNop(), &dataDir, db.DefaultCompression)
+	_, err = check.Run(context.Background())
+	assert.Error(t, err)
+	require.NoError(t, d.Close())
+
+	detail, err := check.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%s (schema version %d, current %d)", dataDir, db.CurrentSchemaVersion, db.CurrentSchemaVersion), detail)
+}
+
+func TestSelfTestGuardianKey(t *testing.T) {
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "guardian.key")
+	require.NoError(t, common.WriteArmoredKey(gk, "", path, common.GuardianKeyArmoredBlock, false))
+	detail, err := SelfTestGuardianKey(path, false).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ethcrypto.PubkeyToAddress(gk.PublicKey).String(), detail)
+
+	_, err = SelfTestGuardianKey(filepath.Join(t.TempDir(), "missing.key"), false).Run(context.Background())
+	assert.ErrorContains(t, err, "failed to open file")
+
+	// Deterministic devnet keys are rejected outside of unsafe dev mode.
+	unsafePath := filepath.Join(t.TempDir(), "unsafe.key")
+	require.NoError(t, common.WriteArmoredKey(gk, "", unsafePath, common.GuardianKeyArmoredBlock, true))
+	_, err = SelfTestGuardianKey(unsafePath, false).Run(context.Background())
+	assert.ErrorContains(t, err, "refusing to use deterministic key in production")
+}
+
+func TestSelfTestSolanaRPC(t *testing.T) {
+	newServer := func(body string) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID json.RawMessage `json:"id"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,%s}`, req.ID, body)
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+
+	healthy := newServer(`"result":"ok"`)
+	_, err := SelfTestSolanaRPC(healthy.URL).Run(context.Background())
+	require.NoError(t, err)
+
+	unhealthy := newServer(`"error":{"code":-32005,"message":"Node is behind by 42 slots"}`)
+	_, err = SelfTestSolanaRPC(unhealthy.URL).Run(context.Background())
+	assert.ErrorContains(t, err, "Node is behind by 42 slots")
+}
+
+func TestSelfTestP2PKey(t *testing.T) {
+	priv, _, err := libp2p_crypto.GenerateKeyPair(libp2p_crypto.Ed25519, -1)
+	require.NoError(t, err)
+	b, err := libp2p_crypto.MarshalPrivateKey(priv)
+	require.NoError(t, err)
+	expectedID, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "node.key")
+	require.NoError(t, os.WriteFile(path, b, 0600))
+	detail, err := SelfTestP2PKey(path).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, expectedID.String(), detail)
+
+	// A missing key is fine since it is generated on startup, but it must not be created by the self test.
+	missing := filepath.Join(dir, "missing.key")
+	_, err = SelfTestP2PKey(missing).Run(context.Background())
+	require.NoError(t, err)
+	assert.NoFileExists(t, missing)
+
+	corrupt := filepath.Join(dir, "corrupt.key")
+	require.NoError(t, os.WriteFile(corrupt, []byte("not a key"), 0600))
+	_, err = SelfTestP2PKey(corrupt).Run(context.Background())
+	assert.ErrorContains(t, err, "failed to unmarshal node key")
+}
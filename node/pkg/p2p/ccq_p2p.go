@@ -214,6 +214,8 @@ func (ccq *ccqP2p) listener(ctx context.Context, signedQueryReqC chan<- *gossipv
 			zap.Binary("raw", envelope.Data),
 			zap.String("from", envelope.GetFrom().String()))
 
+		CountGossipMessageReceived(&msg)
+
 		switch m := msg.Message.(type) {
 		case *gossipv1.GossipMessage_SignedQueryRequest:
 			if err := query.PostSignedQueryRequest(signedQueryReqC, m.SignedQueryRequest); err != nil {
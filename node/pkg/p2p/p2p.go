@@ -14,6 +14,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/version"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -50,6 +51,12 @@ const P2P_SUBSCRIPTION_BUFFER_SIZE = 1024
 // TESTNET_BOOTSTRAP_DHI configures how many nodes may connect to the testnet bootstrap node. This number should not exceed HighWaterMark.
 const TESTNET_BOOTSTRAP_DHI = 350
 
+// ReadinessBootstrapPeers is only registered if Components.RequireBootstrap is false. It is not ready until a bootstrap peer has been reached.
+const ReadinessBootstrapPeers readiness.Component = "p2pBootstrapPeers"
+
+// bootstrapRetryInterval is how often a node that could not reach any bootstrap peer retries to connect.
+const bootstrapRetryInterval = 30 * time.Second
+
 var (
 	p2pHeartbeatsSent = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -71,8 +78,48 @@ var (
 			Name: "wormhole_p2p_receive_channel_overflow",
 			Help: "Total number of p2p received messages dropped due to channel overflow",
 		}, []string{"type"})
+	p2pPeerCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_peer_count",
+			Help: "Current number of connected p2p peers",
+		})
+	gossipMessagesReceived = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_gossip_messages_received_total",
+			Help: "Total number of inbound gossip messages by message type",
+		}, []string{"type"})
 )
 
+// CountGossipMessageReceived increments wormhole_gossip_messages_received_total for observations, signed VAAs,
+// query requests and query responses. Other message types are not counted. Guardians only receive query requests, so
+// query responses are counted by the query server, which subscribes to the response topic.
+func CountGossipMessageReceived(msg *gossipv1.GossipMessage) {
+	var msgType string
+	switch msg.Message.(type) {
+	case *gossipv1.GossipMessage_SignedObservation:
+		msgType = "observation"
+	case *gossipv1.GossipMessage_SignedVaaWithQuorum:
+		msgType = "signed_vaa"
+	case *gossipv1.GossipMessage_SignedQueryRequest:
+		msgType = "query_request"
+	case *gossipv1.GossipMessage_SignedQueryResponse:
+		msgType = "query_response"
+	default:
+		return
+	}
+	gossipMessagesReceived.WithLabelValues(msgType).Inc()
+}
+
+// peerLister is the part of network.Network needed to update the peer count.
+type peerLister interface {
+	Peers() []peer.ID
+}
+
+// updatePeerCount sets wormhole_p2p_peer_count to the number of peers currently connected to n.
+func updatePeerCount(n peerLister) {
+	p2pPeerCount.Set(float64(len(n.Peers())))
+}
+
 var heartbeatMessagePrefix = []byte("heartbeat|")
 
 var signedObservationRequestPrefix = []byte("signed_observation_request|")
@@ -107,6 +154,9 @@ type Components struct {
 	SignedHeartbeatLogLevel zapcore.Level
 	// GossipParams is used to configure the GossipSub instance used by the Guardian.
 	GossipParams pubsub.GossipSubParams
+	// RequireBootstrap: If true, the p2p runnable fails if it cannot connect to any bootstrap peer. If false, it keeps running
+	// in a degraded state and retries in the background, leaving ReadinessBootstrapPeers not ready until a peer is reached.
+	RequireBootstrap bool
 }
 
 func (f *Components) ListeningAddresses() []string {
@@ -137,6 +187,7 @@ func DefaultComponents() *Components {
 		ProtectedHostByGuardianKey: make(map[eth_common.Address]peer.ID),
 		SignedHeartbeatLogLevel:    zapcore.DebugLevel,
 		GossipParams:               pubsub.DefaultGossipSubParams(),
+		RequireBootstrap:           true,
 	}
 }
 
@@ -144,15 +195,40 @@ const LowWaterMarkDefault = 100
 const HighWaterMarkDefault = 400
 
 func DefaultConnectionManager() (*connmgr.BasicConnMgr, error) {
+	return NewConnectionManager(LowWaterMarkDefault, HighWaterMarkDefault)
+}
+
+// NewConnectionManager creates a connection manager that trims connections down to lowWaterMark once there are more than highWaterMark.
+func NewConnectionManager(lowWaterMark int, highWaterMark int) (*connmgr.BasicConnMgr, error) {
+	if lowWaterMark < 0 {
+		return nil, fmt.Errorf("connection manager low watermark may not be negative: %d", lowWaterMark)
+	}
+	if highWaterMark < lowWaterMark {
+		return nil, fmt.Errorf("connection manager high watermark (%d) may not be less than the low watermark (%d)", highWaterMark, lowWaterMark)
+	}
+
 	return connmgr.NewConnManager(
-		LowWaterMarkDefault,
-		HighWaterMarkDefault,
+		lowWaterMark,
+		highWaterMark,
 
 		// GracePeriod set to 0 means that new peers are not protected by a grace period
 		connmgr.WithGracePeriod(0),
 	)
 }
 
+// SetConnMgrWatermarks replaces the connection manager with one using the specified watermarks.
+func (f *Components) SetConnMgrWatermarks(lowWaterMark int, highWaterMark int) error {
+	mgr, err := NewConnectionManager(lowWaterMark, highWaterMark)
+	if err != nil {
+		return err
+	}
+	if f.ConnMgr != nil {
+		_ = f.ConnMgr.Close()
+	}
+	f.ConnMgr = mgr
+	return nil
+}
+
 // BootstrapAddrs takes a comma-separated string of multi-address strings and returns an array of []peer.AddrInfo that does not include `self`.
 // if `self` is part of `bootstrapPeers`, return isBootstrapNode=true
 func BootstrapAddrs(logger *zap.Logger, bootstrapPeers string, self peer.ID) (bootstrappers []peer.AddrInfo, isBootstrapNode bool) {
@@ -195,6 +271,31 @@ func ConnectToPeers(ctx context.Context, logger *zap.Logger, h host.Host, peers
 	return successes
 }
 
+// shouldFailWithoutBootstrapPeers returns true if the p2p runnable should exit because it could not connect to any bootstrap peer.
+// If we're a bootstrap node it's okay to not have any peers.
+func shouldFailWithoutBootstrapPeers(successes int, isBootstrapNode bool, requireBootstrap bool) bool {
+	return successes == 0 && !isBootstrapNode && requireBootstrap
+}
+
+// waitForBootstrapPeers periodically retries to connect to the bootstrap peers until it succeeds, and then marks ReadinessBootstrapPeers as ready.
+func waitForBootstrapPeers(ctx context.Context, logger *zap.Logger, h host.Host, bootstrappers []peer.AddrInfo) {
+	ticker := time.NewTicker(bootstrapRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if successes := ConnectToPeers(ctx, logger, h, bootstrappers); successes > 0 {
+				logger.Info("Connected to bootstrap peers", zap.Int("num", successes))
+				readiness.SetReady(ReadinessBootstrapPeers)
+				return
+			}
+		}
+	}
+}
+
 func NewHost(logger *zap.Logger, ctx context.Context, networkID string, bootstrapPeers string, components *Components, priv crypto.PrivKey) (host.Host, error) {
 	h, err := libp2p.New(
 		// Use the keypair we generated
@@ -276,6 +377,12 @@ func Run(
 		logger := supervisor.Logger(ctx)
 
 		defer func() {
+			// A cancelled context means the caller stopped p2p on purpose, for example to restart it with a rotated node key, so the root context is left alone.
+			if ctx.Err() != nil {
+				logger.Info("p2p routine has exited because its context was cancelled")
+				return
+			}
+
 			// TODO: Right now we're canceling the root context because it used to be the case that libp2p cannot be cleanly restarted.
 			// But that seems to no longer be the case. We may want to revisit this. See (https://github.com/libp2p/go-libp2p/issues/992) for background.
 			logger.Warn("p2p routine has exited, cancelling root context...")
@@ -343,12 +450,20 @@ func Run(
 
 		successes := ConnectToPeers(ctx, logger, h, bootstrappers)
 
-		if successes == 0 && !bootstrapNode { // If we're a bootstrap node it's okay to not have any peers.
+		if shouldFailWithoutBootstrapPeers(successes, bootstrapNode, components.RequireBootstrap) {
 			// If we fail to connect to any bootstrap peer, kill the service
 			// returning from this function will lead to rootCtxCancel() being called in the defer() above. The service will then be restarted by Tilt/kubernetes.
 			return fmt.Errorf("failed to connect to any bootstrap peer")
 		}
-		logger.Info("Connected to bootstrap peers", zap.Int("num", successes))
+		if successes == 0 && !bootstrapNode {
+			logger.Warn("failed to connect to any bootstrap peer, waiting for peers")
+			go waitForBootstrapPeers(ctx, logger, h, bootstrappers)
+		} else {
+			logger.Info("Connected to bootstrap peers", zap.Int("num", successes))
+			if !components.RequireBootstrap {
+				readiness.SetReady(ReadinessBootstrapPeers)
+			}
+		}
 
 		logger.Info("Node has been started", zap.String("peer_id", h.ID().String()),
 			zap.String("addrs", fmt.Sprintf("%v", h.Addrs())))
@@ -376,7 +491,7 @@ func Run(
 			}()
 		}
 
-		// Periodically run guardian state set cleanup.
+		// Periodically run guardian state set cleanup and update the peer count.
 		go func() {
 			ticker := time.NewTicker(15 * time.Second)
 			defer ticker.Stop()
@@ -384,6 +499,7 @@ func Run(
 				select {
 				case <-ticker.C:
 					gst.Cleanup()
+					updatePeerCount(h.Network())
 				case <-ctx.Done():
 					return
 				}
@@ -570,6 +686,8 @@ func Run(
 				zap.Binary("raw", envelope.Data),
 				zap.String("from", envelope.GetFrom().String()))
 
+			CountGossipMessageReceived(&msg)
+
 			switch m := msg.Message.(type) {
 			case *gossipv1.GossipMessage_SignedHeartbeat:
 				s := m.SignedHeartbeat
@@ -731,7 +849,7 @@ func createSignedHeartbeat(gk *ecdsa.PrivateKey, heartbeat *gossipv1.Heartbeat)
 
 func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *common.GuardianSet, gst *common.GuardianSetState, disableVerify bool) (*gossipv1.Heartbeat, error) {
 	envelopeAddr := eth_common.BytesToAddress(s.GuardianAddr)
-	idx, ok := gs.KeyIndex(envelopeAddr)
+	idx, ok := gs.IndexOf(envelopeAddr)
 	var pk eth_common.Address
 	if !ok {
 		if !disableVerify {
@@ -784,7 +902,7 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *commo
 
 func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *common.GuardianSet) (*gossipv1.ObservationRequest, error) {
 	envelopeAddr := eth_common.BytesToAddress(s.GuardianAddr)
-	idx, ok := gs.KeyIndex(envelopeAddr)
+	idx, ok := gs.IndexOf(envelopeAddr)
 	var pk eth_common.Address
 	if !ok {
 		return nil, fmt.Errorf("invalid message: %s not in guardian set", envelopeAddr)
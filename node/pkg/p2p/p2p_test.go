@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	node_common "github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	dto "github.com/prometheus/client_model/go"
 )
 
 func TestSignedHeartbeat(t *testing.T) {
@@ -103,3 +106,118 @@ func TestSignedHeartbeat(t *testing.T) {
 		testFunc(t, tc)
 	}
 }
+
+func getGossipMessagesReceived(msgType string) float64 {
+	var m = &dto.Metric{}
+	if err := gossipMessagesReceived.WithLabelValues(msgType).Write(m); err != nil {
+		return 0
+	}
+	return m.Counter.GetValue()
+}
+
+func TestCountGossipMessageReceived(t *testing.T) {
+	tests := []struct {
+		label string
+		msg   *gossipv1.GossipMessage
+	}{
+		{"observation", &gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedObservation{SignedObservation: &gossipv1.SignedObservation{}}}},
+		{"signed_vaa", &gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedVaaWithQuorum{SignedVaaWithQuorum: &gossipv1.SignedVAAWithQuorum{}}}},
+		{"query_request", &gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedQueryRequest{SignedQueryRequest: &gossipv1.SignedQueryRequest{}}}},
+		{"query_response", &gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedQueryResponse{SignedQueryResponse: &gossipv1.SignedQueryResponse{}}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			before := map[string]float64{}
+			for _, other := range tests {
+				before[other.label] = getGossipMessagesReceived(other.label)
+			}
+
+			CountGossipMessageReceived(tc.msg)
+
+			for _, other := range tests {
+				expected := before[other.label]
+				if other.label == tc.label {
+					expected++
+				}
+				assert.Equal(t, expected, getGossipMessagesReceived(other.label))
+			}
+		})
+	}
+
+	// Heartbeats are not counted.
+	before := 0.0
+	for _, tc := range tests {
+		before += getGossipMessagesReceived(tc.label)
+	}
+	CountGossipMessageReceived(&gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedHeartbeat{SignedHeartbeat: &gossipv1.SignedHeartbeat{}}})
+	after := 0.0
+	for _, tc := range tests {
+		after += getGossipMessagesReceived(tc.label)
+	}
+	assert.Equal(t, before, after)
+}
+
+type fakePeerLister []peer.ID
+
+func (f fakePeerLister) Peers() []peer.ID {
+	return f
+}
+
+func TestUpdatePeerCount(t *testing.T) {
+	getPeerCount := func() float64 {
+		m := &dto.Metric{}
+		require.NoError(t, p2pPeerCount.Write(m))
+		return m.Gauge.GetValue()
+	}
+
+	updatePeerCount(fakePeerLister{"a", "b", "c"})
+	assert.Equal(t, 3.0, getPeerCount())
+
+	updatePeerCount(fakePeerLister{})
+	assert.Equal(t, 0.0, getPeerCount())
+}
+
+func TestComponentsSetConnMgrWatermarks(t *testing.T) {
+	components := DefaultComponents()
+	info := components.ConnMgr.GetInfo()
+	assert.Equal(t, LowWaterMarkDefault, info.LowWater)
+	assert.Equal(t, HighWaterMarkDefault, info.HighWater)
+
+	require.NoError(t, components.SetConnMgrWatermarks(50, 200))
+	info = components.ConnMgr.GetInfo()
+	assert.Equal(t, 50, info.LowWater)
+	assert.Equal(t, 200, info.HighWater)
+
+	assert.ErrorContains(t, components.SetConnMgrWatermarks(-1, 200), "low watermark may not be negative")
+	assert.ErrorContains(t, components.SetConnMgrWatermarks(300, 200), "may not be less than the low watermark")
+
+	// A failed update leaves the existing connection manager in place.
+	info = components.ConnMgr.GetInfo()
+	assert.Equal(t, 50, info.LowWater)
+	assert.Equal(t, 200, info.HighWater)
+}
+
+func TestShouldFailWithoutBootstrapPeers(t *testing.T) {
+	tests := []struct {
+		label            string
+		successes        int
+		isBootstrapNode  bool
+		requireBootstrap bool
+		shouldFail       bool
+	}{
+		{label: "no peers, bootstrap required", successes: 0, isBootstrapNode: false, requireBootstrap: true, shouldFail: true},
+		{label: "no peers, bootstrap not required", successes: 0, isBootstrapNode: false, requireBootstrap: false, shouldFail: false},
+		{label: "no peers, bootstrap node", successes: 0, isBootstrapNode: true, requireBootstrap: true, shouldFail: false},
+		{label: "connected, bootstrap required", successes: 2, isBootstrapNode: false, requireBootstrap: true, shouldFail: false},
+		{label: "connected, bootstrap not required", successes: 1, isBootstrapNode: false, requireBootstrap: false, shouldFail: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			assert.Equal(t, tc.shouldFail, shouldFailWithoutBootstrapPeers(tc.successes, tc.isBootstrapNode, tc.requireBootstrap))
+		})
+	}
+
+	assert.True(t, DefaultComponents().RequireBootstrap)
+}
@@ -51,12 +51,12 @@ func (p *Processor) broadcastSignature(
 	hash := hex.EncodeToString(digest.Bytes())
 
 	if p.state.signatures[hash] == nil {
-		p.state.signatures[hash] = &state{
+		p.addState(hash, &state{
 			firstObserved: time.Now(),
 			nextRetry:     time.Now().Add(nextRetryDuration(0)),
 			signatures:    map[ethcommon.Address][]byte{},
 			source:        "loopback",
-		}
+		})
 	}
 
 	p.state.signatures[hash].ourObservation = o
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
@@ -53,6 +54,21 @@ var (
 			Name: "wormhole_aggregation_state_settled_signatures_total",
 			Help: "Total number of signatures produced by a validator, counted after waiting a fixed amount of time",
 		}, []string{"addr", "origin", "status"})
+	oldestPendingObservationAge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_oldest_pending_observation_age_seconds",
+			Help: "Age of the oldest observation that has not reached quorum yet, as of the last cleanup (zero if there is none)",
+		})
+	reobservationRequestsCoalesced = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_reobservation_requests_coalesced_total",
+			Help: "Total number of re-observation requests not sent because an identical one was sent in the same cleanup",
+		})
+	observationsEvicted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_observations_evicted_total",
+			Help: "Total number of aggregation states evicted because the maximum number of pending observations was exceeded",
+		})
 )
 
 const (
@@ -67,10 +83,107 @@ var (
 	FirstRetryMinWait = time.Minute * 5
 )
 
+// addState starts tracking the aggregation state of an observation. If that puts the number of tracked observations over
+// maxPendingObservations, the oldest ones that have not reached quorum are evicted. To avoid doing this on every new
+// observation during a flood, an extra tenth of the limit is evicted.
+func (p *Processor) addState(hash string, s *state) {
+	p.state.signatures[hash] = s
+
+	if p.maxPendingObservations <= 0 || len(p.state.signatures) <= p.maxPendingObservations {
+		return
+	}
+
+	numToEvict := len(p.state.signatures) - p.maxPendingObservations + p.maxPendingObservations/10
+	candidates := make([]string, 0, len(p.state.signatures))
+	for h, st := range p.state.signatures {
+		if h != hash && !st.submitted {
+			candidates = append(candidates, h)
+		}
+	}
+	if len(candidates) < numToEvict {
+		// Everything else has reached quorum. Those entries are only kept to account for late observations, so evict them too.
+		for h, st := range p.state.signatures {
+			if h != hash && st.submitted {
+				candidates = append(candidates, h)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		si, sj := p.state.signatures[candidates[i]], p.state.signatures[candidates[j]]
+		if si.submitted != sj.submitted {
+			return !si.submitted
+		}
+		return si.firstObserved.Before(sj.firstObserved)
+	})
+	if numToEvict > len(candidates) {
+		numToEvict = len(candidates)
+	}
+
+	for _, h := range candidates[:numToEvict] {
+		delete(p.state.signatures, h)
+	}
+	observationsEvicted.Add(float64(numToEvict))
+	p.logger.Warn("evicted observations because the maximum number of pending observations was exceeded",
+		zap.Int("evicted", numToEvict),
+		zap.Int("maxPendingObservations", p.maxPendingObservations),
+	)
+}
+
+// oldestPendingObservationAge returns how long ago the oldest observation that has not been submitted yet was first seen,
+// or zero if there is none.
+func (p *Processor) oldestPendingObservationAge() time.Duration {
+	var oldest time.Time
+	for _, s := range p.state.signatures {
+		if !s.submitted && (oldest.IsZero() || s.firstObserved.Before(oldest)) {
+			oldest = s.firstObserved
+		}
+	}
+	if oldest.IsZero() {
+		return 0
+	}
+	return time.Since(oldest)
+}
+
+// reobservationBatch collects the re-observation requests of a single cleanup. Observations made in the same transaction
+// share a single request, since a re-observation request covers the whole transaction. If maxSize is positive, no more
+// than that many requests are sent per cleanup.
+type reobservationBatch struct {
+	maxSize  int
+	requests []*gossipv1.ObservationRequest
+	queued   map[string]struct{}
+}
+
+func newReobservationBatch(maxSize int) *reobservationBatch {
+	return &reobservationBatch{maxSize: maxSize, queued: make(map[string]struct{})}
+}
+
+// add queues a request unless an identical one is already queued. It returns false if the request could not be queued
+// because the batch is full.
+func (b *reobservationBatch) add(req *gossipv1.ObservationRequest) bool {
+	key := fmt.Sprintf("%d/%s", req.ChainId, hex.EncodeToString(req.TxHash))
+	if _, exists := b.queued[key]; exists {
+		reobservationRequestsCoalesced.Inc()
+		return true
+	}
+	if b.maxSize > 0 && len(b.requests) >= b.maxSize {
+		return false
+	}
+	b.queued[key] = struct{}{}
+	b.requests = append(b.requests, req)
+	return true
+}
+
 // handleCleanup handles periodic retransmissions and cleanup of observations
 func (p *Processor) handleCleanup(ctx context.Context) {
 	p.logger.Info("aggregation state summary", zap.Int("cached", len(p.state.signatures)))
 	aggregationStateEntries.Set(float64(len(p.state.signatures)))
+	oldestPendingObservationAge.Set(p.oldestPendingObservationAge().Seconds())
+	if p.aggStats != nil {
+		p.aggStats.Set(p.aggregationStats())
+	}
+
+	reobservations := newReobservationBatch(p.reobservationBatchSize)
 
 	for hash, s := range p.state.signatures {
 		delta := time.Since(s.firstObserved)
@@ -102,14 +215,18 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			s.settled = true
 
 			// Use either the most recent (in case of a observation we haven't seen) or stored gs, if available.
+			// During a guardian set update, the observation may have reached quorum in the current set instead.
 			var gs *common.GuardianSet
 			if s.gs != nil {
 				gs = s.gs
 			} else {
 				gs = p.gs
 			}
+			if quorumGs := p.quorumGuardianSet(s.signatures, gs); quorumGs != nil {
+				gs = quorumGs
+			}
 
-			hasSigs := len(s.signatures)
+			hasSigs := countSignaturesInSet(s.signatures, gs)
 			wantSigs := vaa.CalculateQuorum(len(gs.Keys))
 			quorum := hasSigs >= wantSigs
 
@@ -178,18 +295,20 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 				if alreadyInDB {
 					p.logger.Debug("observation already in DB, not requesting reobservation", zap.String("digest", hash))
 				} else {
-					p.logger.Info("resubmitting observation",
-						zap.String("digest", hash),
-						zap.Duration("delta", delta),
-						zap.String("firstObserved", s.firstObserved.String()),
-					)
 					req := &gossipv1.ObservationRequest{
 						ChainId: uint32(s.ourObservation.GetEmitterChain()),
 						TxHash:  s.txHash,
 					}
-					if err := common.PostObservationRequest(p.obsvReqSendC, req); err != nil {
-						p.logger.Warn("failed to broadcast re-observation request", zap.Error(err))
+					if !reobservations.add(req) {
+						// Leave the retry state alone, so that it is retried on the next cleanup.
+						p.logger.Debug("re-observation batch is full, deferring resubmission", zap.String("digest", hash))
+						break
 					}
+					p.logger.Info("resubmitting observation",
+						zap.String("digest", hash),
+						zap.Duration("delta", delta),
+						zap.String("firstObserved", s.firstObserved.String()),
+					)
 					p.gossipSendC <- s.ourMsg
 					s.retryCtr++
 					s.nextRetry = time.Now().Add(nextRetryDuration(s.retryCtr))
@@ -215,6 +334,12 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 		}
 	}
 
+	for _, req := range reobservations.requests {
+		if err := common.PostObservationRequest(p.obsvReqSendC, req); err != nil {
+			p.logger.Warn("failed to broadcast re-observation request", zap.Error(err))
+		}
+	}
+
 	// Clean up old pythnet VAAs.
 	oldestTime := time.Now().Add(-time.Hour)
 	for key, pe := range p.pythnetVaas {
@@ -222,6 +347,8 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			delete(p.pythnetVaas, key)
 		}
 	}
+
+	p.persistAggregationState()
 }
 
 // signedVaaAlreadyInDB checks if the VAA is already in the DB. If it is, it makes sure the hash matches.
@@ -0,0 +1,180 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// processorWithPendingObservation returns a processor tracking one of our own observations that is overdue for a retry.
+func processorWithPendingObservation(t *testing.T, unreliable bool) (*Processor, string, chan *gossipv1.ObservationRequest) {
+	t.Helper()
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close() })
+
+	v := &VAA{
+		VAA: vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Timestamp:        time.Unix(1700000000, 0),
+			EmitterChain:     vaa.ChainIDSolana,
+			EmitterAddress:   vaa.Address{4},
+			Sequence:         1,
+			ConsistencyLevel: 32,
+		},
+		Unreliable: unreliable,
+	}
+	hash := hex.EncodeToString(v.SigningDigest().Bytes())
+
+	obsvReqSendC := make(chan *gossipv1.ObservationRequest, 10)
+	p := &Processor{
+		logger:       zap.NewNop(),
+		db:           database,
+		gs:           &common.GuardianSet{Keys: []ethcommon.Address{{1}}},
+		obsvReqSendC: obsvReqSendC,
+		gossipSendC:  make(chan []byte, 10),
+		state: &aggregationState{observationMap{
+			hash: &state{
+				firstObserved:  time.Now().Add(-2 * FirstRetryMinWait),
+				nextRetry:      time.Now().Add(-time.Second),
+				ourObservation: v,
+				ourMsg:         []byte{1},
+				txHash:         []byte{2},
+				signatures:     map[ethcommon.Address][]byte{},
+				settled:        true,
+			},
+		}},
+	}
+	return p, hash, obsvReqSendC
+}
+
+func TestHandleCleanup_UnreliableNeverReobserved(t *testing.T) {
+	p, hash, obsvReqSendC := processorWithPendingObservation(t, true)
+
+	p.handleCleanup(context.Background())
+
+	assert.Equal(t, 0, len(obsvReqSendC))
+	assert.NotContains(t, p.state.signatures, hash)
+}
+
+func TestHandleCleanup_ReliableReobserved(t *testing.T) {
+	p, hash, obsvReqSendC := processorWithPendingObservation(t, false)
+
+	p.handleCleanup(context.Background())
+
+	require.Equal(t, 1, len(obsvReqSendC))
+	req := <-obsvReqSendC
+	assert.Equal(t, uint32(vaa.ChainIDSolana), req.ChainId)
+	assert.Equal(t, []byte{2}, req.TxHash)
+	require.Contains(t, p.state.signatures, hash)
+	assert.Equal(t, uint(1), p.state.signatures[hash].retryCtr)
+}
+
+func TestHandleCleanup_OldestPendingObservationAge(t *testing.T) {
+	p := &Processor{
+		logger: zap.NewNop(),
+		gs:     &common.GuardianSet{Keys: []ethcommon.Address{{1}}},
+		state: &aggregationState{observationMap{
+			// Submitted observations do not count, no matter how old they are.
+			"submitted": {firstObserved: time.Now().Add(-50 * time.Minute), submitted: true, settled: true},
+			"old":       {firstObserved: time.Now().Add(-10 * time.Minute), nextRetry: time.Now().Add(time.Hour), settled: true},
+			"new":       {firstObserved: time.Now().Add(-time.Minute), nextRetry: time.Now().Add(time.Hour), settled: true},
+		}},
+	}
+
+	p.handleCleanup(context.Background())
+
+	m := &dto.Metric{}
+	require.NoError(t, oldestPendingObservationAge.Write(m))
+	assert.InDelta(t, (10 * time.Minute).Seconds(), m.Gauge.GetValue(), 5)
+
+	// Without pending observations, the gauge drops to zero.
+	p.state.signatures = observationMap{}
+	p.handleCleanup(context.Background())
+	m = &dto.Metric{}
+	require.NoError(t, oldestPendingObservationAge.Write(m))
+	assert.Equal(t, float64(0), m.Gauge.GetValue())
+}
+
+// addPendingObservation adds one of our own reliable observations, overdue for a retry, to the state of p.
+func addPendingObservation(p *Processor, sequence uint64, txHash []byte) string {
+	v := &VAA{
+		VAA: vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Timestamp:        time.Unix(1700000000, 0),
+			EmitterChain:     vaa.ChainIDSolana,
+			EmitterAddress:   vaa.Address{4},
+			Sequence:         sequence,
+			ConsistencyLevel: 32,
+		},
+	}
+	hash := hex.EncodeToString(v.SigningDigest().Bytes())
+	p.state.signatures[hash] = &state{
+		firstObserved:  time.Now().Add(-2 * FirstRetryMinWait),
+		nextRetry:      time.Now().Add(-time.Second),
+		ourObservation: v,
+		ourMsg:         []byte{1},
+		txHash:         txHash,
+		signatures:     map[ethcommon.Address][]byte{},
+		settled:        true,
+	}
+	return hash
+}
+
+func TestHandleCleanup_ReobservationRequestsCoalesced(t *testing.T) {
+	p, _, obsvReqSendC := processorWithPendingObservation(t, false)
+	// Same transaction as the observation created by processorWithPendingObservation.
+	addPendingObservation(p, 2, []byte{2})
+	addPendingObservation(p, 3, []byte{3})
+
+	p.handleCleanup(context.Background())
+
+	require.Equal(t, 2, len(obsvReqSendC))
+	txHashes := [][]byte{(<-obsvReqSendC).TxHash, (<-obsvReqSendC).TxHash}
+	assert.ElementsMatch(t, [][]byte{{2}, {3}}, txHashes)
+
+	// Every observation is still rebroadcast.
+	assert.Equal(t, 3, len(p.gossipSendC))
+	for _, s := range p.state.signatures {
+		assert.Equal(t, uint(1), s.retryCtr)
+	}
+}
+
+func TestHandleCleanup_ReobservationBatchSize(t *testing.T) {
+	p, _, obsvReqSendC := processorWithPendingObservation(t, false)
+	p.reobservationBatchSize = 1
+	addPendingObservation(p, 2, []byte{3})
+
+	p.handleCleanup(context.Background())
+
+	// Map iteration order is random, so either observation may make it into the batch.
+	require.Equal(t, 1, len(obsvReqSendC))
+	first := <-obsvReqSendC
+	assert.Equal(t, 1, len(p.gossipSendC))
+	var deferred string
+	for hash, s := range p.state.signatures {
+		if s.retryCtr == 0 {
+			deferred = hash
+		}
+	}
+	require.Equal(t, uint(0), p.state.signatures[deferred].retryCtr)
+
+	// The deferred observation is resubmitted on the next cleanup.
+	p.handleCleanup(context.Background())
+
+	require.Equal(t, 1, len(obsvReqSendC))
+	second := <-obsvReqSendC
+	assert.NotEqual(t, first.TxHash, second.TxHash)
+	assert.Equal(t, uint(1), p.state.signatures[deferred].retryCtr)
+}
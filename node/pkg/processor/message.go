@@ -32,6 +32,33 @@ var (
 			Help: "Total number of message observations that were successfully signed",
 		},
 		[]string{"emitter_chain"})
+
+	unreliableObservationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_unreliable_observations_total",
+			Help: "Total number of messages observed that are unreliable and can therefore not be reobserved",
+		},
+		[]string{"emitter_chain"})
+
+	oversizedMessagesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_oversized_messages_total",
+			Help: "Total number of messages dropped because their payload exceeds the maximum size",
+		},
+		[]string{"emitter_chain"})
+
+	guardianSignaturesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_guardian_signatures_total",
+			Help: "Total number of observations signed with our guardian key",
+		})
+
+	// guardianLastSignedTimestamp not advancing while messages are being observed indicates a wedged signer.
+	guardianLastSignedTimestamp = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_guardian_last_signed_timestamp",
+			Help: "Unix timestamp in seconds of the last observation signed with our guardian key",
+		})
 )
 
 // handleMessage processes a message received from a chain and instantiates our deterministic copy of the VAA. An
@@ -60,6 +87,13 @@ func (p *Processor) handleMessage(k *common.MessagePublication) {
 		"emitter_chain": k.EmitterChain.String(),
 	}).Add(1)
 
+	// Unreliable messages are signed like any other, but the cleanup expires them instead of requesting a reobservation.
+	if k.Unreliable {
+		unreliableObservationsTotal.With(prometheus.Labels{
+			"emitter_chain": k.EmitterChain.String(),
+		}).Add(1)
+	}
+
 	// All nodes will create the exact same VAA and sign its digest.
 	// Consensus is established on this digest.
 
@@ -83,11 +117,21 @@ func (p *Processor) handleMessage(k *common.MessagePublication) {
 	// Generate digest of the unsigned VAA.
 	digest := v.SigningDigest()
 
+	if p.observerMode {
+		p.logger.Debug("observer mode, not signing confirmed message publication",
+			zap.String("message_id", v.MessageID()),
+			zap.String("digest", hex.EncodeToString(digest.Bytes())),
+		)
+		return
+	}
+
 	// Sign the digest using our node's guardian key.
 	s, err := crypto.Sign(digest.Bytes(), p.gk)
 	if err != nil {
 		panic(err)
 	}
+	guardianSignaturesTotal.Inc()
+	guardianLastSignedTimestamp.SetToCurrentTime()
 
 	p.logger.Debug("observed and signed confirmed message publication",
 		zap.Stringer("source_chain", k.EmitterChain),
@@ -110,3 +154,19 @@ func (p *Processor) handleMessage(k *common.MessagePublication) {
 
 	p.broadcastSignature(v, s, k.TxHash.Bytes())
 }
+
+// payloadTooLarge logs, counts and returns true if the payload of k exceeds the configured maximum size.
+func (p *Processor) payloadTooLarge(k *common.MessagePublication) bool {
+	if p.maxPayloadSize <= 0 || len(k.Payload) <= p.maxPayloadSize {
+		return false
+	}
+
+	p.logger.Error("dropping message since its payload exceeds the maximum size",
+		zap.String("message_id", k.MessageIDString()),
+		zap.Stringer("txhash", k.TxHash),
+		zap.Int("payload_size", len(k.Payload)),
+		zap.Int("max_payload_size", p.maxPayloadSize),
+	)
+	oversizedMessagesTotal.WithLabelValues(k.EmitterChain.String()).Inc()
+	return true
+}
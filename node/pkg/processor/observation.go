@@ -43,6 +43,27 @@ var (
 			Name: "wormhole_observations_unknown_total",
 			Help: "Total number of verified observations we haven't seen ourselves",
 		})
+	signaturesPerObservation = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_signatures_per_observation",
+			Help:    "Number of signatures collected for an observation at the moment it reached quorum",
+			Buckets: prometheus.LinearBuckets(1, 1, 19),
+		})
+	gossipRejectedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_gossip_rejected_total",
+			Help: "Total number of observations and signed VAAs received from gossip that were dropped, grouped by reason",
+		}, []string{"reason"})
+)
+
+// Reasons used to label gossipRejectedTotal. Duplicates include signatures that arrive after quorum was reached and
+// signed VAAs that are already stored, which are normal since guardians keep gossiping after quorum.
+const (
+	gossipRejectedBadSignature    = "bad_signature"
+	gossipRejectedUnknownGuardian = "unknown_guardian"
+	gossipRejectedMalformed       = "malformed"
+	gossipRejectedDuplicate       = "duplicate"
+	gossipRejectedUnknownSet      = "unknown_guardian_set"
 )
 
 // signaturesToVaaFormat converts a map[common.Address][]byte (processor state format) to []*vaa.Signature (VAA format) given a set of keys gsKeys
@@ -72,6 +93,46 @@ func signaturesToVaaFormat(signatures map[common.Address][]byte, gsKeys []common
 	return sigs, agg
 }
 
+// signerGuardianSet returns the guardian set that addr is a member of for an observation aggregated against gs, or nil if
+// addr is not a member of any acceptable set. During a guardian set update, aggregation state that was created before the
+// update remains tied to the old set while other guardians are already signing with the new set, so members of the current
+// set are accepted as well.
+func (p *Processor) signerGuardianSet(gs *node_common.GuardianSet, addr common.Address) *node_common.GuardianSet {
+	if _, ok := gs.IndexOf(addr); ok {
+		return gs
+	}
+	if p.gs != nil && p.gs != gs {
+		if _, ok := p.gs.IndexOf(addr); ok {
+			return p.gs
+		}
+	}
+	return nil
+}
+
+// countSignaturesInSet returns the number of signatures that were made by members of gs.
+func countSignaturesInSet(signatures map[common.Address][]byte, gs *node_common.GuardianSet) int {
+	count := 0
+	for _, k := range gs.Keys {
+		if _, ok := signatures[k]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+// quorumGuardianSet returns the guardian set whose members have made a quorum of signatures, or nil if there is none.
+// During a guardian set update, an observation aggregated against gs can also reach quorum in the current set p.gs
+// first, so both are considered, preferring gs.
+func (p *Processor) quorumGuardianSet(signatures map[common.Address][]byte, gs *node_common.GuardianSet) *node_common.GuardianSet {
+	if countSignaturesInSet(signatures, gs) >= vaa.CalculateQuorum(len(gs.Keys)) {
+		return gs
+	}
+	if p.gs != nil && p.gs != gs && countSignaturesInSet(signatures, p.gs) >= vaa.CalculateQuorum(len(p.gs.Keys)) {
+		return p.gs
+	}
+	return nil
+}
+
 // handleObservation processes a remote VAA observation, verifies it, checks whether the VAA has met quorum,
 // and assembles and submits a valid VAA if possible.
 func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgWithTimeStamp[gossipv1.SignedObservation]) {
@@ -85,6 +146,7 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 	s := p.state.signatures[hash]
 	if s != nil && s.submitted {
 		// already submitted; ignoring additional signatures for it.
+		gossipRejectedTotal.WithLabelValues(gossipRejectedDuplicate).Inc()
 		return
 	}
 
@@ -111,6 +173,11 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 			zap.String("addr", hex.EncodeToString(m.Addr)),
 			zap.Error(err))
 		observationsFailedTotal.WithLabelValues("invalid_signature").Inc()
+		if len(m.Hash) != common.HashLength || len(m.Signature) != crypto.SignatureLength {
+			gossipRejectedTotal.WithLabelValues(gossipRejectedMalformed).Inc()
+		} else {
+			gossipRejectedTotal.WithLabelValues(gossipRejectedBadSignature).Inc()
+		}
 		return
 	}
 
@@ -125,6 +192,7 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 			zap.String("addr", hex.EncodeToString(m.Addr)),
 			zap.String("pk", signer_pk.Hex()))
 		observationsFailedTotal.WithLabelValues("pubkey_mismatch").Inc()
+		gossipRejectedTotal.WithLabelValues(gossipRejectedBadSignature).Inc()
 		return
 	}
 
@@ -162,10 +230,11 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 		return
 	}
 
-	// Verify that m.Addr is included in the guardian set. If it's not, drop the message. In case it's us
-	// who have the outdated guardian set, we'll just wait for the message to be retransmitted eventually.
-	_, ok := gs.KeyIndex(their_addr)
-	if !ok {
+	// Verify that m.Addr is included in the guardian set, or in the current one if we are in the middle of an update.
+	// If it's not, drop the message. In case it's us who have the outdated guardian set, we'll just wait for the message
+	// to be retransmitted eventually.
+	signerGs := p.signerGuardianSet(gs, their_addr)
+	if signerGs == nil {
 		p.logger.Debug("received observation by unknown guardian - is our guardian set outdated?",
 			zap.String("digest", hash),
 			zap.String("their_addr", their_addr.Hex()),
@@ -173,6 +242,7 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 			//zap.Any("keys", gs.KeysAsHexStrings()),
 		)
 		observationsFailedTotal.WithLabelValues("unknown_guardian").Inc()
+		gossipRejectedTotal.WithLabelValues(gossipRejectedUnknownGuardian).Inc()
 		return
 	}
 
@@ -202,22 +272,30 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 			source:        "unknown",
 		}
 
-		p.state.signatures[hash] = s
+		p.addState(hash, s)
 	}
 
 	s.signatures[their_addr] = m.Signature
 
+	if signerGs != gs {
+		// The signature is kept so that it is not lost, but it cannot contribute to quorum for the guardian set
+		// the observation is aggregated against.
+		p.logger.Debug("received observation from a guardian that is only in the current guardian set",
+			zap.String("digest", hash),
+			zap.String("their_addr", their_addr.Hex()),
+			zap.Uint32("observation_gs_index", gs.Index),
+			zap.Uint32("current_gs_index", signerGs.Index),
+		)
+	}
+
 	if s.ourObservation != nil {
 		// We have made this observation on chain!
 
-		quorum := vaa.CalculateQuorum(len(gs.Keys))
-
 		// Check if we have more signatures than required for quorum.
-		// s.signatures may contain signatures from multiple guardian sets during guardian set updates
-		// Hence, if len(s.signatures) < quorum, then there is definitely no quorum and we can return early to save additional computation,
-		// but if len(s.signatures) >= quorum, there is not necessarily quorum for the active guardian set.
-		// We will later check for quorum again after assembling the VAA for a particular guardian set.
-		if len(s.signatures) < quorum {
+		// s.signatures may contain signatures from multiple guardian sets during guardian set updates,
+		// so only the signatures made by members of a single guardian set are counted.
+		quorumGs := p.quorumGuardianSet(s.signatures, gs)
+		if quorumGs == nil {
 			// no quorum yet, we're done here
 			p.logger.Debug("quorum not yet met",
 				zap.String("digest", hash),
@@ -226,15 +304,16 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 			return
 		}
 
-		// Now we *may* have quorum, depending on the guardian set in use.
-		// Let's construct the VAA and check if we actually have quorum.
-		sigsVaaFormat, agg := signaturesToVaaFormat(s.signatures, gs.Keys)
+		// Now we have enough signatures from one guardian set to reach quorum.
+		// Let's construct the VAA for that guardian set.
+		quorum := vaa.CalculateQuorum(len(quorumGs.Keys))
+		sigsVaaFormat, agg := signaturesToVaaFormat(s.signatures, quorumGs.Keys)
 
 		if p.logger.Level().Enabled(zapcore.DebugLevel) {
 			p.logger.Debug("aggregation state for observation", // 1.3M out of 3M info messages / hour / guardian
 				zap.String("digest", hash),
-				zap.Any("set", gs.KeysAsHexStrings()),
-				zap.Uint32("index", gs.Index),
+				zap.Any("set", quorumGs.KeysAsHexStrings()),
+				zap.Uint32("index", quorumGs.Index),
 				zap.Bools("aggregation", agg),
 				zap.Int("required_sigs", quorum),
 				zap.Int("have_sigs", len(sigsVaaFormat)),
@@ -244,7 +323,8 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 
 		if len(sigsVaaFormat) >= quorum && !s.submitted {
 			// we have reached quorum *with the active guardian set*
-			s.ourObservation.HandleQuorum(sigsVaaFormat, hash, p)
+			signaturesPerObservation.Observe(float64(len(sigsVaaFormat)))
+			s.ourObservation.HandleQuorum(sigsVaaFormat, quorumGs.Index, hash, p)
 		} else {
 			p.logger.Debug("quorum not met or already submitted, doing nothing", // 1.2M out of 3M info messages / hour / guardian
 				zap.String("digest", hash))
@@ -258,11 +338,24 @@ func (p *Processor) handleObservation(ctx context.Context, obs *node_common.MsgW
 	observationTotalDelay.Observe(float64(time.Since(obs.Timestamp).Microseconds()))
 }
 
+// guardianSetForIndex returns the guardian set with the given index, so that VAAs signed by an older guardian set are
+// verified against that set. It returns nil if the set is unknown or expired.
+func (p *Processor) guardianSetForIndex(index uint32) *node_common.GuardianSet {
+	if p.gs.Index == index || p.gst == nil {
+		return p.gs
+	}
+	if gs, ok := p.gst.GetForIndex(index); ok {
+		return gs
+	}
+	return nil
+}
+
 func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gossipv1.SignedVAAWithQuorum) {
 	v, err := vaa.Unmarshal(m.Vaa)
 	if err != nil {
 		p.logger.Warn("received invalid VAA in SignedVAAWithQuorum message",
 			zap.Error(err), zap.Any("message", m))
+		gossipRejectedTotal.WithLabelValues(gossipRejectedMalformed).Inc()
 		return
 	}
 
@@ -273,6 +366,7 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 				zap.String("vaaID", string(db.VaaIDFromVAA(v).Bytes())),
 			)
 		}
+		gossipRejectedTotal.WithLabelValues(gossipRejectedDuplicate).Inc()
 		return
 	}
 
@@ -284,8 +378,19 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 		return
 	}
 
+	gs := p.guardianSetForIndex(v.GuardianSetIndex)
+	if gs == nil {
+		p.logger.Warn("dropping SignedVAAWithQuorum message since its guardian set is unknown or expired",
+			zap.String("digest", hex.EncodeToString(v.SigningDigest().Bytes())),
+			zap.Uint32("guardianSetIndex", v.GuardianSetIndex),
+			zap.Uint32("currentGuardianSetIndex", p.gs.Index),
+		)
+		gossipRejectedTotal.WithLabelValues(gossipRejectedUnknownSet).Inc()
+		return
+	}
+
 	// Check if guardianSet doesn't have any keys
-	if len(p.gs.Keys) == 0 {
+	if len(gs.Keys) == 0 {
 		p.logger.Warn("dropping SignedVAAWithQuorum message since we have a guardian set without keys",
 			zap.String("digest", hex.EncodeToString(v.SigningDigest().Bytes())),
 			zap.Any("message", m),
@@ -293,14 +398,16 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 		return
 	}
 
-	if err := v.Verify(p.gs.Keys); err != nil {
+	if err := v.Verify(gs.Keys); err != nil {
 		p.logger.Warn("dropping SignedVAAWithQuorum message because it failed verification: " + err.Error())
+		gossipRejectedTotal.WithLabelValues(gossipRejectedBadSignature).Inc()
 		return
 	}
 
 	// We now established that:
 	//  - all signatures on the VAA are valid
-	//  - the signature's addresses match the node's current guardian set
+	//  - the signature's addresses match the guardian set the VAA claims to be signed by (messages claiming an unknown or
+	//    expired guardian set were dropped above)
 	//  - enough signatures are present for the VAA to reach quorum
 
 	// Store signed VAA in database.
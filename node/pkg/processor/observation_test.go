@@ -4,14 +4,18 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
@@ -123,3 +127,374 @@ func TestHandleInboundSignedVAAWithQuorum(t *testing.T) {
 		})
 	}
 }
+
+// mockObservation is a minimal Observation that records quorum without storing or broadcasting anything.
+type mockObservation struct {
+	digest  ethcommon.Hash
+	sigs    []*vaa.Signature
+	gsIndex uint32
+}
+
+func (o *mockObservation) GetEmitterChain() vaa.ChainID { return vaa.ChainIDSolana }
+func (o *mockObservation) MessageID() string {
+	return "1/0000000000000000000000000000000000000000000000000000000000000004/1"
+}
+func (o *mockObservation) SigningDigest() ethcommon.Hash { return o.digest }
+func (o *mockObservation) IsReliable() bool              { return true }
+func (o *mockObservation) IsReobservation() bool         { return false }
+func (o *mockObservation) HandleQuorum(sigs []*vaa.Signature, gsIndex uint32, hash string, p *Processor) {
+	o.sigs = sigs
+	o.gsIndex = gsIndex
+	p.state.signatures[hash].submitted = true
+}
+
+func getSignaturesPerObservationBucketCount(t *testing.T, upperBound float64) uint64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, signaturesPerObservation.Write(m))
+	for _, b := range m.Histogram.GetBucket() {
+		if b.GetUpperBound() == upperBound {
+			return b.GetCumulativeCount()
+		}
+	}
+	t.Fatalf("no bucket with upper bound %f", upperBound)
+	return 0
+}
+
+func TestHandleObservation_SignaturesPerObservationMetric(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	addrs := make([]ethcommon.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	gs := &common.GuardianSet{Keys: addrs, Index: 0}
+
+	v := getVAA()
+	digest := v.SigningDigest()
+	hash := hex.EncodeToString(digest.Bytes())
+
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+	processor.gs = gs
+	processor.state = &aggregationState{observationMap{
+		hash: &state{
+			ourObservation: &mockObservation{digest: digest},
+			signatures:     map[ethcommon.Address][]byte{},
+			gs:             gs,
+		},
+	}}
+
+	// The quorum for four guardians is three, so the third signature should be recorded in the bucket for three.
+	before := getSignaturesPerObservationBucketCount(t, 3)
+	beforeTwo := getSignaturesPerObservationBucketCount(t, 2)
+
+	for i := 0; i < 3; i++ {
+		sig, err := crypto.Sign(digest.Bytes(), keys[i])
+		require.NoError(t, err)
+		processor.handleObservation(context.Background(), &common.MsgWithTimeStamp[gossipv1.SignedObservation]{
+			Msg: &gossipv1.SignedObservation{
+				Addr:      addrs[i].Bytes(),
+				Hash:      digest.Bytes(),
+				Signature: sig,
+				MessageId: v.MessageID(),
+			},
+			Timestamp: time.Now(),
+		})
+	}
+
+	assert.True(t, processor.state.signatures[hash].submitted)
+	assert.Equal(t, before+1, getSignaturesPerObservationBucketCount(t, 3))
+	assert.Equal(t, beforeTwo, getSignaturesPerObservationBucketCount(t, 2))
+}
+
+func getGossipRejectedCount(t *testing.T, reason string) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, gossipRejectedTotal.WithLabelValues(reason).Write(m))
+	return m.Counter.GetValue()
+}
+
+func TestHandleObservation_LateSignatureIsRejectedAsDuplicate(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	gs := &common.GuardianSet{Keys: []ethcommon.Address{addr}, Index: 0}
+
+	v := getVAA()
+	digest := v.SigningDigest()
+	hash := hex.EncodeToString(digest.Bytes())
+
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+	processor.gs = gs
+	processor.state = &aggregationState{observationMap{
+		hash: &state{
+			ourObservation: &mockObservation{digest: digest},
+			signatures:     map[ethcommon.Address][]byte{},
+			gs:             gs,
+			submitted:      true,
+		},
+	}}
+
+	before := getGossipRejectedCount(t, gossipRejectedDuplicate)
+
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	require.NoError(t, err)
+	processor.handleObservation(context.Background(), &common.MsgWithTimeStamp[gossipv1.SignedObservation]{
+		Msg: &gossipv1.SignedObservation{
+			Addr:      addr.Bytes(),
+			Hash:      digest.Bytes(),
+			Signature: sig,
+			MessageId: v.MessageID(),
+		},
+		Timestamp: time.Now(),
+	})
+
+	assert.Equal(t, before+1, getGossipRejectedCount(t, gossipRejectedDuplicate))
+}
+
+func TestHandleObservation_MalformedIsRejected(t *testing.T) {
+	v := getVAA()
+	digest := v.SigningDigest()
+
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+	processor.state = &aggregationState{observationMap{}}
+
+	malformedBefore := getGossipRejectedCount(t, gossipRejectedMalformed)
+	badSigBefore := getGossipRejectedCount(t, gossipRejectedBadSignature)
+
+	// A truncated signature cannot even be parsed.
+	processor.handleObservation(context.Background(), &common.MsgWithTimeStamp[gossipv1.SignedObservation]{
+		Msg: &gossipv1.SignedObservation{
+			Addr:      ethcommon.Address{}.Bytes(),
+			Hash:      digest.Bytes(),
+			Signature: []byte{1, 2, 3},
+			MessageId: v.MessageID(),
+		},
+		Timestamp: time.Now(),
+	})
+
+	assert.Equal(t, malformedBefore+1, getGossipRejectedCount(t, gossipRejectedMalformed))
+	assert.Equal(t, badSigBefore, getGossipRejectedCount(t, gossipRejectedBadSignature))
+	assert.Empty(t, processor.state.signatures)
+}
+
+func TestHandleInboundSignedVAAWithQuorum_MalformedIsRejected(t *testing.T) {
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+
+	before := getGossipRejectedCount(t, gossipRejectedMalformed)
+	processor.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: []byte{1, 2, 3}})
+	assert.Equal(t, before+1, getGossipRejectedCount(t, gossipRejectedMalformed))
+}
+
+func TestHandleObservation_GuardianSetRotation(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 8)
+	addrs := make([]ethcommon.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+
+	// Guardians 1-3 are in both sets, guardian 0 is only in the old set and guardians 4-6 are only in the new set.
+	// Guardian 7 is in neither set.
+	oldGs := &common.GuardianSet{Keys: addrs[0:4], Index: 0}
+	newGs := &common.GuardianSet{Keys: addrs[1:7], Index: 1}
+
+	v := getVAA()
+	digest := v.SigningDigest()
+	hash := hex.EncodeToString(digest.Bytes())
+	obs := &mockObservation{digest: digest}
+
+	// The observation was made before the update, so it is tied to the old set, but the processor already has the new one.
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+	processor.gs = newGs
+	processor.state = &aggregationState{observationMap{
+		hash: &state{
+			ourObservation: obs,
+			signatures:     map[ethcommon.Address][]byte{},
+			gs:             oldGs,
+		},
+	}}
+
+	sendObservation := func(idx int) {
+		sig, err := crypto.Sign(digest.Bytes(), keys[idx])
+		require.NoError(t, err)
+		processor.handleObservation(context.Background(), &common.MsgWithTimeStamp[gossipv1.SignedObservation]{
+			Msg: &gossipv1.SignedObservation{
+				Addr:      addrs[idx].Bytes(),
+				Hash:      digest.Bytes(),
+				Signature: sig,
+				MessageId: v.MessageID(),
+			},
+			Timestamp: time.Now(),
+		})
+	}
+
+	s := processor.state.signatures[hash]
+
+	// Signatures from members of the new set only are kept, but do not count toward quorum in the old set.
+	for _, idx := range []int{4, 5, 6, 1} {
+		sendObservation(idx)
+	}
+	assert.Len(t, s.signatures, 4)
+	assert.Equal(t, 1, countSignaturesInSet(s.signatures, oldGs))
+	assert.False(t, s.submitted)
+
+	// A signature from a guardian in neither set is dropped.
+	unknownBefore := getGossipRejectedCount(t, gossipRejectedUnknownGuardian)
+	sendObservation(7)
+	assert.Len(t, s.signatures, 4)
+	assert.Equal(t, unknownBefore+1, getGossipRejectedCount(t, gossipRejectedUnknownGuardian))
+
+	// The quorum for the old set of four guardians is three.
+	sendObservation(0)
+	assert.False(t, s.submitted)
+	sendObservation(2)
+	require.True(t, s.submitted)
+
+	// Only signatures from the old set made it into the VAA.
+	assert.Equal(t, oldGs.Index, obs.gsIndex)
+	require.Len(t, obs.sigs, 3)
+	for _, sig := range obs.sigs {
+		assert.Less(t, int(sig.Index), len(oldGs.Keys))
+		pk, err := crypto.Ecrecover(digest.Bytes(), sig.Signature[:])
+		require.NoError(t, err)
+		assert.Equal(t, oldGs.Keys[sig.Index], ethcommon.BytesToAddress(crypto.Keccak256(pk[1:])[12:]))
+	}
+}
+
+func TestHandleInboundSignedVAAWithQuorum_UsesGuardianSetMatchingIndex(t *testing.T) {
+	oldKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	newKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	oldGs := &common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(oldKey.PublicKey)}, Index: 1}
+	newGs := &common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(newKey.PublicKey)}, Index: 2}
+
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(oldGs)
+	gst.Set(newGs)
+
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+	processor.db = database
+	processor.gs = newGs
+	processor.gst = gst
+
+	// A VAA from guardian set 1 should be verified against set 1, even though the current set is 2.
+	v := getVAA()
+	require.Equal(t, uint32(1), v.GuardianSetIndex)
+	v.AddSignature(oldKey, 0)
+	marshalVAA, err := v.Marshal()
+	require.NoError(t, err)
+
+	processor.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: marshalVAA})
+	_, err = database.GetSignedVAABytes(*db.VaaIDFromVAA(&v))
+	require.NoError(t, err)
+
+	// A VAA claiming guardian set 1 but signed by guardian set 2 must be rejected.
+	v2 := getVAA()
+	v2.Sequence = 2
+	v2.AddSignature(newKey, 0)
+	marshalVAA, err = v2.Marshal()
+	require.NoError(t, err)
+
+	processor.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: marshalVAA})
+	_, err = database.GetSignedVAABytes(*db.VaaIDFromVAA(&v2))
+	assert.ErrorIs(t, err, db.ErrVAANotFound)
+}
+
+func TestHandleInboundSignedVAAWithQuorum_UnknownGuardianSetIsRefused(t *testing.T) {
+	oldKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	newGs := &common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(oldKey.PublicKey)}, Index: 2}
+
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	// Guardian set 1 is not known (or has expired), so the VAA must not be verified against the current set, even though
+	// it contains the same key.
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(newGs)
+
+	observedZapCore, observedLogs := observer.New(zap.InfoLevel)
+	processor := Processor{}
+	processor.logger = zap.New(observedZapCore)
+	processor.db = database
+	processor.gs = newGs
+	processor.gst = gst
+
+	v := getVAA()
+	require.Equal(t, uint32(1), v.GuardianSetIndex)
+	v.AddSignature(oldKey, 0)
+	marshalVAA, err := v.Marshal()
+	require.NoError(t, err)
+
+	processor.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: marshalVAA})
+	_, err = database.GetSignedVAABytes(*db.VaaIDFromVAA(&v))
+	assert.ErrorIs(t, err, db.ErrVAANotFound)
+	require.Equal(t, 1, observedLogs.Len())
+	assert.Equal(t, "dropping SignedVAAWithQuorum message since its guardian set is unknown or expired", observedLogs.All()[0].Message)
+}
+
+func TestHandleObservation_GuardianSetRotationQuorumInNewSetOnly(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 7)
+	addrs := make([]ethcommon.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+
+	// Guardians 1-3 are in both sets, guardian 0 is only in the old set and guardians 4-6 are only in the new set.
+	oldGs := &common.GuardianSet{Keys: addrs[0:4], Index: 0}
+	newGs := &common.GuardianSet{Keys: addrs[1:7], Index: 1}
+
+	v := getVAA()
+	digest := v.SigningDigest()
+	hash := hex.EncodeToString(digest.Bytes())
+	obs := &mockObservation{digest: digest}
+
+	processor := Processor{}
+	processor.logger = zap.NewNop()
+	processor.gs = newGs
+	processor.state = &aggregationState{observationMap{
+		hash: &state{
+			ourObservation: obs,
+			signatures:     map[ethcommon.Address][]byte{},
+			gs:             oldGs,
+		},
+	}}
+
+	s := processor.state.signatures[hash]
+
+	// The quorum for the new set of six guardians is five, while only two of these guardians are in the old set.
+	for _, idx := range []int{4, 5, 6, 1, 3} {
+		sig, err := crypto.Sign(digest.Bytes(), keys[idx])
+		require.NoError(t, err)
+		processor.handleObservation(context.Background(), &common.MsgWithTimeStamp[gossipv1.SignedObservation]{
+			Msg: &gossipv1.SignedObservation{
+				Addr:      addrs[idx].Bytes(),
+				Hash:      digest.Bytes(),
+				Signature: sig,
+				MessageId: v.MessageID(),
+			},
+			Timestamp: time.Now(),
+		})
+	}
+	assert.Equal(t, 2, countSignaturesInSet(s.signatures, oldGs))
+	require.True(t, s.submitted)
+
+	// The VAA is built for the new set.
+	assert.Equal(t, newGs.Index, obs.gsIndex)
+	require.Len(t, obs.sigs, 5)
+	for _, sig := range obs.sigs {
+		pk, err := crypto.Ecrecover(digest.Bytes(), sig.Signature[:])
+		require.NoError(t, err)
+		assert.Equal(t, newGs.Keys[sig.Index], ethcommon.BytesToAddress(crypto.Keccak256(pk[1:])[12:]))
+	}
+}
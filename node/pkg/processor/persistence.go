@@ -0,0 +1,73 @@
+package processor
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// persistAggregationState writes the signatures collected for observations that have not reached quorum yet to the database,
+// so that a restart does not lose quorum progress. Observations that were already submitted are not persisted.
+func (p *Processor) persistAggregationState() {
+	if !p.persistAggState || p.db == nil {
+		return
+	}
+
+	states := make([]*db.AggregationState, 0, len(p.state.signatures))
+	for hash, s := range p.state.signatures {
+		if s.submitted || len(s.signatures) == 0 {
+			continue
+		}
+		digest, err := hex.DecodeString(hash)
+		if err != nil || len(digest) != ethcommon.HashLength {
+			continue
+		}
+		states = append(states, &db.AggregationState{
+			Digest:        ethcommon.BytesToHash(digest),
+			FirstObserved: s.firstObserved,
+			Source:        s.source,
+			Signatures:    s.signatures,
+		})
+	}
+
+	if err := p.db.StoreAggregationState(states); err != nil {
+		p.logger.Error("failed to persist aggregation state", zap.Error(err))
+	}
+}
+
+// loadAggregationStateFromDB restores the signatures persisted by persistAggregationState. Entries that are older than the
+// settlement time are discarded, since most of their signatures would have been retransmitted already.
+func (p *Processor) loadAggregationStateFromDB() {
+	if !p.persistAggState || p.db == nil {
+		return
+	}
+
+	states, err := p.db.GetAggregationState()
+	if err != nil {
+		p.logger.Error("failed to load aggregation state from database", zap.Error(err))
+		return
+	}
+
+	restored := 0
+	for _, a := range states {
+		if time.Since(a.FirstObserved) > settlementTime {
+			continue
+		}
+		hash := hex.EncodeToString(a.Digest.Bytes())
+		if p.state.signatures[hash] != nil {
+			continue
+		}
+		p.addState(hash, &state{
+			firstObserved: a.FirstObserved,
+			nextRetry:     time.Now().Add(nextRetryDuration(0)),
+			signatures:    a.Signatures,
+			source:        a.Source,
+		})
+		restored++
+	}
+
+	p.logger.Info("restored aggregation state from database", zap.Int("restored", restored), zap.Int("discarded", len(states)-restored))
+}
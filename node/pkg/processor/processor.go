@@ -3,6 +3,7 @@ package processor
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"time"
 
@@ -28,6 +29,10 @@ import (
 var GovInterval = time.Minute
 var CleanupInterval = time.Second * 30
 
+// DefaultMaxPayloadSize is the default maximum size of a message publication payload. It is well above the payloads of any
+// known integration.
+const DefaultMaxPayloadSize = 1024 * 1024
+
 type (
 	// Observation defines the interface for any events observed by the guardian.
 	Observation interface {
@@ -43,8 +48,8 @@ type (
 		// IsReobservation returns whether this message is the result of a reobservation request.
 		IsReobservation() bool
 		// HandleQuorum finishes processing the observation once a quorum of signatures have
-		// been received for it.
-		HandleQuorum(sigs []*vaa.Signature, hash string, p *Processor)
+		// been received for it from the guardian set with index gsIndex.
+		HandleQuorum(sigs []*vaa.Signature, gsIndex uint32, hash string, p *Processor)
 	}
 
 	// state represents the local view of a given observation
@@ -122,30 +127,69 @@ type Processor struct {
 	state *aggregationState
 	// gk pk as eth address
 	ourAddr ethcommon.Address
+	// secondaryAddr is the address of the guardian key being rotated out, if any. We never sign with it. It is only used to
+	// warn if the guardian set still contains it rather than our primary key.
+	secondaryAddr *ethcommon.Address
 
 	governor       *governor.ChainGovernor
 	acct           *accountant.Accountant
 	acctReadC      <-chan *common.MessagePublication
 	pythnetVaas    map[string]PythNetVaaEntry
 	gatewayRelayer *gwrelayer.GatewayRelayer
+
+	// maxPendingObservations is the maximum number of observations tracked in state. Zero means unlimited.
+	maxPendingObservations int
+	// reobservationBatchSize is the maximum number of re-observation requests sent per cleanup. Zero means unlimited.
+	reobservationBatchSize int
+	// maxPayloadSize is the maximum size of a message publication payload, larger messages are dropped. Zero means unlimited.
+	maxPayloadSize int
+	// observerMode disables signing and broadcasting observations. Inbound VAAs are still verified and stored.
+	observerMode bool
+	// persistAggState enables persisting the aggregation state to the database on every cleanup and restoring it on startup.
+	persistAggState bool
+	// aggStats is updated with the size of the aggregation state on every cleanup. May be nil.
+	aggStats *AggregationStatsState
 }
 
+// observationDelayBuckets are the buckets of the observation latency histograms, in microseconds. They go up to five seconds
+// so that slow setups can be told apart from a stuck processor rather than all landing in the overflow bucket.
+var observationDelayBuckets = []float64{10.0, 20.0, 50.0, 100.0, 1000.0, 5000.0, 10000.0, 50000.0, 100000.0, 500000.0, 1000000.0, 5000000.0}
+
 var (
 	observationChanDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "wormhole_signed_observation_channel_delay_us",
 			Help:    "Latency histogram for delay of signed observations in channel",
-			Buckets: []float64{10.0, 20.0, 50.0, 100.0, 1000.0, 5000.0, 10000.0},
+			Buckets: observationDelayBuckets,
 		})
 
 	observationTotalDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "wormhole_signed_observation_total_delay_us",
 			Help:    "Latency histogram for total time to process signed observations",
-			Buckets: []float64{10.0, 20.0, 50.0, 100.0, 1000.0, 5000.0, 10000.0},
+			Buckets: observationDelayBuckets,
 		})
 )
 
+// Config holds the optional processor settings. The zero value disables all of them.
+type Config struct {
+	// MaxPendingObservations is the maximum number of observations tracked at a time. Zero means unlimited.
+	MaxPendingObservations int
+	// ReobservationBatchSize is the maximum number of re-observation requests sent per cleanup. Zero means unlimited.
+	ReobservationBatchSize int
+	// MaxPayloadSize is the maximum size of a message publication payload, larger messages are dropped. Zero means unlimited.
+	MaxPayloadSize int
+	// ObserverMode disables signing and broadcasting observations. Inbound VAAs are still verified and stored.
+	ObserverMode bool
+	// PersistAggState enables persisting the aggregation state to the database on every cleanup and restoring it on startup.
+	PersistAggState bool
+	// SecondaryGk is the guardian key being rotated out, if any. It is never used for signing, only to warn if the guardian
+	// set still contains it rather than the primary key.
+	SecondaryGk *ecdsa.PrivateKey
+	// AggStats is updated with the size of the aggregation state on every cleanup. May be nil.
+	AggStats *AggregationStatsState
+}
+
 func NewProcessor(
 	ctx context.Context,
 	db *db.Database,
@@ -161,9 +205,10 @@ func NewProcessor(
 	acct *accountant.Accountant,
 	acctReadC <-chan *common.MessagePublication,
 	gatewayRelayer *gwrelayer.GatewayRelayer,
+	cfg Config,
 ) *Processor {
 
-	return &Processor{
+	p := &Processor{
 		msgC:         msgC,
 		setC:         setC,
 		gossipSendC:  gossipSendC,
@@ -182,7 +227,70 @@ func NewProcessor(
 		acctReadC:      acctReadC,
 		pythnetVaas:    make(map[string]PythNetVaaEntry),
 		gatewayRelayer: gatewayRelayer,
+
+		maxPendingObservations: cfg.MaxPendingObservations,
+		reobservationBatchSize: cfg.ReobservationBatchSize,
+		maxPayloadSize:         cfg.MaxPayloadSize,
+		observerMode:           cfg.ObserverMode,
+		persistAggState:        cfg.PersistAggState,
+		aggStats:               cfg.AggStats,
+	}
+
+	if cfg.SecondaryGk != nil {
+		secondaryAddr := crypto.PubkeyToAddress(cfg.SecondaryGk.PublicKey)
+		p.secondaryAddr = &secondaryAddr
+	}
+
+	p.loadGuardianSetFromDB()
+	p.loadAggregationStateFromDB()
+
+	return p
+}
+
+// loadGuardianSetFromDB seeds the guardian set from the latest one persisted in the database, so that the processor
+// has a guardian set before the first update arrives on setC.
+func (p *Processor) loadGuardianSetFromDB() {
+	if p.db == nil {
+		return
+	}
+
+	gs, err := p.db.GetLatestGuardianSet()
+	if err != nil {
+		if !errors.Is(err, db.ErrGuardianSetNotFound) {
+			p.logger.Error("failed to load guardian set from database", zap.Error(err))
+		}
+		return
+	}
+
+	p.logger.Info("loaded guardian set from database",
+		zap.Strings("set", gs.KeysAsHexStrings()),
+		zap.Uint32("index", gs.Index))
+	p.gs = gs
+	p.gst.Set(gs)
+}
+
+// checkOurKeyInGuardianSet warns if the guardian set does not contain our primary key. During a key rotation, the new
+// primary key only becomes part of the guardian set once the rotation is complete, so our observations will not count until then.
+func (p *Processor) checkOurKeyInGuardianSet(gs *common.GuardianSet) {
+	if p.observerMode {
+		return
+	}
+
+	if _, ok := gs.KeyIndex(p.ourAddr); ok {
+		return
 	}
+
+	if p.secondaryAddr != nil {
+		if _, ok := gs.KeyIndex(*p.secondaryAddr); ok {
+			p.logger.Warn("guardian set contains our secondary key but not our primary key, our observations will not count until the key rotation is complete",
+				zap.Stringer("primary", p.ourAddr),
+				zap.Stringer("secondary", *p.secondaryAddr),
+				zap.Uint32("index", gs.Index))
+			return
+		}
+	}
+
+	p.logger.Warn("guardian set does not contain our guardian key", zap.Stringer("primary", p.ourAddr), zap.Uint32("index", gs.Index))
 }
 
 func (p *Processor) Run(ctx context.Context) error {
@@ -198,6 +306,8 @@ func (p *Processor) Run(ctx context.Context) error {
 				p.acct.Close()
 			}
 
+			p.persistAggregationState()
+
 			// Log these as warnings so they show up in the benchmark logs.
 			metric := &dto.Metric{}
 			_ = observationChanDelay.Write(metric)
@@ -213,7 +323,17 @@ func (p *Processor) Run(ctx context.Context) error {
 				zap.Strings("set", p.gs.KeysAsHexStrings()),
 				zap.Uint32("index", p.gs.Index))
 			p.gst.Set(p.gs)
+			p.checkOurKeyInGuardianSet(p.gs)
+			if p.db != nil {
+				if err := p.db.StoreGuardianSet(p.gs); err != nil {
+					p.logger.Error("failed to persist guardian set", zap.Uint32("index", p.gs.Index), zap.Error(err))
+				}
+			}
 		case k := <-p.msgC:
+			// Oversized messages are dropped before they reach the governor or the accountant.
+			if p.payloadTooLarge(k) {
+				continue
+			}
 			if p.governor != nil {
 				if !p.governor.ProcessMsg(k) {
 					continue
@@ -0,0 +1,487 @@
+package processor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLoadGuardianSetFromDB(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	gs := common.NewGuardianSet([]ethcommon.Address{ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")}, 3)
+	require.NoError(t, database.StoreGuardianSet(gs))
+
+	p := &Processor{
+		db:     database,
+		gst:    common.NewGuardianSetState(nil),
+		logger: zap.NewNop(),
+	}
+	p.loadGuardianSetFromDB()
+
+	require.NotNil(t, p.gs)
+	assert.Equal(t, gs, p.gs)
+	assert.Equal(t, gs, p.gst.Get())
+}
+
+func TestPersistAndRestoreAggregationState(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	sig := func(b byte) []byte {
+		s := make([]byte, 65)
+		s[0] = b
+		return s
+	}
+	addr1 := ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	addr2 := ethcommon.HexToAddress("0x88D7D8B32a9105d228100E72dFFe2Fae0705D31c")
+
+	pendingHash := ethcommon.HexToHash("0x01").Hex()[2:]
+	staleHash := ethcommon.HexToHash("0x02").Hex()[2:]
+	submittedHash := ethcommon.HexToHash("0x03").Hex()[2:]
+
+	p := &Processor{
+		db:              database,
+		logger:          zap.NewNop(),
+		persistAggState: true,
+		state: &aggregationState{observationMap{
+			pendingHash: {
+				firstObserved: time.Now().Add(-5 * time.Second),
+				signatures:    map[ethcommon.Address][]byte{addr1: sig(1), addr2: sig(2)},
+				source:        "solana",
+			},
+			staleHash: {
+				firstObserved: time.Now().Add(-2 * settlementTime),
+				signatures:    map[ethcommon.Address][]byte{addr1: sig(3)},
+				source:        "solana",
+			},
+			submittedHash: {
+				firstObserved: time.Now(),
+				signatures:    map[ethcommon.Address][]byte{addr1: sig(4)},
+				submitted:     true,
+			},
+		}},
+	}
+	p.persistAggregationState()
+
+	persisted, err := database.GetAggregationState()
+	require.NoError(t, err)
+	assert.Len(t, persisted, 2)
+
+	// Simulate a restart.
+	p2 := &Processor{
+		db:              database,
+		logger:          zap.NewNop(),
+		persistAggState: true,
+		state:           &aggregationState{observationMap{}},
+	}
+	p2.loadAggregationStateFromDB()
+
+	require.Len(t, p2.state.signatures, 1)
+	s, exists := p2.state.signatures[pendingHash]
+	require.True(t, exists)
+	assert.Len(t, s.signatures, 2)
+	assert.Equal(t, sig(1), s.signatures[addr1])
+	assert.Equal(t, sig(2), s.signatures[addr2])
+	assert.Equal(t, "solana", s.source)
+	assert.False(t, s.submitted)
+	assert.Nil(t, s.ourObservation)
+}
+
+func TestRestoreAggregationStateDisabled(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	require.NoError(t, database.StoreAggregationState([]*db.AggregationState{{
+		Digest:        ethcommon.HexToHash("0x01"),
+		FirstObserved: time.Now(),
+		Signatures:    map[ethcommon.Address][]byte{},
+	}}))
+
+	p := &Processor{
+		db:     database,
+		logger: zap.NewNop(),
+		state:  &aggregationState{observationMap{}},
+	}
+	p.loadAggregationStateFromDB()
+	assert.Empty(t, p.state.signatures)
+}
+
+func TestLoadGuardianSetFromEmptyDB(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	p := &Processor{
+		db:     database,
+		gst:    common.NewGuardianSetState(nil),
+		logger: zap.NewNop(),
+	}
+	p.loadGuardianSetFromDB()
+
+	assert.Nil(t, p.gs)
+	assert.Nil(t, p.gst.Get())
+}
+
+func TestAddStateEvictsOldest(t *testing.T) {
+	p := &Processor{
+		logger:                 zap.NewNop(),
+		state:                  &aggregationState{observationMap{}},
+		maxPendingObservations: 3,
+	}
+
+	var m = &dto.Metric{}
+	require.NoError(t, observationsEvicted.Write(m))
+	before := m.Counter.GetValue()
+
+	now := time.Now()
+	p.addState("submitted", &state{firstObserved: now.Add(-4 * time.Minute), submitted: true})
+	p.addState("oldest", &state{firstObserved: now.Add(-3 * time.Minute)})
+	p.addState("older", &state{firstObserved: now.Add(-2 * time.Minute)})
+	assert.Equal(t, 3, len(p.state.signatures))
+
+	// Exceeding the cap evicts the oldest entry that has not reached quorum.
+	p.addState("new", &state{firstObserved: now})
+	assert.Equal(t, 3, len(p.state.signatures))
+	assert.NotContains(t, p.state.signatures, "oldest")
+	assert.Contains(t, p.state.signatures, "submitted")
+	assert.Contains(t, p.state.signatures, "older")
+	assert.Contains(t, p.state.signatures, "new")
+
+	m = &dto.Metric{}
+	require.NoError(t, observationsEvicted.Write(m))
+	assert.Equal(t, before+1, m.Counter.GetValue())
+}
+
+func TestAddStateUnlimited(t *testing.T) {
+	p := &Processor{
+		logger: zap.NewNop(),
+		state:  &aggregationState{observationMap{}},
+	}
+
+	for _, hash := range []string{"a", "b", "c", "d"} {
+		p.addState(hash, &state{firstObserved: time.Now()})
+	}
+	assert.Equal(t, 4, len(p.state.signatures))
+}
+
+func TestObserverModeDoesNotSign(t *testing.T) {
+	p := &Processor{
+		gs:           &common.GuardianSet{Index: 1},
+		state:        &aggregationState{observationMap{}},
+		logger:       zap.NewNop(),
+		observerMode: true,
+	}
+
+	// Without a guardian key or gossip channel, signing or broadcasting would panic.
+	p.handleMessage(&common.MessagePublication{
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		Sequence:         1,
+		EmitterChain:     vaa.ChainIDSolana,
+		ConsistencyLevel: 32,
+		Payload:          []byte{97, 97, 97},
+	})
+
+	assert.Empty(t, p.state.signatures)
+}
+
+func TestObserverModeStoresInboundVAA(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	v := getVAA()
+	v.AddSignature(key, 0)
+	vaaBytes, err := v.Marshal()
+	require.NoError(t, err)
+
+	p := &Processor{
+		db: database,
+		gs: &common.GuardianSet{
+			Keys:  []ethcommon.Address{crypto.PubkeyToAddress(key.PublicKey)},
+			Index: 1,
+		},
+		logger:       zap.NewNop(),
+		observerMode: true,
+	}
+
+	p.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: vaaBytes})
+
+	stored, err := database.HasVAA(*db.VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.True(t, stored)
+}
+
+func TestSecondaryGuardianKeyDoesNotSign(t *testing.T) {
+	primary, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	secondary, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	primaryAddr := crypto.PubkeyToAddress(primary.PublicKey)
+	secondaryAddr := crypto.PubkeyToAddress(secondary.PublicKey)
+
+	p := &Processor{
+		gk:            primary,
+		ourAddr:       primaryAddr,
+		secondaryAddr: &secondaryAddr,
+		gs:            &common.GuardianSet{Keys: []ethcommon.Address{secondaryAddr}, Index: 1},
+		state:         &aggregationState{observationMap{}},
+		logger:        zap.NewNop(),
+		gossipSendC:   make(chan []byte, 1),
+		obsvC:         make(chan *common.MsgWithTimeStamp[gossipv1.SignedObservation], 1),
+	}
+
+	p.handleMessage(&common.MessagePublication{
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		Sequence:         1,
+		EmitterChain:     vaa.ChainIDSolana,
+		ConsistencyLevel: 32,
+		Payload:          []byte{97, 97, 97},
+	})
+
+	require.Equal(t, 1, len(p.obsvC))
+	obsv := (<-p.obsvC).Msg
+	assert.Equal(t, primaryAddr.Bytes(), obsv.Addr)
+
+	pubKey, err := crypto.Ecrecover(obsv.Hash, obsv.Signature)
+	require.NoError(t, err)
+	assert.Equal(t, primaryAddr, ethcommon.BytesToAddress(crypto.Keccak256(pubKey[1:])[12:]))
+}
+
+func TestCheckOurKeyInGuardianSet(t *testing.T) {
+	primary := ethcommon.HexToAddress("0x1111111111111111111111111111111111111111")
+	secondary := ethcommon.HexToAddress("0x2222222222222222222222222222222222222222")
+	other := ethcommon.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	tests := []struct {
+		label       string
+		keys        []ethcommon.Address
+		secondary   *ethcommon.Address
+		expectedMsg string
+	}{
+		{label: "primary in set", keys: []ethcommon.Address{other, primary}, secondary: &secondary},
+		{label: "only secondary in set", keys: []ethcommon.Address{other, secondary}, secondary: &secondary, expectedMsg: "guardian set contains our secondary key but not our primary key, our observations will not count until the key rotation is complete"},
+		{label: "no secondary key", keys: []ethcommon.Address{other, secondary}, expectedMsg: "guardian set does not contain our guardian key"},
+		{label: "neither in set", keys: []ethcommon.Address{other}, secondary: &secondary, expectedMsg: "guardian set does not contain our guardian key"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			observedCore, observedLogs := observer.New(zap.WarnLevel)
+			p := &Processor{
+				ourAddr:       primary,
+				secondaryAddr: tc.secondary,
+				logger:        zap.New(observedCore),
+			}
+			p.checkOurKeyInGuardianSet(&common.GuardianSet{Keys: tc.keys, Index: 1})
+
+			if tc.expectedMsg == "" {
+				assert.Equal(t, 0, observedLogs.Len())
+			} else {
+				require.Equal(t, 1, observedLogs.Len())
+				assert.Equal(t, tc.expectedMsg, observedLogs.All()[0].Message)
+			}
+		})
+	}
+}
+
+func TestSigningUpdatesGuardianSignatureMetrics(t *testing.T) {
+	gk, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	p := &Processor{
+		gk:          gk,
+		ourAddr:     crypto.PubkeyToAddress(gk.PublicKey),
+		gs:          &common.GuardianSet{Index: 1},
+		state:       &aggregationState{observationMap{}},
+		logger:      zap.NewNop(),
+		gossipSendC: make(chan []byte, 1),
+		obsvC:       make(chan *common.MsgWithTimeStamp[gossipv1.SignedObservation], 1),
+	}
+
+	m := &dto.Metric{}
+	require.NoError(t, guardianSignaturesTotal.Write(m))
+	signaturesBefore := m.Counter.GetValue()
+
+	before := time.Now()
+	p.handleMessage(&common.MessagePublication{
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		Sequence:         2,
+		EmitterChain:     vaa.ChainIDSolana,
+		ConsistencyLevel: 32,
+		Payload:          []byte{97, 97, 97},
+	})
+
+	m = &dto.Metric{}
+	require.NoError(t, guardianSignaturesTotal.Write(m))
+	assert.Equal(t, signaturesBefore+1, m.Counter.GetValue())
+
+	m = &dto.Metric{}
+	require.NoError(t, guardianLastSignedTimestamp.Write(m))
+	assert.GreaterOrEqual(t, m.Gauge.GetValue(), float64(before.UnixNano())/1e9)
+	assert.LessOrEqual(t, m.Gauge.GetValue(), float64(time.Now().UnixNano())/1e9)
+}
+
+func TestOversizedMessageIsDropped(t *testing.T) {
+	p := &Processor{
+		logger:         zap.NewNop(),
+		maxPayloadSize: 4,
+	}
+
+	getOversizedCount := func() float64 {
+		m := &dto.Metric{}
+		require.NoError(t, oversizedMessagesTotal.WithLabelValues(vaa.ChainIDSolana.String()).Write(m))
+		return m.Counter.GetValue()
+	}
+	before := getOversizedCount()
+
+	msg := &common.MessagePublication{
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		Sequence:         3,
+		EmitterChain:     vaa.ChainIDSolana,
+		ConsistencyLevel: 32,
+		Payload:          []byte{1, 2, 3, 4, 5},
+	}
+	assert.True(t, p.payloadTooLarge(msg))
+	assert.Equal(t, before+1, getOversizedCount())
+
+	// A payload at the limit is accepted.
+	msg.Payload = []byte{1, 2, 3, 4}
+	assert.False(t, p.payloadTooLarge(msg))
+	assert.Equal(t, before+1, getOversizedCount())
+
+	// Zero means unlimited.
+	p.maxPayloadSize = 0
+	msg.Payload = make([]byte, DefaultMaxPayloadSize+1)
+	assert.False(t, p.payloadTooLarge(msg))
+}
+
+func TestOversizedMessageDoesNotReachGovernor(t *testing.T) {
+	gk, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	msgC := make(chan *common.MessagePublication, 2)
+	gossipSendC := make(chan []byte, 2)
+	p := &Processor{
+		gk:             gk,
+		ourAddr:        crypto.PubkeyToAddress(gk.PublicKey),
+		gs:             &common.GuardianSet{Index: 1},
+		state:          &aggregationState{observationMap{}},
+		logger:         zap.NewNop(),
+		msgC:           msgC,
+		gossipSendC:    gossipSendC,
+		maxPayloadSize: 4,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errC := make(chan error, 1)
+	go func() { errC <- p.Run(ctx) }()
+
+	// With no governor or accountant, any message that gets past the size check is signed and broadcast right away.
+	msgC <- &common.MessagePublication{
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		Sequence:         3,
+		EmitterChain:     vaa.ChainIDSolana,
+		ConsistencyLevel: 32,
+		Payload:          []byte{1, 2, 3, 4, 5},
+	}
+	msgC <- &common.MessagePublication{
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		Sequence:         4,
+		EmitterChain:     vaa.ChainIDSolana,
+		ConsistencyLevel: 32,
+		Payload:          []byte{1, 2, 3, 4},
+	}
+	require.Eventually(t, func() bool { return len(gossipSendC) == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+	<-errC
+	assert.Equal(t, 1, len(gossipSendC))
+}
+
+func TestObservationDelayAboveTenMillisecondsHasItsOwnBucket(t *testing.T) {
+	bucketCounts := func() map[float64]uint64 {
+		m := &dto.Metric{}
+		require.NoError(t, observationTotalDelay.Write(m))
+		counts := map[float64]uint64{}
+		for _, b := range m.Histogram.GetBucket() {
+			counts[b.GetUpperBound()] = b.GetCumulativeCount()
+		}
+		return counts
+	}
+
+	before := bucketCounts()
+	observationTotalDelay.Observe(float64((30 * time.Millisecond).Microseconds()))
+	after := bucketCounts()
+
+	// Buckets are cumulative, so a 30ms observation is counted in every bucket from 50ms up, but not in the 10ms one.
+	assert.Equal(t, before[10000.0], after[10000.0])
+	assert.Equal(t, before[50000.0]+1, after[50000.0])
+	assert.Equal(t, before[5000000.0]+1, after[5000000.0])
+}
+
+func TestObservationChannelDelayAboveTenMillisecondsHasItsOwnBucket(t *testing.T) {
+	bucketCounts := func() map[float64]uint64 {
+		m := &dto.Metric{}
+		require.NoError(t, observationChanDelay.Write(m))
+		counts := map[float64]uint64{}
+		for _, b := range m.Histogram.GetBucket() {
+			counts[b.GetUpperBound()] = b.GetCumulativeCount()
+		}
+		return counts
+	}
+
+	obsvC := make(chan *common.MsgWithTimeStamp[gossipv1.SignedObservation], 1)
+	p := &Processor{
+		state:  &aggregationState{observationMap{}},
+		logger: zap.NewNop(),
+		obsvC:  obsvC,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errC := make(chan error, 1)
+	go func() { errC <- p.Run(ctx) }()
+
+	// The observation has been waiting in the channel for 30ms. It is malformed, so it is dropped once its delay is recorded.
+	before := bucketCounts()
+	obsvC <- &common.MsgWithTimeStamp[gossipv1.SignedObservation]{
+		Msg:       &gossipv1.SignedObservation{},
+		Timestamp: time.Now().Add(-30 * time.Millisecond),
+	}
+	require.Eventually(t, func() bool { return bucketCounts()[5000000.0] == before[5000000.0]+1 }, time.Second, 10*time.Millisecond)
+	cancel()
+	<-errC
+
+	// Allow for some scheduling delay on top of the 30ms, but it must not be counted in the 10ms bucket.
+	after := bucketCounts()
+	assert.Equal(t, before[10000.0], after[10000.0])
+	assert.Equal(t, before[100000.0]+1, after[100000.0])
+}
@@ -0,0 +1,69 @@
+package processor
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// estimatedStateOverhead is a rough size of a state entry, its map entry and digest key, excluding variable sized data.
+	estimatedStateOverhead = 512
+	// estimatedSignatureSize is a rough size of a signature map entry: the address, the signature and the map overhead.
+	estimatedSignatureSize = 20 + 65 + 32
+)
+
+// AggregationStats describes the size of the aggregation state, to help operators size their nodes.
+type AggregationStats struct {
+	// Observations is the number of observations in the aggregation state.
+	Observations int
+	// PendingObservations is the number of observations that have not reached quorum yet.
+	PendingObservations int
+	// Signatures is the total number of guardian signatures held across all observations.
+	Signatures int
+	// EstimatedMemoryBytes is a rough estimate of the memory used by the aggregation state.
+	EstimatedMemoryBytes uint64
+	// Timestamp is when the stats were computed.
+	Timestamp time.Time
+}
+
+// AggregationStatsState holds the latest AggregationStats. The aggregation state is owned by the processor, which
+// updates the stats on every cleanup, so they can be read concurrently by other components.
+type AggregationStatsState struct {
+	mu    sync.Mutex
+	stats AggregationStats
+}
+
+func NewAggregationStatsState() *AggregationStatsState {
+	return &AggregationStatsState{}
+}
+
+// Get returns the latest stats. The timestamp is zero if the processor has not computed them yet.
+func (s *AggregationStatsState) Get() AggregationStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// Set replaces the stats. It is called by the processor.
+func (s *AggregationStatsState) Set(stats AggregationStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats = stats
+}
+
+// aggregationStats computes the stats of the aggregation state. It must only be called from the processor routine.
+func (p *Processor) aggregationStats() AggregationStats {
+	stats := AggregationStats{
+		Observations: len(p.state.signatures),
+		Timestamp:    time.Now(),
+	}
+	for hash, s := range p.state.signatures {
+		if !s.submitted {
+			stats.PendingObservations++
+		}
+		stats.Signatures += len(s.signatures)
+		stats.EstimatedMemoryBytes += uint64(estimatedStateOverhead + len(hash) + len(s.ourMsg) + len(s.txHash) + len(s.source))
+		stats.EstimatedMemoryBytes += uint64(len(s.signatures) * estimatedSignatureSize)
+	}
+	return stats
+}
@@ -0,0 +1,37 @@
+package processor
+
+import (
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationStats(t *testing.T) {
+	sigs := func(n int) map[ethcommon.Address][]byte {
+		m := map[ethcommon.Address][]byte{}
+		for i := 0; i < n; i++ {
+			m[ethcommon.BytesToAddress([]byte{byte(i + 1)})] = make([]byte, 65)
+		}
+		return m
+	}
+
+	p := &Processor{state: &aggregationState{observationMap{
+		"hash1": {firstObserved: time.Now(), signatures: sigs(2)},
+		"hash2": {firstObserved: time.Now(), signatures: sigs(1), ourMsg: make([]byte, 100)},
+		"hash3": {firstObserved: time.Now(), signatures: sigs(3), submitted: true},
+	}}}
+
+	stats := p.aggregationStats()
+	assert.Equal(t, 3, stats.Observations)
+	assert.Equal(t, 2, stats.PendingObservations)
+	assert.Equal(t, 6, stats.Signatures)
+	assert.Equal(t, uint64(3*(estimatedStateOverhead+len("hash1"))+100+6*estimatedSignatureSize), stats.EstimatedMemoryBytes)
+
+	s := NewAggregationStatsState()
+	require.True(t, s.Get().Timestamp.IsZero())
+	s.Set(stats)
+	assert.Equal(t, stats, s.Get())
+}
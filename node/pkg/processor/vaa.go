@@ -11,11 +11,12 @@ type VAA struct {
 	Reobservation bool
 }
 
-func (v *VAA) HandleQuorum(sigs []*vaa.Signature, hash string, p *Processor) {
-	// Deep copy the observation and add signatures
+func (v *VAA) HandleQuorum(sigs []*vaa.Signature, gsIndex uint32, hash string, p *Processor) {
+	// Deep copy the observation and add signatures. The guardian set index is the one of the set that reached quorum,
+	// which may be newer than the one the observation was made with.
 	signed := &vaa.VAA{
 		Version:          v.Version,
-		GuardianSetIndex: v.GuardianSetIndex,
+		GuardianSetIndex: gsIndex,
 		Signatures:       sigs,
 		Timestamp:        v.Timestamp,
 		Nonce:            v.Nonce,
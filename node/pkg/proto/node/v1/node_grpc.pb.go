@@ -24,6 +24,9 @@ type NodePrivilegedServiceClient interface {
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
 	InjectGovernanceVAA(ctx context.Context, in *InjectGovernanceVAARequest, opts ...grpc.CallOption) (*InjectGovernanceVAAResponse, error)
+	// InjectSignedVAA verifies a fully signed VAA against the current guardian set, stores it and gossips it
+	// to the network.
+	InjectSignedVAA(ctx context.Context, in *InjectSignedVAARequest, opts ...grpc.CallOption) (*InjectSignedVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
 	// sequence numbers available in the local store, respectively.
@@ -42,8 +45,10 @@ type NodePrivilegedServiceClient interface {
 	ChainGovernorDropPendingVAA(ctx context.Context, in *ChainGovernorDropPendingVAARequest, opts ...grpc.CallOption) (*ChainGovernorDropPendingVAAResponse, error)
 	// ChainGovernorReleasePendingVAA release a VAA from the chain governor pending list, publishing it immediately.
 	ChainGovernorReleasePendingVAA(ctx context.Context, in *ChainGovernorReleasePendingVAARequest, opts ...grpc.CallOption) (*ChainGovernorReleasePendingVAAResponse, error)
-	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the configured maximum.
+	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the requested delay, bounded by the configured maximum.
 	ChainGovernorResetReleaseTimer(ctx context.Context, in *ChainGovernorResetReleaseTimerRequest, opts ...grpc.CallOption) (*ChainGovernorResetReleaseTimerResponse, error)
+	// ChainGovernorDumpConfig returns the chain and token configuration currently loaded by the chain governor.
+	ChainGovernorDumpConfig(ctx context.Context, in *ChainGovernorDumpConfigRequest, opts ...grpc.CallOption) (*ChainGovernorDumpConfigResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
@@ -52,6 +57,32 @@ type NodePrivilegedServiceClient interface {
 	DumpRPCs(ctx context.Context, in *DumpRPCsRequest, opts ...grpc.CallOption) (*DumpRPCsResponse, error)
 	// GetMissingVAAs returns the VAAs from a cloud function that need to be reobserved.
 	GetAndObserveMissingVAAs(ctx context.Context, in *GetAndObserveMissingVAAsRequest, opts ...grpc.CallOption) (*GetAndObserveMissingVAAsResponse, error)
+	// RotateNodeKey generates a new p2p node key, persists it to the configured node key path and
+	// restarts the p2p stack with the new identity.
+	RotateNodeKey(ctx context.Context, in *RotateNodeKeyRequest, opts ...grpc.CallOption) (*RotateNodeKeyResponse, error)
+	// GetNodeVersion returns build information about the running guardian.
+	GetNodeVersion(ctx context.Context, in *GetNodeVersionRequest, opts ...grpc.CallOption) (*GetNodeVersionResponse, error)
+	// DatabaseStats returns the number of VAAs stored in the local database for each emitter.
+	DatabaseStats(ctx context.Context, in *DatabaseStatsRequest, opts ...grpc.CallOption) (*DatabaseStatsResponse, error)
+	// ListSupportedGovernanceActions returns the governance message payloads InjectGovernanceVAA supports,
+	// with the module and action of the resulting governance VAAs.
+	ListSupportedGovernanceActions(ctx context.Context, in *ListSupportedGovernanceActionsRequest, opts ...grpc.CallOption) (*ListSupportedGovernanceActionsResponse, error)
+	// PlanRecovery reports the sequence gaps of an emitter in the local VAA store and an upper bound on how long
+	// backfilling them from the given nodes would take. It does not backfill anything.
+	PlanRecovery(ctx context.Context, in *PlanRecoveryRequest, opts ...grpc.CallOption) (*PlanRecoveryResponse, error)
+	// CheckGovernanceQuorum reports whether the local guardian's signature on an injected governance VAA
+	// can count toward quorum, i.e. whether it is in the current guardian set.
+	CheckGovernanceQuorum(ctx context.Context, in *CheckGovernanceQuorumRequest, opts ...grpc.CallOption) (*CheckGovernanceQuorumResponse, error)
+	// VerifyVAADigest loads a VAA from the local database, recomputes its signing digest and verifies its
+	// signatures against the guardian set it claims to be signed by.
+	VerifyVAADigest(ctx context.Context, in *VerifyVAADigestRequest, opts ...grpc.CallOption) (*VerifyVAADigestResponse, error)
+	// ListSupportedQueryTypes returns the cross chain query per chain request types this node handles.
+	ListSupportedQueryTypes(ctx context.Context, in *ListSupportedQueryTypesRequest, opts ...grpc.CallOption) (*ListSupportedQueryTypesResponse, error)
+	// GetProcessorStats returns the size of the processor's aggregation state and an estimate of its memory
+	// footprint, to help operators size their nodes. The stats are not read from the live state under its lock: they
+	// are a snapshot the processor publishes on every cleanup (every 30 seconds), so they may be that much out of date.
+	// The timestamp in the response says when the snapshot was taken.
+	GetProcessorStats(ctx context.Context, in *GetProcessorStatsRequest, opts ...grpc.CallOption) (*GetProcessorStatsResponse, error)
 }
 
 type nodePrivilegedServiceClient struct {
@@ -71,6 +102,15 @@ func (c *nodePrivilegedServiceClient) InjectGovernanceVAA(ctx context.Context, i
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) InjectSignedVAA(ctx context.Context, in *InjectSignedVAARequest, opts ...grpc.CallOption) (*InjectSignedVAAResponse, error) {
+	out := new(InjectSignedVAAResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/InjectSignedVAA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodePrivilegedServiceClient) FindMissingMessages(ctx context.Context, in *FindMissingMessagesRequest, opts ...grpc.CallOption) (*FindMissingMessagesResponse, error) {
 	out := new(FindMissingMessagesResponse)
 	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/FindMissingMessages", in, out, opts...)
@@ -134,6 +174,15 @@ func (c *nodePrivilegedServiceClient) ChainGovernorResetReleaseTimer(ctx context
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) ChainGovernorDumpConfig(ctx context.Context, in *ChainGovernorDumpConfigRequest, opts ...grpc.CallOption) (*ChainGovernorDumpConfigResponse, error) {
+	out := new(ChainGovernorDumpConfigResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ChainGovernorDumpConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodePrivilegedServiceClient) PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error) {
 	out := new(PurgePythNetVaasResponse)
 	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/PurgePythNetVaas", in, out, opts...)
@@ -170,6 +219,87 @@ func (c *nodePrivilegedServiceClient) GetAndObserveMissingVAAs(ctx context.Conte
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) RotateNodeKey(ctx context.Context, in *RotateNodeKeyRequest, opts ...grpc.CallOption) (*RotateNodeKeyResponse, error) {
+	out := new(RotateNodeKeyResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/RotateNodeKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetNodeVersion(ctx context.Context, in *GetNodeVersionRequest, opts ...grpc.CallOption) (*GetNodeVersionResponse, error) {
+	out := new(GetNodeVersionResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetNodeVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DatabaseStats(ctx context.Context, in *DatabaseStatsRequest, opts ...grpc.CallOption) (*DatabaseStatsResponse, error) {
+	out := new(DatabaseStatsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DatabaseStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ListSupportedGovernanceActions(ctx context.Context, in *ListSupportedGovernanceActionsRequest, opts ...grpc.CallOption) (*ListSupportedGovernanceActionsResponse, error) {
+	out := new(ListSupportedGovernanceActionsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ListSupportedGovernanceActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) PlanRecovery(ctx context.Context, in *PlanRecoveryRequest, opts ...grpc.CallOption) (*PlanRecoveryResponse, error) {
+	out := new(PlanRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/PlanRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) CheckGovernanceQuorum(ctx context.Context, in *CheckGovernanceQuorumRequest, opts ...grpc.CallOption) (*CheckGovernanceQuorumResponse, error) {
+	out := new(CheckGovernanceQuorumResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/CheckGovernanceQuorum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) VerifyVAADigest(ctx context.Context, in *VerifyVAADigestRequest, opts ...grpc.CallOption) (*VerifyVAADigestResponse, error) {
+	out := new(VerifyVAADigestResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/VerifyVAADigest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ListSupportedQueryTypes(ctx context.Context, in *ListSupportedQueryTypesRequest, opts ...grpc.CallOption) (*ListSupportedQueryTypesResponse, error) {
+	out := new(ListSupportedQueryTypesResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ListSupportedQueryTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetProcessorStats(ctx context.Context, in *GetProcessorStatsRequest, opts ...grpc.CallOption) (*GetProcessorStatsResponse, error) {
+	out := new(GetProcessorStatsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetProcessorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodePrivilegedServiceServer is the server API for NodePrivilegedService service.
 // All implementations must embed UnimplementedNodePrivilegedServiceServer
 // for forward compatibility
@@ -180,6 +310,9 @@ type NodePrivilegedServiceServer interface {
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
 	InjectGovernanceVAA(context.Context, *InjectGovernanceVAARequest) (*InjectGovernanceVAAResponse, error)
+	// InjectSignedVAA verifies a fully signed VAA against the current guardian set, stores it and gossips it
+	// to the network.
+	InjectSignedVAA(context.Context, *InjectSignedVAARequest) (*InjectSignedVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
 	// sequence numbers available in the local store, respectively.
@@ -198,8 +331,10 @@ type NodePrivilegedServiceServer interface {
 	ChainGovernorDropPendingVAA(context.Context, *ChainGovernorDropPendingVAARequest) (*ChainGovernorDropPendingVAAResponse, error)
 	// ChainGovernorReleasePendingVAA release a VAA from the chain governor pending list, publishing it immediately.
 	ChainGovernorReleasePendingVAA(context.Context, *ChainGovernorReleasePendingVAARequest) (*ChainGovernorReleasePendingVAAResponse, error)
-	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the configured maximum.
+	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the requested delay, bounded by the configured maximum.
 	ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error)
+	// ChainGovernorDumpConfig returns the chain and token configuration currently loaded by the chain governor.
+	ChainGovernorDumpConfig(context.Context, *ChainGovernorDumpConfigRequest) (*ChainGovernorDumpConfigResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
@@ -208,6 +343,32 @@ type NodePrivilegedServiceServer interface {
 	DumpRPCs(context.Context, *DumpRPCsRequest) (*DumpRPCsResponse, error)
 	// GetMissingVAAs returns the VAAs from a cloud function that need to be reobserved.
 	GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error)
+	// RotateNodeKey generates a new p2p node key, persists it to the configured node key path and
+	// restarts the p2p stack with the new identity.
+	RotateNodeKey(context.Context, *RotateNodeKeyRequest) (*RotateNodeKeyResponse, error)
+	// GetNodeVersion returns build information about the running guardian.
+	GetNodeVersion(context.Context, *GetNodeVersionRequest) (*GetNodeVersionResponse, error)
+	// DatabaseStats returns the number of VAAs stored in the local database for each emitter.
+	DatabaseStats(context.Context, *DatabaseStatsRequest) (*DatabaseStatsResponse, error)
+	// ListSupportedGovernanceActions returns the governance message payloads InjectGovernanceVAA supports,
+	// with the module and action of the resulting governance VAAs.
+	ListSupportedGovernanceActions(context.Context, *ListSupportedGovernanceActionsRequest) (*ListSupportedGovernanceActionsResponse, error)
+	// PlanRecovery reports the sequence gaps of an emitter in the local VAA store and an upper bound on how long
+	// backfilling them from the given nodes would take. It does not backfill anything.
+	PlanRecovery(context.Context, *PlanRecoveryRequest) (*PlanRecoveryResponse, error)
+	// CheckGovernanceQuorum reports whether the local guardian's signature on an injected governance VAA
+	// can count toward quorum, i.e. whether it is in the current guardian set.
+	CheckGovernanceQuorum(context.Context, *CheckGovernanceQuorumRequest) (*CheckGovernanceQuorumResponse, error)
+	// VerifyVAADigest loads a VAA from the local database, recomputes its signing digest and verifies its
+	// signatures against the guardian set it claims to be signed by.
+	VerifyVAADigest(context.Context, *VerifyVAADigestRequest) (*VerifyVAADigestResponse, error)
+	// ListSupportedQueryTypes returns the cross chain query per chain request types this node handles.
+	ListSupportedQueryTypes(context.Context, *ListSupportedQueryTypesRequest) (*ListSupportedQueryTypesResponse, error)
+	// GetProcessorStats returns the size of the processor's aggregation state and an estimate of its memory
+	// footprint, to help operators size their nodes. The stats are not read from the live state under its lock: they
+	// are a snapshot the processor publishes on every cleanup (every 30 seconds), so they may be that much out of date.
+	// The timestamp in the response says when the snapshot was taken.
+	GetProcessorStats(context.Context, *GetProcessorStatsRequest) (*GetProcessorStatsResponse, error)
 	mustEmbedUnimplementedNodePrivilegedServiceServer()
 }
 
@@ -218,6 +379,9 @@ type UnimplementedNodePrivilegedServiceServer struct {
 func (UnimplementedNodePrivilegedServiceServer) InjectGovernanceVAA(context.Context, *InjectGovernanceVAARequest) (*InjectGovernanceVAAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InjectGovernanceVAA not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) InjectSignedVAA(context.Context, *InjectSignedVAARequest) (*InjectSignedVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectSignedVAA not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) FindMissingMessages(context.Context, *FindMissingMessagesRequest) (*FindMissingMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMissingMessages not implemented")
 }
@@ -239,6 +403,9 @@ func (UnimplementedNodePrivilegedServiceServer) ChainGovernorReleasePendingVAA(c
 func (UnimplementedNodePrivilegedServiceServer) ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainGovernorResetReleaseTimer not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) ChainGovernorDumpConfig(context.Context, *ChainGovernorDumpConfigRequest) (*ChainGovernorDumpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainGovernorDumpConfig not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePythNetVaas not implemented")
 }
@@ -251,6 +418,33 @@ func (UnimplementedNodePrivilegedServiceServer) DumpRPCs(context.Context, *DumpR
 func (UnimplementedNodePrivilegedServiceServer) GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAndObserveMissingVAAs not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) RotateNodeKey(context.Context, *RotateNodeKeyRequest) (*RotateNodeKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateNodeKey not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetNodeVersion(context.Context, *GetNodeVersionRequest) (*GetNodeVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeVersion not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DatabaseStats(context.Context, *DatabaseStatsRequest) (*DatabaseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseStats not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ListSupportedGovernanceActions(context.Context, *ListSupportedGovernanceActionsRequest) (*ListSupportedGovernanceActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedGovernanceActions not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) PlanRecovery(context.Context, *PlanRecoveryRequest) (*PlanRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanRecovery not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) CheckGovernanceQuorum(context.Context, *CheckGovernanceQuorumRequest) (*CheckGovernanceQuorumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckGovernanceQuorum not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) VerifyVAADigest(context.Context, *VerifyVAADigestRequest) (*VerifyVAADigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyVAADigest not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ListSupportedQueryTypes(context.Context, *ListSupportedQueryTypesRequest) (*ListSupportedQueryTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedQueryTypes not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetProcessorStats(context.Context, *GetProcessorStatsRequest) (*GetProcessorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessorStats not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) mustEmbedUnimplementedNodePrivilegedServiceServer() {}
 
 // UnsafeNodePrivilegedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -282,6 +476,24 @@ func _NodePrivilegedService_InjectGovernanceVAA_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_InjectSignedVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InjectSignedVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).InjectSignedVAA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/InjectSignedVAA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).InjectSignedVAA(ctx, req.(*InjectSignedVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodePrivilegedService_FindMissingMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindMissingMessagesRequest)
 	if err := dec(in); err != nil {
@@ -408,6 +620,24 @@ func _NodePrivilegedService_ChainGovernorResetReleaseTimer_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_ChainGovernorDumpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainGovernorDumpConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ChainGovernorDumpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ChainGovernorDumpConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ChainGovernorDumpConfig(ctx, req.(*ChainGovernorDumpConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodePrivilegedService_PurgePythNetVaas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgePythNetVaasRequest)
 	if err := dec(in); err != nil {
@@ -480,6 +710,168 @@ func _NodePrivilegedService_GetAndObserveMissingVAAs_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_RotateNodeKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateNodeKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).RotateNodeKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/RotateNodeKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).RotateNodeKey(ctx, req.(*RotateNodeKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetNodeVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetNodeVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetNodeVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetNodeVersion(ctx, req.(*GetNodeVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DatabaseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DatabaseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DatabaseStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DatabaseStats(ctx, req.(*DatabaseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ListSupportedGovernanceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportedGovernanceActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ListSupportedGovernanceActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ListSupportedGovernanceActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ListSupportedGovernanceActions(ctx, req.(*ListSupportedGovernanceActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_PlanRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).PlanRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/PlanRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).PlanRecovery(ctx, req.(*PlanRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_CheckGovernanceQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckGovernanceQuorumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).CheckGovernanceQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/CheckGovernanceQuorum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).CheckGovernanceQuorum(ctx, req.(*CheckGovernanceQuorumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_VerifyVAADigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyVAADigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).VerifyVAADigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/VerifyVAADigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).VerifyVAADigest(ctx, req.(*VerifyVAADigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ListSupportedQueryTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportedQueryTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ListSupportedQueryTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ListSupportedQueryTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ListSupportedQueryTypes(ctx, req.(*ListSupportedQueryTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetProcessorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetProcessorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetProcessorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetProcessorStats(ctx, req.(*GetProcessorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodePrivilegedService_ServiceDesc is the grpc.ServiceDesc for NodePrivilegedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -491,6 +883,10 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InjectGovernanceVAA",
 			Handler:    _NodePrivilegedService_InjectGovernanceVAA_Handler,
 		},
+		{
+			MethodName: "InjectSignedVAA",
+			Handler:    _NodePrivilegedService_InjectSignedVAA_Handler,
+		},
 		{
 			MethodName: "FindMissingMessages",
 			Handler:    _NodePrivilegedService_FindMissingMessages_Handler,
@@ -519,6 +915,10 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChainGovernorResetReleaseTimer",
 			Handler:    _NodePrivilegedService_ChainGovernorResetReleaseTimer_Handler,
 		},
+		{
+			MethodName: "ChainGovernorDumpConfig",
+			Handler:    _NodePrivilegedService_ChainGovernorDumpConfig_Handler,
+		},
 		{
 			MethodName: "PurgePythNetVaas",
 			Handler:    _NodePrivilegedService_PurgePythNetVaas_Handler,
@@ -535,6 +935,42 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAndObserveMissingVAAs",
 			Handler:    _NodePrivilegedService_GetAndObserveMissingVAAs_Handler,
 		},
+		{
+			MethodName: "RotateNodeKey",
+			Handler:    _NodePrivilegedService_RotateNodeKey_Handler,
+		},
+		{
+			MethodName: "GetNodeVersion",
+			Handler:    _NodePrivilegedService_GetNodeVersion_Handler,
+		},
+		{
+			MethodName: "DatabaseStats",
+			Handler:    _NodePrivilegedService_DatabaseStats_Handler,
+		},
+		{
+			MethodName: "ListSupportedGovernanceActions",
+			Handler:    _NodePrivilegedService_ListSupportedGovernanceActions_Handler,
+		},
+		{
+			MethodName: "PlanRecovery",
+			Handler:    _NodePrivilegedService_PlanRecovery_Handler,
+		},
+		{
+			MethodName: "CheckGovernanceQuorum",
+			Handler:    _NodePrivilegedService_CheckGovernanceQuorum_Handler,
+		},
+		{
+			MethodName: "VerifyVAADigest",
+			Handler:    _NodePrivilegedService_VerifyVAADigest_Handler,
+		},
+		{
+			MethodName: "ListSupportedQueryTypes",
+			Handler:    _NodePrivilegedService_ListSupportedQueryTypes_Handler,
+		},
+		{
+			MethodName: "GetProcessorStats",
+			Handler:    _NodePrivilegedService_GetProcessorStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node/v1/node.proto",
@@ -28,6 +28,9 @@ type PublicRPCServiceClient interface {
 	GovernorGetEnqueuedVAAs(ctx context.Context, in *GovernorGetEnqueuedVAAsRequest, opts ...grpc.CallOption) (*GovernorGetEnqueuedVAAsResponse, error)
 	GovernorIsVAAEnqueued(ctx context.Context, in *GovernorIsVAAEnqueuedRequest, opts ...grpc.CallOption) (*GovernorIsVAAEnqueuedResponse, error)
 	GovernorGetTokenList(ctx context.Context, in *GovernorGetTokenListRequest, opts ...grpc.CallOption) (*GovernorGetTokenListResponse, error)
+	// ValidateQueryRequest checks that a cross chain query request is well formed without executing it,
+	// so that integrators can test their queries before submitting them.
+	ValidateQueryRequest(ctx context.Context, in *ValidateQueryRequestRequest, opts ...grpc.CallOption) (*ValidateQueryRequestResponse, error)
 }
 
 type publicRPCServiceClient struct {
@@ -101,6 +104,15 @@ func (c *publicRPCServiceClient) GovernorGetTokenList(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) ValidateQueryRequest(ctx context.Context, in *ValidateQueryRequestRequest, opts ...grpc.CallOption) (*ValidateQueryRequestResponse, error) {
+	out := new(ValidateQueryRequestResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/ValidateQueryRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PublicRPCServiceServer is the server API for PublicRPCService service.
 // All implementations must embed UnimplementedPublicRPCServiceServer
 // for forward compatibility
@@ -115,6 +127,9 @@ type PublicRPCServiceServer interface {
 	GovernorGetEnqueuedVAAs(context.Context, *GovernorGetEnqueuedVAAsRequest) (*GovernorGetEnqueuedVAAsResponse, error)
 	GovernorIsVAAEnqueued(context.Context, *GovernorIsVAAEnqueuedRequest) (*GovernorIsVAAEnqueuedResponse, error)
 	GovernorGetTokenList(context.Context, *GovernorGetTokenListRequest) (*GovernorGetTokenListResponse, error)
+	// ValidateQueryRequest checks that a cross chain query request is well formed without executing it,
+	// so that integrators can test their queries before submitting them.
+	ValidateQueryRequest(context.Context, *ValidateQueryRequestRequest) (*ValidateQueryRequestResponse, error)
 	mustEmbedUnimplementedPublicRPCServiceServer()
 }
 
@@ -143,6 +158,9 @@ func (UnimplementedPublicRPCServiceServer) GovernorIsVAAEnqueued(context.Context
 func (UnimplementedPublicRPCServiceServer) GovernorGetTokenList(context.Context, *GovernorGetTokenListRequest) (*GovernorGetTokenListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovernorGetTokenList not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) ValidateQueryRequest(context.Context, *ValidateQueryRequestRequest) (*ValidateQueryRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateQueryRequest not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) mustEmbedUnimplementedPublicRPCServiceServer() {}
 
 // UnsafePublicRPCServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -282,6 +300,24 @@ func _PublicRPCService_GovernorGetTokenList_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_ValidateQueryRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateQueryRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).ValidateQueryRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/ValidateQueryRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).ValidateQueryRequest(ctx, req.(*ValidateQueryRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PublicRPCService_ServiceDesc is the grpc.ServiceDesc for PublicRPCService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +353,10 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GovernorGetTokenList",
 			Handler:    _PublicRPCService_GovernorGetTokenList_Handler,
 		},
+		{
+			MethodName: "ValidateQueryRequest",
+			Handler:    _PublicRPCService_ValidateQueryRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "publicrpc/v1/publicrpc.proto",
@@ -3,12 +3,14 @@ package publicrpc
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -180,3 +182,24 @@ func (s *PublicrpcServer) GovernorGetTokenList(ctx context.Context, req *publicr
 
 	return resp, nil
 }
+
+// ValidateQueryRequest unmarshals and validates a query request without executing it. An invalid request is not an RPC error,
+// the reason is returned in the response.
+func (s *PublicrpcServer) ValidateQueryRequest(ctx context.Context, req *publicrpcv1.ValidateQueryRequestRequest) (*publicrpcv1.ValidateQueryRequestResponse, error) {
+	if len(req.QueryRequest) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no query request specified")
+	}
+
+	if _, err := query.CheckQueryRequest(req.QueryRequest); err != nil {
+		resp := &publicrpcv1.ValidateQueryRequestResponse{
+			Error:        publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_INVALID,
+			ErrorMessage: err.Error(),
+		}
+		if errors.Is(err, query.ErrMalformedQueryRequest) {
+			resp.Error = publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_MALFORMED
+		}
+		return resp, nil
+	}
+
+	return &publicrpcv1.ValidateQueryRequestResponse{Valid: true}, nil
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -81,3 +84,53 @@ func TestGovernorIsVAAEnqueuedNoMessage(t *testing.T) {
 		assert.Equal(t, expected_err, err)
 	})
 }
+
+func TestValidateQueryRequest(t *testing.T) {
+	queryRequest := query.NewQueryRequest(&query.PerChainQueryRequest{
+		ChainId: vaa.ChainIDSolana,
+		Query: &query.SolanaAccountQueryRequest{
+			Commitment: "finalized",
+			Accounts:   [][query.SolanaPublicKeyLength]byte{{1}},
+		},
+	})
+	validBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	badVersion := append([]byte{}, validBytes...)
+	badVersion[0] = 2
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expectedErr publicrpcv1.QueryRequestError
+		errContains string
+	}{
+		{"valid", validBytes, publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_UNSPECIFIED, ""},
+		{"bad version", badVersion, publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_MALFORMED, "unsupported message version: 2"},
+		{"truncated", validBytes[:len(validBytes)-1], publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_MALFORMED, "failed to Unmarshal per chain query"},
+		{"excess bytes", append(append([]byte{}, validBytes...), 0), publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_MALFORMED, "excess bytes in unmarshal"},
+		{"no per chain queries", []byte{query.MSG_VERSION, 0, 0, 0, 1, 0}, publicrpcv1.QueryRequestError_QUERY_REQUEST_ERROR_INVALID, "request does not contain any per chain queries"},
+	}
+
+	server := &PublicrpcServer{logger: zap.NewNop()}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := server.ValidateQueryRequest(context.Background(), &publicrpcv1.ValidateQueryRequestRequest{QueryRequest: tc.data})
+			require.NoError(t, err)
+			assert.Equal(t, tc.errContains == "", resp.Valid)
+			assert.Equal(t, tc.expectedErr, resp.Error)
+			if tc.errContains == "" {
+				assert.Empty(t, resp.ErrorMessage)
+			} else {
+				assert.Contains(t, resp.ErrorMessage, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestValidateQueryRequestEmpty(t *testing.T) {
+	server := &PublicrpcServer{logger: zap.NewNop()}
+	resp, err := server.ValidateQueryRequest(context.Background(), &publicrpcv1.ValidateQueryRequestRequest{})
+	assert.Nil(t, resp)
+	assert.Equal(t, status.Error(codes.InvalidArgument, "no query request specified"), err)
+}
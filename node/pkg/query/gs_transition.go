@@ -0,0 +1,49 @@
+package query
+
+import (
+	"errors"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+)
+
+// ErrGuardianSetTransition is reported for query requests whose responses were withheld around a guardian set change.
+var ErrGuardianSetTransition = errors.New("query responses are withheld around a guardian set change")
+
+// guardianSetTransition withholds query responses for requests received or answered around a guardian set change.
+// Responses signed right around an update may not be verifiable by clients that have not picked up the new set yet.
+//
+// The CCQ gossip protocol has no way to send an error such as Unavailable back to the requestor, so withheld requests
+// are simply not answered and time out on the client side, like any request that does not reach quorum. Within the
+// node, they are counted and reported as ErrGuardianSetTransition.
+type guardianSetTransition struct {
+	gst    *common.GuardianSetState
+	window time.Duration
+}
+
+// newGuardianSetTransition returns a transition tracker that withholds responses within window before and after a
+// guardian set change. It returns nil, which never withholds anything, if window is zero or gst is nil.
+func newGuardianSetTransition(gst *common.GuardianSetState, window time.Duration) *guardianSetTransition {
+	if gst == nil || window <= 0 {
+		return nil
+	}
+	return &guardianSetTransition{gst: gst, window: window}
+}
+
+// withholding returns true if the guardian set changed less than the transition window before or after t. The change
+// is timestamped by the guardian set state when the new set is set, so it does not depend on when queries arrive. The
+// initial guardian set is not considered a change.
+//
+// A change after t is only known once it happened, so a request received shortly before a change is caught when its
+// response is about to be published, by checking its receive time as well as the current time.
+func (t *guardianSetTransition) withholding(at time.Time) bool {
+	if t == nil {
+		return false
+	}
+
+	changedAt := t.gst.ChangedAt()
+	if changedAt.IsZero() {
+		return false
+	}
+	return at.After(changedAt.Add(-t.window)) && at.Before(changedAt.Add(t.window))
+}
@@ -60,6 +60,24 @@ var (
 			Help: "Total number of query requests that timed out",
 		})
 
+	queryResponseCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ccq_guardian_total_query_response_cache_hits",
+			Help: "Total number of query requests served from the response cache",
+		})
+
+	queryResponsesTooLarge = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ccq_guardian_total_query_responses_too_large",
+			Help: "Total number of query responses dropped because their result data exceeded the maximum size",
+		})
+
+	queryResponsesWithheld = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ccq_guardian_total_query_responses_withheld",
+			Help: "Total number of query responses not published because the guardian set changed around the time of the request",
+		})
+
 	TotalWatcherTime = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "ccq_guardian_total_watcher_query_time_in_ms",
@@ -43,6 +43,7 @@ func NewQueryHandler(
 	chainQueryReqC map[vaa.ChainID]chan *PerChainQueryInternal,
 	queryResponseReadC <-chan *PerChainQueryResponseInternal,
 	queryResponseWriteC chan<- *QueryResponsePublication,
+	config QueryHandlerConfig,
 ) *QueryHandler {
 	return &QueryHandler{
 		logger:               logger.With(zap.String("component", "ccq")),
@@ -52,6 +53,25 @@ func NewQueryHandler(
 		chainQueryReqC:       chainQueryReqC,
 		queryResponseReadC:   queryResponseReadC,
 		queryResponseWriteC:  queryResponseWriteC,
+		config:               config,
+	}
+}
+
+// QueryRequestError reports a query request that the handler gave up on without publishing a response.
+type QueryRequestError struct {
+	RequestID string
+	Err       error
+}
+
+// reportQueryRequestError posts a QueryRequestError to queryErrorC, if it is set. It does not block the handler.
+func reportQueryRequestError(qLogger *zap.Logger, queryErrorC chan<- *QueryRequestError, requestID string, err error) {
+	if queryErrorC == nil {
+		return
+	}
+	select {
+	case queryErrorC <- &QueryRequestError{RequestID: requestID, Err: err}:
+	default:
+		qLogger.Warn("query error channel is full, not reporting the error", zap.String("requestID", requestID), zap.Error(err))
 	}
 }
 
@@ -71,6 +91,36 @@ type (
 		queryResponseReadC   <-chan *PerChainQueryResponseInternal
 		queryResponseWriteC  chan<- *QueryResponsePublication
 		allowedRequestors    map[ethCommon.Address]struct{}
+		responseCache        *responseCache
+		config               QueryHandlerConfig
+	}
+
+	// QueryHandlerConfig holds the optional settings of the query handler. The zero value disables all of them.
+	QueryHandlerConfig struct {
+		// ResponseCacheTTL enables caching of query responses for that long when non-zero.
+		ResponseCacheTTL time.Duration
+
+		// RequestPrefix overrides the query signing prefix of the environment when set, for use in private networks.
+		RequestPrefix []byte
+
+		// MaxResponseDataSize limits the aggregate result data of a response, zero means unlimited.
+		MaxResponseDataSize int
+
+		// Gst and GsTransitionWindow configure withholding responses for a while before and after a guardian set change. A zero window disables it.
+		Gst                *common.GuardianSetState
+		GsTransitionWindow time.Duration
+
+		// QueryErrorC receives the requests that were dropped with an error, such as a response that is too large. It may be nil.
+		QueryErrorC chan<- *QueryRequestError
+	}
+
+	// handlerOptions holds the optional settings used by handleQueryRequestsImpl, so tests can supply their own.
+	handlerOptions struct {
+		respCache           *responseCache
+		requestPrefix       []byte
+		maxResponseDataSize int
+		gsTransition        *guardianSetTransition
+		queryErrorC         chan<- *QueryRequestError
 	}
 
 	// pendingQuery is the cache entry for a given query.
@@ -78,6 +128,7 @@ type (
 		signedRequest *gossipv1.SignedQueryRequest
 		request       *QueryRequest
 		requestID     string
+		cacheKey      string
 		receiveTime   time.Time
 		queries       []*perChainQuery
 		responses     []*PerChainQueryResponseInternal
@@ -152,6 +203,11 @@ func (qh *QueryHandler) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to parse allowed requesters: %w", err)
 	}
 
+	qh.responseCache, err = newResponseCache(ResponseCacheSize, qh.config.ResponseCacheTTL)
+	if err != nil {
+		return err
+	}
+
 	if err := supervisor.Run(ctx, "query_handler", common.WrapWithScissors(qh.handleQueryRequests, "query_handler")); err != nil {
 		return fmt.Errorf("failed to start query handler routine: %w", err)
 	}
@@ -161,7 +217,14 @@ func (qh *QueryHandler) Start(ctx context.Context) error {
 
 // handleQueryRequests multiplexes observation requests to the appropriate chain
 func (qh *QueryHandler) handleQueryRequests(ctx context.Context) error {
-	return handleQueryRequestsImpl(ctx, qh.logger, qh.signedQueryReqC, qh.chainQueryReqC, qh.allowedRequestors, qh.queryResponseReadC, qh.queryResponseWriteC, qh.env, RequestTimeout, RetryInterval, AuditInterval)
+	opts := handlerOptions{
+		respCache:           qh.responseCache,
+		requestPrefix:       qh.config.RequestPrefix,
+		maxResponseDataSize: qh.config.MaxResponseDataSize,
+		gsTransition:        newGuardianSetTransition(qh.config.Gst, qh.config.GsTransitionWindow),
+		queryErrorC:         qh.config.QueryErrorC,
+	}
+	return handleQueryRequestsImpl(ctx, qh.logger, qh.signedQueryReqC, qh.chainQueryReqC, qh.allowedRequestors, qh.queryResponseReadC, qh.queryResponseWriteC, qh.env, opts, RequestTimeout, RetryInterval, AuditInterval)
 }
 
 // handleQueryRequestsImpl allows instantiating the handler in the test environment with shorter timeout and retry parameters.
@@ -174,12 +237,17 @@ func handleQueryRequestsImpl(
 	queryResponseReadC <-chan *PerChainQueryResponseInternal,
 	queryResponseWriteC chan<- *QueryResponsePublication,
 	env common.Environment,
+	opts handlerOptions,
 	requestTimeoutImpl time.Duration,
 	retryIntervalImpl time.Duration,
 	auditIntervalImpl time.Duration,
 ) error {
 	qLogger := logger.With(zap.String("component", "ccqhandler"))
-	qLogger.Info("cross chain queries are enabled", zap.Any("allowedRequestors", allowedRequestors), zap.String("env", string(env)))
+	requestPrefix := opts.requestPrefix
+	if len(requestPrefix) == 0 {
+		requestPrefix = QueryRequestPrefix(env)
+	}
+	qLogger.Info("cross chain queries are enabled", zap.Any("allowedRequestors", allowedRequestors), zap.String("env", string(env)), zap.ByteString("requestPrefix", requestPrefix))
 
 	pendingQueries := make(map[string]*pendingQuery) // Key is requestID.
 
@@ -217,11 +285,19 @@ func handleQueryRequestsImpl(
 			// - valid "block" strings
 
 			allQueryRequestsReceived.Inc()
-			digest := QueryRequestDigest(env, signedRequest.QueryRequest)
+
+			digest := QueryRequestDigestWithPrefix(requestPrefix, signedRequest.QueryRequest)
 
 			// It's possible that the signature alone is not unique, and the digest alone is not unique, but the combination should be.
 			requestID := hex.EncodeToString(signedRequest.Signature) + ":" + digest.String()
 
+			if opts.gsTransition.withholding(time.Now()) {
+				qLogger.Info("not serving query request during guardian set transition", zap.String("requestID", requestID))
+				invalidQueryRequestReceived.WithLabelValues("guardian_set_transition").Inc()
+				reportQueryRequestError(qLogger, opts.queryErrorC, requestID, ErrGuardianSetTransition)
+				continue
+			}
+
 			qLogger.Info("received a query request", zap.String("requestID", requestID))
 
 			signerBytes, err := ethCrypto.Ecrecover(digest.Bytes(), signedRequest.Signature)
@@ -297,13 +373,47 @@ func handleQueryRequestsImpl(
 				continue
 			}
 
+			cacheKey, err := responseCacheKey(&queryRequest)
+			if err != nil {
+				qLogger.Error("failed to compute response cache key", zap.String("requestID", requestID), zap.Error(err))
+				invalidQueryRequestReceived.WithLabelValues("invalid_request").Inc()
+				continue
+			}
+
 			validQueryRequestsReceived.Inc()
 
+			// If we recently answered the same queries, publish the cached results rather than querying the watchers again.
+			if cachedResponses := opts.respCache.get(cacheKey, receiveTime); cachedResponses != nil {
+				queryResponseCacheHits.Inc()
+				respPub := &QueryResponsePublication{
+					Request:           signedRequest,
+					PerChainResponses: cachedResponses,
+				}
+
+				select {
+				case queryResponseWriteC <- respPub:
+					qLogger.Info("forwarded cached query response to p2p", zap.String("requestID", requestID))
+					queryResponsesPublished.Inc()
+				default:
+					qLogger.Warn("failed to publish cached query response to p2p, will retry publishing next interval", zap.String("requestID", requestID))
+					pendingQueries[requestID] = &pendingQuery{
+						signedRequest: signedRequest,
+						request:       &queryRequest,
+						requestID:     requestID,
+						cacheKey:      cacheKey,
+						receiveTime:   receiveTime,
+						respPub:       respPub,
+					}
+				}
+				continue
+			}
+
 			// Create the pending query and add it to the cache.
 			pq := &pendingQuery{
 				signedRequest: signedRequest,
 				request:       &queryRequest,
 				requestID:     requestID,
+				cacheKey:      cacheKey,
 				receiveTime:   receiveTime,
 				queries:       queries,
 				responses:     responses,
@@ -365,6 +475,27 @@ func handleQueryRequestsImpl(
 					PerChainResponses: responses,
 				}
 
+				// Refuse to sign and gossip oversized responses rather than straining the p2p network. There is no way to send an
+				// error back to the requestor over gossip, so it is reported as the error of the request instead.
+				if err := respPub.ValidateResultDataSize(opts.maxResponseDataSize); err != nil {
+					qLogger.Error("query response is too large, dropping the whole request", zap.String("requestID", resp.RequestID), zap.Error(err))
+					queryResponsesTooLarge.Inc()
+					reportQueryRequestError(qLogger, opts.queryErrorC, resp.RequestID, err)
+					delete(pendingQueries, resp.RequestID)
+					continue
+				}
+
+				// The guardian set may have changed while the request was pending.
+				if opts.gsTransition.withholding(pq.receiveTime) || opts.gsTransition.withholding(time.Now()) {
+					qLogger.Info("withholding query response during guardian set transition", zap.String("requestID", resp.RequestID))
+					queryResponsesWithheld.Inc()
+					reportQueryRequestError(qLogger, opts.queryErrorC, resp.RequestID, ErrGuardianSetTransition)
+					delete(pendingQueries, resp.RequestID)
+					continue
+				}
+
+				opts.respCache.add(pq.cacheKey, responses, time.Now())
+
 				// Send the response to be published.
 				select {
 				case queryResponseWriteC <- respPub:
@@ -401,7 +532,12 @@ func handleQueryRequestsImpl(
 					queryRequestsTimedOut.Inc()
 					delete(pendingQueries, reqId)
 				} else {
-					if pq.respPub != nil {
+					if pq.respPub != nil && opts.gsTransition.withholding(now) {
+						qLogger.Info("withholding query response during guardian set transition", zap.String("requestID", reqId))
+						queryResponsesWithheld.Inc()
+						reportQueryRequestError(qLogger, opts.queryErrorC, reqId, ErrGuardianSetTransition)
+						delete(pendingQueries, reqId)
+					} else if pq.respPub != nil {
 						// Resend the response to be published.
 						select {
 						case queryResponseWriteC <- pq.respPub:
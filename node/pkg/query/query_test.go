@@ -311,6 +311,8 @@ type mockData struct {
 	queryResponsePublicationReadC  <-chan *QueryResponsePublication
 	queryResponsePublicationWriteC chan<- *QueryResponsePublication
 
+	queryErrorC chan *QueryRequestError
+
 	mutex                    sync.Mutex
 	queryResponsePublication *QueryResponsePublication
 	expectedResults          []PerChainQueryResponse
@@ -408,6 +410,17 @@ func createQueryHandlerForTest(t *testing.T, ctx context.Context, logger *zap.Lo
 // createQueryHandlerForTestWithoutPublisher creates the query handler mock environment, including the set of watchers but not the response listener.
 // This function can be invoked directly to test retries of response publication (by delaying the start of the response listener).
 func createQueryHandlerForTestWithoutPublisher(t *testing.T, ctx context.Context, logger *zap.Logger, chains []vaa.ChainID) *mockData {
+	return createQueryHandlerForTestWithResponseCache(t, ctx, logger, chains, nil)
+}
+
+// createQueryHandlerForTestWithResponseCache creates the query handler mock environment, without the response listener, using the specified response cache.
+func createQueryHandlerForTestWithResponseCache(t *testing.T, ctx context.Context, logger *zap.Logger, chains []vaa.ChainID, respCache *responseCache) *mockData {
+	return createQueryHandlerForTestImpl(t, ctx, logger, chains, respCache, nil, 0)
+}
+
+// createQueryHandlerForTestImpl creates the query handler mock environment, without the response listener, using the specified response cache
+// and guardian set transition tracker, either of which may be nil, and the specified maximum response data size.
+func createQueryHandlerForTestImpl(t *testing.T, ctx context.Context, logger *zap.Logger, chains []vaa.ChainID, respCache *responseCache, gsTransition *guardianSetTransition, maxResponseDataSize int) *mockData {
 	md := mockData{}
 	var err error
 
@@ -433,11 +446,15 @@ func createQueryHandlerForTestWithoutPublisher(t *testing.T, ctx context.Context
 	// Query responses from query handler to p2p
 	md.queryResponsePublicationReadC, md.queryResponsePublicationWriteC = makeChannelPair[*QueryResponsePublication](0)
 
+	// Requests dropped by the query handler with an error
+	md.queryErrorC = make(chan *QueryRequestError, 1)
+
 	md.resetState()
 
 	go func() {
+		opts := handlerOptions{respCache: respCache, maxResponseDataSize: maxResponseDataSize, gsTransition: gsTransition, queryErrorC: md.queryErrorC}
 		err := handleQueryRequestsImpl(ctx, logger, md.signedQueryReqReadC, md.chainQueryReqC, ccqAllowedRequestersList,
-			md.queryResponseReadC, md.queryResponsePublicationWriteC, common.GoTest, requestTimeoutForTest, retryIntervalForTest, auditIntervalForTest)
+			md.queryResponseReadC, md.queryResponsePublicationWriteC, common.GoTest, opts, requestTimeoutForTest, retryIntervalForTest, auditIntervalForTest)
 		assert.NoError(t, err)
 	}()
 
@@ -779,6 +796,41 @@ func TestFatalErrorOnPerChainQueryShouldCauseRequestToFail(t *testing.T) {
 	assert.Equal(t, 1, md.getRequestsPerChain(vaa.ChainIDBSC))
 }
 
+func TestOversizedResponseShouldCauseRequestToFail(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	perChainQueries := []*PerChainQueryRequest{createPerChainQueryForEthCall(t, vaa.ChainIDPolygon, "0x28d9630", 2)}
+
+	// Allow one byte less than the aggregate result data of the response.
+	expectedResults := createExpectedResultsForTest(t, perChainQueries)
+	size := (&QueryResponsePublication{PerChainResponses: []*PerChainQueryResponse{&expectedResults[0]}}).ResultDataSize()
+	require.NotZero(t, size)
+
+	md := createQueryHandlerForTestImpl(t, ctx, logger, watcherChainsForTest, nil, nil, size-1)
+	md.startResponseListener(ctx)
+
+	signedQueryRequest, _ := createSignedQueryRequestForTesting(t, md.sk, perChainQueries)
+	md.setExpectedResults(expectedResults)
+
+	// Submit the query request to the handler.
+	md.signedQueryReqWriteC <- signedQueryRequest
+
+	// The response is not published, and the request fails with a truncation error instead.
+	select {
+	case queryErr := <-md.queryErrorC:
+		assert.True(t, strings.HasPrefix(queryErr.RequestID, hex.EncodeToString(signedQueryRequest.Signature)))
+		var truncatedErr *ResponseTruncatedError
+		require.ErrorAs(t, queryErr.Err, &truncatedErr)
+		assert.Equal(t, ResponseTruncatedError{Size: size, MaxSize: size - 1}, *truncatedErr)
+	case <-time.After(time.Second):
+		require.Fail(t, "timed out waiting for the query error")
+	}
+
+	require.Nil(t, md.waitForResponse())
+	assert.Equal(t, 1, md.getRequestsPerChain(vaa.ChainIDPolygon))
+}
+
 func TestPublishRetrySucceeds(t *testing.T) {
 	ctx := context.Background()
 	logger := zap.NewNop()
@@ -811,6 +863,195 @@ func TestPublishRetrySucceeds(t *testing.T) {
 	assert.True(t, validateResponseForTest(t, queryResponsePublication, signedQueryRequest, queryRequest, expectedResults))
 }
 
+func TestCachedResponseSkipsWatcher(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	respCache, err := newResponseCache(ResponseCacheSize, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, respCache)
+
+	md := createQueryHandlerForTestWithResponseCache(t, ctx, logger, watcherChainsForTest, respCache)
+	md.startResponseListener(ctx)
+
+	perChainQueries := []*PerChainQueryRequest{createPerChainQueryForEthCall(t, vaa.ChainIDPolygon, "0x28d9630", 2)}
+	signedQueryRequest, queryRequest := createSignedQueryRequestForTesting(t, md.sk, perChainQueries)
+	expectedResults := createExpectedResultsForTest(t, queryRequest.PerChainQueries)
+	md.setExpectedResults(expectedResults)
+
+	// The first request should go to the watcher.
+	md.signedQueryReqWriteC <- signedQueryRequest
+	queryResponsePublication := md.waitForResponse()
+	require.NotNil(t, queryResponsePublication)
+	assert.Equal(t, 1, md.getRequestsPerChain(vaa.ChainIDPolygon))
+
+	// An identical request should be answered from the cache without invoking the watcher.
+	md.resetState()
+	md.signedQueryReqWriteC <- signedQueryRequest
+	queryResponsePublication = md.waitForResponse()
+	require.NotNil(t, queryResponsePublication)
+	assert.Equal(t, 0, md.getRequestsPerChain(vaa.ChainIDPolygon))
+	assert.True(t, validateResponseForTest(t, queryResponsePublication, signedQueryRequest, queryRequest, expectedResults))
+
+	// The same queries with a new nonce should also be answered from the cache, in a response for the new request.
+	md.resetState()
+	signedQueryRequest, queryRequest = createSignedQueryRequestForTesting(t, md.sk, perChainQueries)
+	md.signedQueryReqWriteC <- signedQueryRequest
+	queryResponsePublication = md.waitForResponse()
+	require.NotNil(t, queryResponsePublication)
+	assert.Equal(t, 0, md.getRequestsPerChain(vaa.ChainIDPolygon))
+	assert.True(t, validateResponseForTest(t, queryResponsePublication, signedQueryRequest, queryRequest, expectedResults))
+
+	// Different queries should go to the watcher.
+	md.resetState()
+	perChainQueries = []*PerChainQueryRequest{createPerChainQueryForEthCall(t, vaa.ChainIDPolygon, "0x28d9631", 2)}
+	signedQueryRequest, queryRequest = createSignedQueryRequestForTesting(t, md.sk, perChainQueries)
+	expectedResults = createExpectedResultsForTest(t, queryRequest.PerChainQueries)
+	md.setExpectedResults(expectedResults)
+	md.signedQueryReqWriteC <- signedQueryRequest
+	queryResponsePublication = md.waitForResponse()
+	require.NotNil(t, queryResponsePublication)
+	assert.Equal(t, 1, md.getRequestsPerChain(vaa.ChainIDPolygon))
+	assert.True(t, validateResponseForTest(t, queryResponsePublication, signedQueryRequest, queryRequest, expectedResults))
+}
+
+func TestResponseCacheExpires(t *testing.T) {
+	respCache, err := newResponseCache(ResponseCacheSize, time.Minute)
+	require.NoError(t, err)
+
+	responses := []*PerChainQueryResponse{{ChainId: vaa.ChainIDPolygon}}
+	now := time.Now()
+	respCache.add("digest", responses, now)
+
+	assert.Equal(t, responses, respCache.get("digest", now.Add(59*time.Second)))
+	assert.Nil(t, respCache.get("digest", now.Add(time.Minute)))
+	assert.Nil(t, respCache.get("digest", now))
+}
+
+func TestResponseCacheDisabled(t *testing.T) {
+	respCache, err := newResponseCache(ResponseCacheSize, 0)
+	require.NoError(t, err)
+	require.Nil(t, respCache)
+
+	// A nil cache is a no-op.
+	respCache.add("digest", []*PerChainQueryResponse{{ChainId: vaa.ChainIDPolygon}}, time.Now())
+	assert.Nil(t, respCache.get("digest", time.Now()))
+}
+
+func TestQueriesWithheldAroundGuardianSetTransition(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(&common.GuardianSet{Index: 1})
+	gsTransition := newGuardianSetTransition(gst, 500*time.Millisecond)
+	require.NotNil(t, gsTransition)
+
+	md := createQueryHandlerForTestImpl(t, ctx, logger, watcherChainsForTest, nil, gsTransition, 0)
+	md.startResponseListener(ctx)
+
+	perChainQueries := []*PerChainQueryRequest{createPerChainQueryForEthCall(t, vaa.ChainIDPolygon, "0x28d9630", 2)}
+	signedQueryRequest, queryRequest := createSignedQueryRequestForTesting(t, md.sk, perChainQueries)
+	expectedResults := createExpectedResultsForTest(t, queryRequest.PerChainQueries)
+	md.setExpectedResults(expectedResults)
+
+	requireQueryError := func(expected error) {
+		t.Helper()
+		select {
+		case queryErr := <-md.queryErrorC:
+			assert.True(t, strings.HasPrefix(queryErr.RequestID, hex.EncodeToString(signedQueryRequest.Signature)))
+			assert.ErrorIs(t, queryErr.Err, expected)
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for the query error")
+		}
+	}
+
+	// Queries are served while the guardian set is stable.
+	md.signedQueryReqWriteC <- signedQueryRequest
+	require.NotNil(t, md.waitForResponse())
+	assert.Equal(t, 1, md.getRequestsPerChain(vaa.ChainIDPolygon))
+
+	// A request received before the guardian set changes, but answered after, is not served.
+	md.resetState()
+	md.setExpectedResults(expectedResults)
+	md.setRetries(vaa.ChainIDPolygon, ignoreQuery)
+	md.signedQueryReqWriteC <- signedQueryRequest
+	require.Eventually(t, func() bool {
+		// Holding the mock lock keeps the watcher from answering the retry until the guardian set has changed.
+		md.mutex.Lock()
+		defer md.mutex.Unlock()
+		if md.requestsPerChain[vaa.ChainIDPolygon] != 1 {
+			return false
+		}
+		gst.Set(&common.GuardianSet{Index: 2})
+		return true
+	}, time.Second, time.Millisecond)
+	requireQueryError(ErrGuardianSetTransition)
+	require.Nil(t, md.waitForResponse())
+	assert.Equal(t, 2, md.getRequestsPerChain(vaa.ChainIDPolygon))
+
+	// Right after the guardian set changes, queries are not served and do not go to the watcher.
+	md.resetState()
+	md.setExpectedResults(expectedResults)
+	md.signedQueryReqWriteC <- signedQueryRequest
+	requireQueryError(ErrGuardianSetTransition)
+	require.Nil(t, md.waitForResponse())
+	assert.Equal(t, 0, md.getRequestsPerChain(vaa.ChainIDPolygon))
+
+	// Once the transition window has passed, queries are served again.
+	time.Sleep(500 * time.Millisecond)
+	md.resetState()
+	md.setExpectedResults(expectedResults)
+	md.signedQueryReqWriteC <- signedQueryRequest
+	require.NotNil(t, md.waitForResponse())
+	assert.Equal(t, 1, md.getRequestsPerChain(vaa.ChainIDPolygon))
+}
+
+func TestGuardianSetTransitionWithoutTraffic(t *testing.T) {
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(&common.GuardianSet{Index: 1})
+	gsTransition := newGuardianSetTransition(gst, time.Minute)
+	require.NotNil(t, gsTransition)
+
+	// The initial guardian set is not a change.
+	assert.False(t, gsTransition.withholding(time.Now()))
+
+	// The window starts when the guardian set changes, even if no query arrives until later.
+	before := time.Now()
+	gst.Set(&common.GuardianSet{Index: 2})
+	after := time.Now()
+	assert.True(t, gsTransition.withholding(after))
+	assert.True(t, gsTransition.withholding(before.Add(time.Minute-time.Second)))
+
+	// The first query after the window is served, since the window was not restarted by the first query seeing the change.
+	assert.False(t, gsTransition.withholding(after.Add(time.Minute)))
+}
+
+func TestGuardianSetTransitionWindowIsAroundTheChange(t *testing.T) {
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(&common.GuardianSet{Index: 1})
+	gsTransition := newGuardianSetTransition(gst, time.Minute)
+	require.NotNil(t, gsTransition)
+
+	gst.Set(&common.GuardianSet{Index: 2})
+	changedAt := gst.ChangedAt()
+
+	// Requests received shortly before the change are withheld as well as those received shortly after it.
+	assert.False(t, gsTransition.withholding(changedAt.Add(-time.Minute-time.Second)))
+	assert.True(t, gsTransition.withholding(changedAt.Add(-time.Minute+time.Second)))
+	assert.True(t, gsTransition.withholding(changedAt))
+	assert.True(t, gsTransition.withholding(changedAt.Add(time.Minute-time.Second)))
+	assert.False(t, gsTransition.withholding(changedAt.Add(time.Minute+time.Second)))
+}
+
+func TestGuardianSetTransitionDisabled(t *testing.T) {
+	assert.Nil(t, newGuardianSetTransition(common.NewGuardianSetState(nil), 0))
+	assert.Nil(t, newGuardianSetTransition(nil, time.Minute))
+
+	var gsTransition *guardianSetTransition
+	assert.False(t, gsTransition.withholding(time.Now()))
+}
+
 func TestPerChainConfigValid(t *testing.T) {
 	for chainID, config := range perChainConfig {
 		if config.NumWorkers <= 0 {
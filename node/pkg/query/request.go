@@ -3,8 +3,10 @@ package query
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"strings"
 
 	"github.com/certusone/wormhole/node/pkg/common"
@@ -20,6 +22,14 @@ import (
 // MSG_VERSION is the current version of the CCQ message protocol.
 const MSG_VERSION uint8 = 1
 
+var (
+	// ErrMalformedQueryRequest is returned by CheckQueryRequest when a request cannot be unmarshaled.
+	ErrMalformedQueryRequest = errors.New("malformed query request")
+
+	// ErrInvalidQueryRequest is returned by CheckQueryRequest when a request is unmarshaled but fails validation.
+	ErrInvalidQueryRequest = errors.New("invalid query request")
+)
+
 // QueryRequest defines a cross chain query request to be submitted to the guardians.
 // It is the payload of the SignedQueryRequest gossip message.
 type QueryRequest struct {
@@ -27,6 +37,21 @@ type QueryRequest struct {
 	PerChainQueries []*PerChainQueryRequest
 }
 
+// NewQueryRequest returns a query request for the specified per chain queries with a random nonce. Use WithNonce to
+// override the nonce.
+func NewQueryRequest(perChainQueries ...*PerChainQueryRequest) *QueryRequest {
+	return &QueryRequest{
+		Nonce:           rand.Uint32(), // #nosec G404 -- The nonce only needs to be unique, not unpredictable.
+		PerChainQueries: perChainQueries,
+	}
+}
+
+// WithNonce sets the nonce of the query request and returns it.
+func (queryRequest *QueryRequest) WithNonce(nonce uint32) *QueryRequest {
+	queryRequest.Nonce = nonce
+	return queryRequest
+}
+
 // PerChainQueryRequest represents a query request for a single chain.
 type PerChainQueryRequest struct {
 	// ChainId indicates which chain this query is destine for.
@@ -157,6 +182,43 @@ func (saq *SolanaAccountQueryRequest) AccountList() [][SolanaPublicKeyLength]byt
 	return saq.Accounts
 }
 
+// SolanaAccountQueryOptions are the settings shared by the account queries built by BuildAccountQueries.
+type SolanaAccountQueryOptions struct {
+	// Commitment is the commitment level of the queries. Defaults to "finalized".
+	Commitment      string
+	MinContextSlot  uint64
+	DataSliceOffset uint64
+	DataSliceLength uint64
+}
+
+// BuildAccountQueries returns per chain sol_account queries for the specified accounts, splitting them into as many
+// queries as needed so that none exceeds SolanaMaxAccountsPerQuery accounts. The accounts keep their order.
+func BuildAccountQueries(chainID vaa.ChainID, accounts [][SolanaPublicKeyLength]byte, opts SolanaAccountQueryOptions) []*PerChainQueryRequest {
+	commitment := opts.Commitment
+	if commitment == "" {
+		commitment = "finalized"
+	}
+
+	queries := make([]*PerChainQueryRequest, 0, (len(accounts)+SolanaMaxAccountsPerQuery-1)/SolanaMaxAccountsPerQuery)
+	for start := 0; start < len(accounts); start += SolanaMaxAccountsPerQuery {
+		end := start + SolanaMaxAccountsPerQuery
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+		queries = append(queries, &PerChainQueryRequest{
+			ChainId: chainID,
+			Query: &SolanaAccountQueryRequest{
+				Commitment:      commitment,
+				MinContextSlot:  opts.MinContextSlot,
+				DataSliceOffset: opts.DataSliceOffset,
+				DataSliceLength: opts.DataSliceLength,
+				Accounts:        accounts[start:end:end],
+			},
+		})
+	}
+	return queries
+}
+
 // SolanaPdaQueryRequestType is the type of a Solana sol_pda query request.
 const SolanaPdaQueryRequestType ChainSpecificQueryType = 5
 
@@ -199,6 +261,52 @@ func (spda *SolanaPdaQueryRequest) PDAList() []SolanaPDAEntry {
 	return spda.PDAs
 }
 
+// SolanaGuardianSetSeed is the seed prefix of the core bridge GuardianSet PDAs.
+const SolanaGuardianSetSeed = "GuardianSet"
+
+// NewGuardianSetPdaQuery returns a finalized query for the GuardianSet account of the specified guardian set index
+// of the Solana core bridge program. The PDA seeds are "GuardianSet" and the index as a big endian uint32.
+func NewGuardianSetPdaQuery(coreBridgeProgram [SolanaPublicKeyLength]byte, index uint32) *SolanaPdaQueryRequest {
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index)
+	return &SolanaPdaQueryRequest{
+		Commitment: "finalized",
+		PDAs: []SolanaPDAEntry{
+			{
+				ProgramAddress: coreBridgeProgram,
+				Seeds: [][]byte{
+					[]byte(SolanaGuardianSetSeed),
+					indexBytes,
+				},
+			},
+		},
+	}
+}
+
+// SolanaTokenAccountsByOwnerQueryRequestType is the type of a Solana sol_token_accounts_by_owner query request.
+const SolanaTokenAccountsByOwnerQueryRequestType ChainSpecificQueryType = 6
+
+// SolanaTokenAccountsByOwnerQueryRequest implements ChainSpecificQuery for a Solana sol_token_accounts_by_owner query request.
+// It returns the token accounts of an owner, optionally limited to a single mint, sorted by account address. The results
+// are not paginated: if more than SolanaMaxAccountsPerQuery accounts match, the query fails with QueryFatalError, so
+// owners with many token accounts should be queried by mint.
+type SolanaTokenAccountsByOwnerQueryRequest struct {
+	// Commitment identifies the commitment level to be used in the queried. Currently it may only "finalized".
+	Commitment string
+
+	// The minimum slot that the request can be evaluated at. Zero means unused.
+	MinContextSlot uint64
+
+	// Owner is the public key of the owner of the token accounts.
+	Owner [SolanaPublicKeyLength]byte
+
+	// Mint limits the results to token accounts of this mint, if set.
+	Mint *[SolanaPublicKeyLength]byte
+
+	// ProgramId is the token program that owns the accounts, e.g. SPL Token or Token-2022.
+	ProgramId [SolanaPublicKeyLength]byte
+}
+
 // PerChainQueryInternal is an internal representation of a query request that is passed to the watcher.
 type PerChainQueryInternal struct {
 	RequestID  string
@@ -210,18 +318,42 @@ func (pcqi *PerChainQueryInternal) ID() string {
 	return fmt.Sprintf("%s:%d", pcqi.RequestID, pcqi.RequestIdx)
 }
 
-// QueryRequestDigest returns the query signing prefix based on the environment.
-func QueryRequestDigest(env common.Environment, b []byte) ethCommon.Hash {
-	var queryRequestPrefix []byte
+// QueryRequestPrefix returns the query signing prefix based on the environment.
+func QueryRequestPrefix(env common.Environment) []byte {
 	if env == common.MainNet {
-		queryRequestPrefix = []byte("mainnet_query_request_000000000000|")
+		return []byte("mainnet_query_request_000000000000|")
 	} else if env == common.TestNet {
-		queryRequestPrefix = []byte("testnet_query_request_000000000000|")
-	} else {
-		queryRequestPrefix = []byte("devnet_query_request_0000000000000|")
+		return []byte("testnet_query_request_000000000000|")
 	}
+	return []byte("devnet_query_request_0000000000000|")
+}
 
-	return ethCrypto.Keccak256Hash(append(queryRequestPrefix, b...))
+// ValidateQueryRequestPrefix checks that a custom query signing prefix has the same length and terminator as the
+// standard ones and does not match any of them, so that requests signed for a private network cannot be replayed against the public ones.
+func ValidateQueryRequestPrefix(prefix string) error {
+	standardLen := len(QueryRequestPrefix(common.MainNet))
+	if len(prefix) != standardLen {
+		return fmt.Errorf("query request prefix must be %d bytes long, it is %d", standardLen, len(prefix))
+	}
+	if !strings.HasSuffix(prefix, "|") {
+		return fmt.Errorf(`query request prefix must end with "|"`)
+	}
+	for _, env := range []common.Environment{common.MainNet, common.TestNet, common.UnsafeDevNet} {
+		if prefix == string(QueryRequestPrefix(env)) {
+			return fmt.Errorf("query request prefix must not match the %s prefix", env)
+		}
+	}
+	return nil
+}
+
+// QueryRequestDigest returns the digest of a query request using the signing prefix of the environment.
+func QueryRequestDigest(env common.Environment, b []byte) ethCommon.Hash {
+	return QueryRequestDigestWithPrefix(QueryRequestPrefix(env), b)
+}
+
+// QueryRequestDigestWithPrefix returns the digest of a query request using the specified signing prefix.
+func QueryRequestDigestWithPrefix(prefix []byte, b []byte) ethCommon.Hash {
+	return ethCrypto.Keccak256Hash(append(append([]byte(nil), prefix...), b...))
 }
 
 // PostSignedQueryRequest posts a signed query request to the specified channel.
@@ -280,6 +412,19 @@ func (queryRequest *QueryRequest) Unmarshal(data []byte) error {
 
 // UnmarshalFromReader deserializes the binary representation of a query request from an existing reader
 func (queryRequest *QueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := queryRequest.decodeFromReader(reader); err != nil {
+		return err
+	}
+
+	if err := queryRequest.Validate(); err != nil {
+		return fmt.Errorf("unmarshaled request failed validation: %w", err)
+	}
+
+	return nil
+}
+
+// decodeFromReader deserializes the binary representation of a query request without validating it.
+func (queryRequest *QueryRequest) decodeFromReader(reader *bytes.Reader) error {
 	var version uint8
 	if err := binary.Read(reader, binary.BigEndian, &version); err != nil {
 		return fmt.Errorf("failed to read message version: %w", err)
@@ -311,11 +456,20 @@ func (queryRequest *QueryRequest) UnmarshalFromReader(reader *bytes.Reader) erro
 		return fmt.Errorf("excess bytes in unmarshal")
 	}
 
+	return nil
+}
+
+// CheckQueryRequest unmarshals and validates a serialized query request. Errors wrap ErrMalformedQueryRequest if the
+// request could not be unmarshaled, or ErrInvalidQueryRequest if it failed validation.
+func CheckQueryRequest(data []byte) (*QueryRequest, error) {
+	var queryRequest QueryRequest
+	if err := queryRequest.decodeFromReader(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedQueryRequest, err)
+	}
 	if err := queryRequest.Validate(); err != nil {
-		return fmt.Errorf("unmarshaled request failed validation: %w", err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQueryRequest, err)
 	}
-
-	return nil
+	return &queryRequest, nil
 }
 
 // Validate does basic validation on a received query request.
@@ -352,6 +506,18 @@ func (left *QueryRequest) Equal(right *QueryRequest) bool {
 	return true
 }
 
+// Clone returns a deep copy of the query request, including the chain specific queries.
+func (queryRequest *QueryRequest) Clone() *QueryRequest {
+	clone := &QueryRequest{
+		Nonce:           queryRequest.Nonce,
+		PerChainQueries: make([]*PerChainQueryRequest, len(queryRequest.PerChainQueries)),
+	}
+	for idx, perChainQuery := range queryRequest.PerChainQueries {
+		clone.PerChainQueries[idx] = perChainQuery.Clone()
+	}
+	return clone
+}
+
 //
 // Implementation of PerChainQueryRequest.
 //
@@ -440,6 +606,12 @@ func (perChainQuery *PerChainQueryRequest) UnmarshalFromReader(reader *bytes.Rea
 			return fmt.Errorf("failed to unmarshal solana PDA query request: %w", err)
 		}
 		perChainQuery.Query = &q
+	case SolanaTokenAccountsByOwnerQueryRequestType:
+		q := SolanaTokenAccountsByOwnerQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal solana token accounts by owner query request: %w", err)
+		}
+		perChainQuery.Query = &q
 	default:
 		return fmt.Errorf("unsupported query type: %d", queryType)
 	}
@@ -469,12 +641,30 @@ func (perChainQuery *PerChainQueryRequest) Validate() error {
 	return nil
 }
 
+// SupportedQueryType describes a per chain query type handled by this node.
+type SupportedQueryType struct {
+	Type ChainSpecificQueryType
+	Name string
+}
+
+// SupportedQueryTypes lists the per chain query types handled by this node, so that clients can detect its capabilities.
+// It is also what ValidatePerChainQueryRequestType accepts, so new query types only need to be added here.
+var SupportedQueryTypes = []SupportedQueryType{
+	{EthCallQueryRequestType, "eth_call"},
+	{EthCallByTimestampQueryRequestType, "eth_call_by_timestamp"},
+	{EthCallWithFinalityQueryRequestType, "eth_call_with_finality"},
+	{SolanaAccountQueryRequestType, "sol_account"},
+	{SolanaPdaQueryRequestType, "sol_pda"},
+	{SolanaTokenAccountsByOwnerQueryRequestType, "sol_token_accounts_by_owner"},
+}
+
 func ValidatePerChainQueryRequestType(qt ChainSpecificQueryType) error {
-	if qt != EthCallQueryRequestType && qt != EthCallByTimestampQueryRequestType && qt != EthCallWithFinalityQueryRequestType &&
-		qt != SolanaAccountQueryRequestType && qt != SolanaPdaQueryRequestType {
-		return fmt.Errorf("invalid query request type: %d", qt)
+	for _, supported := range SupportedQueryTypes {
+		if supported.Type == qt {
+			return nil
+		}
 	}
-	return nil
+	return fmt.Errorf("invalid query request type: %d", qt)
 }
 
 // Equal verifies that two query requests are equal.
@@ -531,11 +721,41 @@ func (left *PerChainQueryRequest) Equal(right *PerChainQueryRequest) bool {
 		default:
 			panic("unsupported query type on right, must be sol_pda")
 		}
+	case *SolanaTokenAccountsByOwnerQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *SolanaTokenAccountsByOwnerQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be sol_token_accounts_by_owner")
+		}
 	default:
 		panic("unsupported query type on left")
 	}
 }
 
+// Clone returns a deep copy of the per chain query request, including the chain specific query.
+func (perChainQuery *PerChainQueryRequest) Clone() *PerChainQueryRequest {
+	clone := &PerChainQueryRequest{ChainId: perChainQuery.ChainId}
+	switch query := perChainQuery.Query.(type) {
+	case nil:
+	case *EthCallQueryRequest:
+		clone.Query = query.Clone()
+	case *EthCallByTimestampQueryRequest:
+		clone.Query = query.Clone()
+	case *EthCallWithFinalityQueryRequest:
+		clone.Query = query.Clone()
+	case *SolanaAccountQueryRequest:
+		clone.Query = query.Clone()
+	case *SolanaPdaQueryRequest:
+		clone.Query = query.Clone()
+	case *SolanaTokenAccountsByOwnerQueryRequest:
+		clone.Query = query.Clone()
+	default:
+		panic("unsupported query type")
+	}
+	return clone
+}
+
 //
 // Implementation of EthCallQueryRequest, which implements the ChainSpecificQuery interface.
 //
@@ -666,6 +886,29 @@ func (left *EthCallQueryRequest) Equal(right *EthCallQueryRequest) bool {
 	return true
 }
 
+// Clone returns a deep copy of the EVM eth_call query.
+func (ecd *EthCallQueryRequest) Clone() *EthCallQueryRequest {
+	return &EthCallQueryRequest{
+		BlockId:  ecd.BlockId,
+		CallData: cloneEthCallData(ecd.CallData),
+	}
+}
+
+// cloneEthCallData returns a deep copy of the call data of an EVM query.
+func cloneEthCallData(callData []*EthCallData) []*EthCallData {
+	if callData == nil {
+		return nil
+	}
+	clone := make([]*EthCallData, len(callData))
+	for idx, cd := range callData {
+		clone[idx] = &EthCallData{
+			To:   bytes.Clone(cd.To),
+			Data: bytes.Clone(cd.Data),
+		}
+	}
+	return clone
+}
+
 //
 // Implementation of EthCallByTimestampQueryRequest, which implements the ChainSpecificQuery interface.
 //
@@ -834,6 +1077,16 @@ func (left *EthCallByTimestampQueryRequest) Equal(right *EthCallByTimestampQuery
 	return true
 }
 
+// Clone returns a deep copy of the EVM eth_call_by_timestamp query.
+func (ecd *EthCallByTimestampQueryRequest) Clone() *EthCallByTimestampQueryRequest {
+	return &EthCallByTimestampQueryRequest{
+		TargetTimestamp:      ecd.TargetTimestamp,
+		TargetBlockIdHint:    ecd.TargetBlockIdHint,
+		FollowingBlockIdHint: ecd.FollowingBlockIdHint,
+		CallData:             cloneEthCallData(ecd.CallData),
+	}
+}
+
 //
 // Implementation of EthCallWithFinalityQueryRequest, which implements the ChainSpecificQuery interface.
 //
@@ -993,6 +1246,15 @@ func (left *EthCallWithFinalityQueryRequest) Equal(right *EthCallWithFinalityQue
 	return true
 }
 
+// Clone returns a deep copy of the EVM eth_call_with_finality query.
+func (ecd *EthCallWithFinalityQueryRequest) Clone() *EthCallWithFinalityQueryRequest {
+	return &EthCallWithFinalityQueryRequest{
+		BlockId:  ecd.BlockId,
+		Finality: ecd.Finality,
+		CallData: cloneEthCallData(ecd.CallData),
+	}
+}
+
 //
 // Implementation of SolanaAccountQueryRequest, which implements the ChainSpecificQuery interface.
 //
@@ -1125,6 +1387,16 @@ func (left *SolanaAccountQueryRequest) Equal(right *SolanaAccountQueryRequest) b
 	return true
 }
 
+// Clone returns a deep copy of the Solana sol_account query.
+func (saq *SolanaAccountQueryRequest) Clone() *SolanaAccountQueryRequest {
+	clone := *saq
+	if saq.Accounts != nil {
+		clone.Accounts = make([][SolanaPublicKeyLength]byte, len(saq.Accounts))
+		copy(clone.Accounts, saq.Accounts)
+	}
+	return &clone
+}
+
 //
 // Implementation of SolanaPdaQueryRequest, which implements the ChainSpecificQuery interface.
 //
@@ -1309,3 +1581,161 @@ func (left *SolanaPdaQueryRequest) Equal(right *SolanaPdaQueryRequest) bool {
 
 	return true
 }
+
+// Clone returns a deep copy of the Solana sol_pda query.
+func (spda *SolanaPdaQueryRequest) Clone() *SolanaPdaQueryRequest {
+	clone := *spda
+	if spda.PDAs != nil {
+		clone.PDAs = make([]SolanaPDAEntry, len(spda.PDAs))
+		for idx, pda := range spda.PDAs {
+			clone.PDAs[idx].ProgramAddress = pda.ProgramAddress
+			if pda.Seeds != nil {
+				clone.PDAs[idx].Seeds = make([][]byte, len(pda.Seeds))
+				for idx2, seed := range pda.Seeds {
+					clone.PDAs[idx].Seeds[idx2] = bytes.Clone(seed)
+				}
+			}
+		}
+	}
+	return &clone
+}
+
+//
+// Implementation of SolanaTokenAccountsByOwnerQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *SolanaTokenAccountsByOwnerQueryRequest) Type() ChainSpecificQueryType {
+	return SolanaTokenAccountsByOwnerQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Solana sol_token_accounts_by_owner request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (stao *SolanaTokenAccountsByOwnerQueryRequest) Marshal() ([]byte, error) {
+	if err := stao.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(stao.Commitment)))
+	buf.Write([]byte(stao.Commitment))
+
+	vaa.MustWrite(buf, binary.BigEndian, stao.MinContextSlot)
+
+	buf.Write(stao.Owner[:])
+
+	// The mint is optional, so it is preceded by a flag.
+	if stao.Mint != nil {
+		vaa.MustWrite(buf, binary.BigEndian, true)
+		buf.Write(stao.Mint[:])
+	} else {
+		vaa.MustWrite(buf, binary.BigEndian, false)
+	}
+
+	buf.Write(stao.ProgramId[:])
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Solana sol_token_accounts_by_owner query from a byte array
+func (stao *SolanaTokenAccountsByOwnerQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return stao.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Solana sol_token_accounts_by_owner query from a byte array
+func (stao *SolanaTokenAccountsByOwnerQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	len := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &len); err != nil {
+		return fmt.Errorf("failed to read commitment len: %w", err)
+	}
+
+	if len > SolanaMaxCommitmentLength {
+		return fmt.Errorf("commitment string is too long, may not be more than %d characters", SolanaMaxCommitmentLength)
+	}
+
+	commitment := make([]byte, len)
+	if n, err := reader.Read(commitment[:]); err != nil || n != int(len) {
+		return fmt.Errorf("failed to read commitment [%d]: %w", n, err)
+	}
+	stao.Commitment = string(commitment)
+
+	if err := binary.Read(reader, binary.BigEndian, &stao.MinContextSlot); err != nil {
+		return fmt.Errorf("failed to read min slot: %w", err)
+	}
+
+	if n, err := reader.Read(stao.Owner[:]); err != nil || n != SolanaPublicKeyLength {
+		return fmt.Errorf("failed to read owner [%d]: %w", n, err)
+	}
+
+	hasMint := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &hasMint); err != nil {
+		return fmt.Errorf("failed to read mint flag: %w", err)
+	}
+
+	switch hasMint {
+	case 0:
+		stao.Mint = nil
+	case 1:
+		mint := [SolanaPublicKeyLength]byte{}
+		if n, err := reader.Read(mint[:]); err != nil || n != SolanaPublicKeyLength {
+			return fmt.Errorf("failed to read mint [%d]: %w", n, err)
+		}
+		stao.Mint = &mint
+	default:
+		return fmt.Errorf("invalid mint flag: %d", hasMint)
+	}
+
+	if n, err := reader.Read(stao.ProgramId[:]); err != nil || n != SolanaPublicKeyLength {
+		return fmt.Errorf("failed to read program ID [%d]: %w", n, err)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Solana sol_token_accounts_by_owner query.
+func (stao *SolanaTokenAccountsByOwnerQueryRequest) Validate() error {
+	if len(stao.Commitment) > SolanaMaxCommitmentLength {
+		return fmt.Errorf("commitment too long")
+	}
+	if stao.Commitment != "finalized" {
+		return fmt.Errorf(`commitment must be "finalized"`)
+	}
+
+	// The keys are fixed length, so don't need to check the lengths.
+	if stao.Owner == [SolanaPublicKeyLength]byte{} {
+		return fmt.Errorf("owner may not be zero")
+	}
+	if stao.Mint != nil && *stao.Mint == [SolanaPublicKeyLength]byte{} {
+		return fmt.Errorf("mint may not be zero")
+	}
+	if stao.ProgramId == [SolanaPublicKeyLength]byte{} {
+		return fmt.Errorf("program ID may not be zero")
+	}
+
+	return nil
+}
+
+// Equal verifies that two Solana sol_token_accounts_by_owner queries are equal.
+func (left *SolanaTokenAccountsByOwnerQueryRequest) Equal(right *SolanaTokenAccountsByOwnerQueryRequest) bool {
+	if left.Commitment != right.Commitment ||
+		left.MinContextSlot != right.MinContextSlot ||
+		left.Owner != right.Owner ||
+		left.ProgramId != right.ProgramId {
+		return false
+	}
+
+	if left.Mint == nil || right.Mint == nil {
+		return left.Mint == nil && right.Mint == nil
+	}
+	return *left.Mint == *right.Mint
+}
+
+// Clone returns a deep copy of the Solana sol_token_accounts_by_owner query.
+func (stao *SolanaTokenAccountsByOwnerQueryRequest) Clone() *SolanaTokenAccountsByOwnerQueryRequest {
+	clone := *stao
+	if stao.Mint != nil {
+		mint := *stao.Mint
+		clone.Mint = &mint
+	}
+	return &clone
+}
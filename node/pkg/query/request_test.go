@@ -1,12 +1,14 @@
 package query
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
@@ -764,6 +766,22 @@ func TestSolanaAccountQueryRequestMarshalUnmarshal(t *testing.T) {
 	assert.True(t, queryRequest.Equal(&queryRequest2))
 }
 
+func TestCheckQueryRequest(t *testing.T) {
+	queryRequestBytes, err := createSolanaAccountQueryRequestForTesting(t).Marshal()
+	require.NoError(t, err)
+
+	queryRequest, err := CheckQueryRequest(queryRequestBytes)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(queryRequest.PerChainQueries))
+
+	_, err = CheckQueryRequest(queryRequestBytes[:len(queryRequestBytes)-1])
+	assert.ErrorIs(t, err, ErrMalformedQueryRequest)
+
+	_, err = CheckQueryRequest([]byte{MSG_VERSION, 0, 0, 0, 1, 0})
+	assert.ErrorIs(t, err, ErrInvalidQueryRequest)
+	assert.ErrorContains(t, err, "request does not contain any per chain queries")
+}
+
 func TestSolanaAccountQueryRequestMarshalUnmarshalFromSDK(t *testing.T) {
 	serialized, err := hex.DecodeString("0000000966696e616c697a656400000000000000000000000000000000000000000000000002165809739240a0ac03b98440fe8985548e3aa683cd0d4d9df5b5659669faa3019c006c48c8cbf33849cb07a3f936159cc523f9591cb1999abd45890ec5fee9b7")
 	require.NoError(t, err)
@@ -836,6 +854,106 @@ func TestSolanaPdaQueryRequestMarshalUnmarshal(t *testing.T) {
 	assert.True(t, queryRequest.Equal(&queryRequest2))
 }
 
+func TestNewQueryRequest(t *testing.T) {
+	perChainQuery := createSolanaAccountQueryRequestForTesting(t).PerChainQueries[0]
+
+	nonces := map[uint32]struct{}{}
+	for i := 0; i < 100; i++ {
+		queryRequest := NewQueryRequest(perChainQuery)
+		require.Equal(t, []*PerChainQueryRequest{perChainQuery}, queryRequest.PerChainQueries)
+		nonces[queryRequest.Nonce] = struct{}{}
+	}
+	// A collision among 100 random uint32s is possible, but very unlikely.
+	assert.GreaterOrEqual(t, len(nonces), 99)
+
+	queryRequest := NewQueryRequest(perChainQuery).WithNonce(42)
+	assert.Equal(t, uint32(42), queryRequest.Nonce)
+	require.NoError(t, queryRequest.Validate())
+}
+
+func TestQueryRequestClone(t *testing.T) {
+	queryRequest := createQueryRequestForTesting(t, vaa.ChainIDPolygon)
+	queryRequest.PerChainQueries = append(queryRequest.PerChainQueries, createSolanaAccountQueryRequestForTesting(t).PerChainQueries...)
+	queryRequest.PerChainQueries = append(queryRequest.PerChainQueries, createSolanaPdaQueryRequestForTesting(t).PerChainQueries...)
+	original, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	clone := queryRequest.Clone()
+	require.True(t, queryRequest.Equal(clone))
+	cloneBytes, err := clone.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, original, cloneBytes)
+
+	// Mutate everything in the clone that could be shared with the original.
+	clone.Nonce++
+	clone.PerChainQueries[0].ChainId = vaa.ChainIDEthereum
+	for _, pcq := range clone.PerChainQueries {
+		switch q := pcq.Query.(type) {
+		case *EthCallQueryRequest:
+			q.CallData[0].To[0]++
+			q.CallData[0].Data[0]++
+		case *EthCallByTimestampQueryRequest:
+			q.CallData[1].To[0]++
+		case *EthCallWithFinalityQueryRequest:
+			q.CallData[1].Data[0]++
+		case *SolanaAccountQueryRequest:
+			q.Accounts[0][0]++
+		case *SolanaPdaQueryRequest:
+			q.PDAs[0].ProgramAddress[0]++
+			q.PDAs[0].Seeds[0][0]++
+		}
+	}
+
+	assert.False(t, queryRequest.Equal(clone))
+	afterMutation, err := queryRequest.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, original, afterMutation)
+}
+
+func TestBuildAccountQueries(t *testing.T) {
+	accounts := make([][SolanaPublicKeyLength]byte, 250)
+	for i := range accounts {
+		binary.BigEndian.PutUint32(accounts[i][:], uint32(i))
+	}
+
+	queries := BuildAccountQueries(vaa.ChainIDSolana, accounts, SolanaAccountQueryOptions{DataSliceLength: 8})
+	require.Equal(t, 3, len(queries))
+
+	var all [][SolanaPublicKeyLength]byte
+	for i, expectedLen := range []int{100, 100, 50} {
+		assert.Equal(t, vaa.ChainIDSolana, queries[i].ChainId)
+		sar, ok := queries[i].Query.(*SolanaAccountQueryRequest)
+		require.True(t, ok)
+		assert.Equal(t, expectedLen, len(sar.Accounts))
+		assert.Equal(t, "finalized", sar.Commitment)
+		assert.Equal(t, uint64(8), sar.DataSliceLength)
+		all = append(all, sar.Accounts...)
+	}
+	assert.Equal(t, accounts, all)
+
+	queryRequest := &QueryRequest{Nonce: 1, PerChainQueries: queries}
+	require.NoError(t, queryRequest.Validate())
+
+	// Exactly the maximum fits in one query, and no accounts produce no queries.
+	assert.Equal(t, 1, len(BuildAccountQueries(vaa.ChainIDSolana, accounts[:SolanaMaxAccountsPerQuery], SolanaAccountQueryOptions{})))
+	assert.Empty(t, BuildAccountQueries(vaa.ChainIDSolana, nil, SolanaAccountQueryOptions{}))
+}
+
+func TestNewGuardianSetPdaQuery(t *testing.T) {
+	expected, err := createSolanaPdaQueryRequestForTesting(t).PerChainQueries[0].Query.Marshal()
+	require.NoError(t, err)
+
+	callRequest := NewGuardianSetPdaQuery(ethCommon.HexToHash("0x02c806312cbe5b79ef8aa6c17e3f423d8fdfe1d46909fb1f6cdf65ee8e2e6faa"), 0)
+	actual, err := callRequest.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	// The index is big endian.
+	callRequest = NewGuardianSetPdaQuery(ethCommon.HexToHash("0x02c806312cbe5b79ef8aa6c17e3f423d8fdfe1d46909fb1f6cdf65ee8e2e6faa"), 0x01020304)
+	assert.Equal(t, []byte{1, 2, 3, 4}, callRequest.PDAs[0].Seeds[1])
+	require.NoError(t, callRequest.Validate())
+}
+
 func TestSolanaPdaQueryUnmarshalFromSDK(t *testing.T) {
 	serialized, err := hex.DecodeString("010000002b010001050000005e0000000966696e616c697a656400000000000008ff000000000000000c00000000000000140102c806312cbe5b79ef8aa6c17e3f423d8fdfe1d46909fb1f6cdf65ee8e2e6faa020000000b477561726469616e5365740000000400000000")
 	require.NoError(t, err)
@@ -847,6 +965,146 @@ func TestSolanaPdaQueryUnmarshalFromSDK(t *testing.T) {
 
 ///////////// End of Solana PDA Query tests ///////////////////////////
 
+///////////// Solana Token Accounts By Owner Query tests /////////////////////////////////
+
+func createSolanaTokenAccountsByOwnerQueryRequestForTesting(t *testing.T, withMint bool) *QueryRequest {
+	t.Helper()
+
+	callRequest := &SolanaTokenAccountsByOwnerQueryRequest{
+		Commitment: "finalized",
+		Owner:      ethCommon.HexToHash("0x165809739240a0ac03b98440fe8985548e3aa683cd0d4d9df5b5659669faa301"),
+		ProgramId:  ethCommon.HexToHash("0x06ddf6e1d765a193d9cbe146ceeb79ac1cb485ed5f5b37913a8cf5857eff00a9"), // SPL Token program
+	}
+	if withMint {
+		mint := [SolanaPublicKeyLength]byte(ethCommon.HexToHash("0xc6fa7af3bedbad3a3d65f36aabc97431b1bbe4c2d2f6e0e47ca60203452f5d61"))
+		callRequest.Mint = &mint
+	}
+
+	return &QueryRequest{
+		Nonce: 1,
+		PerChainQueries: []*PerChainQueryRequest{
+			{
+				ChainId: vaa.ChainIDSolana,
+				Query:   callRequest,
+			},
+		},
+	}
+}
+
+func TestSolanaTokenAccountsByOwnerQueryRequestMarshalUnmarshal(t *testing.T) {
+	for _, withMint := range []bool{false, true} {
+		queryRequest := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, withMint)
+		queryRequestBytes, err := queryRequest.Marshal()
+		require.NoError(t, err)
+
+		var queryRequest2 QueryRequest
+		err = queryRequest2.Unmarshal(queryRequestBytes)
+		require.NoError(t, err)
+
+		assert.True(t, queryRequest.Equal(&queryRequest2))
+		req2 := queryRequest2.PerChainQueries[0].Query.(*SolanaTokenAccountsByOwnerQueryRequest)
+		assert.Equal(t, withMint, req2.Mint != nil)
+	}
+}
+
+func TestSolanaTokenAccountsByOwnerQueryRequestMinContextSlot(t *testing.T) {
+	queryRequest := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, true)
+	queryRequest.PerChainQueries[0].Query.(*SolanaTokenAccountsByOwnerQueryRequest).MinContextSlot = 12345
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	require.NoError(t, queryRequest2.Unmarshal(queryRequestBytes))
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+	assert.Equal(t, uint64(12345), queryRequest2.PerChainQueries[0].Query.(*SolanaTokenAccountsByOwnerQueryRequest).MinContextSlot)
+
+	assert.False(t, queryRequest.Equal(createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, true)))
+}
+
+func TestSolanaTokenAccountsByOwnerQueryRequestEqualOptionalMint(t *testing.T) {
+	withoutMint := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, false)
+	withMint := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, true)
+	assert.False(t, withoutMint.Equal(withMint))
+	assert.False(t, withMint.Equal(withoutMint))
+
+	// A clone has its own copy of the mint.
+	clone := withMint.Clone()
+	require.True(t, withMint.Equal(clone))
+	clone.PerChainQueries[0].Query.(*SolanaTokenAccountsByOwnerQueryRequest).Mint[0] ^= 0xff
+	assert.False(t, withMint.Equal(clone))
+}
+
+func TestSolanaTokenAccountsByOwnerQueryRequestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *SolanaTokenAccountsByOwnerQueryRequest)
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) {},
+		},
+		{
+			name:   "valid without mint",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) { req.Mint = nil },
+		},
+		{
+			name:   "confirmed commitment",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) { req.Commitment = "confirmed" },
+			errMsg: `commitment must be "finalized"`,
+		},
+		{
+			name:   "commitment too long",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) { req.Commitment = "finalizedfinalized" },
+			errMsg: "commitment too long",
+		},
+		{
+			name:   "zero owner",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) { req.Owner = [SolanaPublicKeyLength]byte{} },
+			errMsg: "owner may not be zero",
+		},
+		{
+			name:   "zero mint",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) { req.Mint = &[SolanaPublicKeyLength]byte{} },
+			errMsg: "mint may not be zero",
+		},
+		{
+			name:   "zero program ID",
+			modify: func(req *SolanaTokenAccountsByOwnerQueryRequest) { req.ProgramId = [SolanaPublicKeyLength]byte{} },
+			errMsg: "program ID may not be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queryRequest := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, true)
+			tc.modify(queryRequest.PerChainQueries[0].Query.(*SolanaTokenAccountsByOwnerQueryRequest))
+			err := queryRequest.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestSolanaTokenAccountsByOwnerQueryRequestInvalidMintFlag(t *testing.T) {
+	queryRequest := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, false)
+	queryBytes, err := queryRequest.PerChainQueries[0].Query.Marshal()
+	require.NoError(t, err)
+
+	// The mint flag follows the commitment, the min context slot and the owner.
+	flagIdx := 4 + len("finalized") + 8 + SolanaPublicKeyLength
+	require.Equal(t, byte(0), queryBytes[flagIdx])
+	queryBytes[flagIdx] = 2
+
+	var req SolanaTokenAccountsByOwnerQueryRequest
+	assert.ErrorContains(t, req.Unmarshal(queryBytes), "invalid mint flag: 2")
+}
+
+///////////// End of Solana Token Accounts By Owner Query tests ///////////////////////////
+
 func TestPostSignedQueryRequestShouldFailIfNoOneIsListening(t *testing.T) {
 	queryRequest := createQueryRequestForTesting(t, vaa.ChainIDPolygon)
 	queryRequestBytes, err := queryRequest.Marshal()
@@ -861,3 +1119,26 @@ func TestPostSignedQueryRequestShouldFailIfNoOneIsListening(t *testing.T) {
 	var signedQueryReqSendC chan<- *gossipv1.SignedQueryRequest
 	assert.Error(t, PostSignedQueryRequest(signedQueryReqSendC, signedQueryRequest))
 }
+
+func TestQueryRequestDigestWithCustomPrefix(t *testing.T) {
+	b := []byte("some query request")
+	customPrefix := []byte("private_query_request_000000000000|")
+	require.NoError(t, ValidateQueryRequestPrefix(string(customPrefix)))
+
+	// The standard digest is unchanged.
+	assert.Equal(t, QueryRequestDigest(common.MainNet, b), QueryRequestDigestWithPrefix(QueryRequestPrefix(common.MainNet), b))
+
+	digest := QueryRequestDigestWithPrefix(customPrefix, b)
+	for _, env := range []common.Environment{common.MainNet, common.TestNet, common.UnsafeDevNet} {
+		assert.NotEqual(t, QueryRequestDigest(env, b), digest)
+	}
+	assert.Equal(t, digest, QueryRequestDigestWithPrefix(customPrefix, b))
+}
+
+func TestValidateQueryRequestPrefix(t *testing.T) {
+	require.ErrorContains(t, ValidateQueryRequestPrefix(""), "must be 35 bytes long")
+	require.ErrorContains(t, ValidateQueryRequestPrefix("private_query_request|"), "must be 35 bytes long")
+	require.ErrorContains(t, ValidateQueryRequestPrefix("private_query_request_0000000000000"), `must end with "|"`)
+	require.ErrorContains(t, ValidateQueryRequestPrefix("testnet_query_request_000000000000|"), "must not match")
+	require.NoError(t, ValidateQueryRequestPrefix("private_query_request_000000000000|"))
+}
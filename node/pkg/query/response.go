@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"time"
@@ -28,6 +29,28 @@ const (
 	QueryFatalError QueryStatus = -1
 )
 
+// DefaultMaxResponseDataSize is the default limit on the aggregate result data in a query response. Gossip messages are
+// limited to 1 MiB, and the response also carries the request and its signature.
+const DefaultMaxResponseDataSize = 512 * 1024
+
+// ErrResponseDataTooLarge is returned when the aggregate result data of a query response exceeds the configured limit.
+var ErrResponseDataTooLarge = errors.New("query response data is too large")
+
+// ResponseTruncatedError is the ErrResponseDataTooLarge returned for a particular response. The response is not signed,
+// since the only way to fit it within the limit would be to truncate the results.
+type ResponseTruncatedError struct {
+	Size    int
+	MaxSize int
+}
+
+func (e *ResponseTruncatedError) Error() string {
+	return fmt.Sprintf("%s: %d bytes of result data, maximum is %d", ErrResponseDataTooLarge, e.Size, e.MaxSize)
+}
+
+func (e *ResponseTruncatedError) Unwrap() error {
+	return ErrResponseDataTooLarge
+}
+
 // This is the query response returned from the watcher to the query handler.
 type PerChainQueryResponseInternal struct {
 	RequestID  string
@@ -121,6 +144,10 @@ type SolanaAccountQueryResponse struct {
 	Results []SolanaAccountResult
 }
 
+// SolanaMaxAccountDataLength is the largest amount of data a Solana account may hold (MAX_PERMITTED_DATA_LENGTH).
+// https://github.com/solana-labs/solana/blob/9d132441fdc6282a8be4bff0bc77d6a2fefe8b59/sdk/program/src/system_instruction.rs#L85
+const SolanaMaxAccountDataLength = 10 * 1024 * 1024
+
 type SolanaAccountResult struct {
 	// Lamports is the number of lamports assigned to the account.
 	Lamports uint64
@@ -175,6 +202,41 @@ type SolanaPdaResult struct {
 	Data []byte
 }
 
+// SolanaTokenAccountsByOwnerQueryResponse implements ChainSpecificResponse for a Solana sol_token_accounts_by_owner query response.
+type SolanaTokenAccountsByOwnerQueryResponse struct {
+	// SlotNumber is the slot number returned by the sol_token_accounts_by_owner query
+	SlotNumber uint64
+
+	// BlockTime is the block time associated with the slot.
+	BlockTime time.Time
+
+	// BlockHash is the block hash associated with the slot.
+	BlockHash [SolanaPublicKeyLength]byte
+
+	// Results may be empty if the owner does not hold any matching token accounts.
+	Results []SolanaTokenAccountResult
+}
+
+type SolanaTokenAccountResult struct {
+	// Account is the public key of the token account.
+	Account [SolanaPublicKeyLength]byte
+
+	// Lamports is the number of lamports assigned to the account.
+	Lamports uint64
+
+	// RentEpoch is the epoch at which this account will next owe rent.
+	RentEpoch uint64
+
+	// Executable is a boolean indicating if the account contains a program (and is strictly read-only).
+	Executable bool
+
+	// Owner is the public key of the owner of the account, which is the token program.
+	Owner [SolanaPublicKeyLength]byte
+
+	// Data is the raw token account data.
+	Data []byte
+}
+
 //
 // Implementation of QueryResponsePublication.
 //
@@ -324,9 +386,98 @@ func (msg *QueryResponsePublication) Validate() error {
 		if err := pcr.Validate(); err != nil {
 			return fmt.Errorf("failed to validate per chain query %d: %w", idx, err)
 		}
+		if pcr.ChainId != queryRequest.PerChainQueries[idx].ChainId {
+			return fmt.Errorf("chain ID of response %d does not match the query", idx)
+		}
 		if pcr.Response.Type() != queryRequest.PerChainQueries[idx].Query.Type() {
 			return fmt.Errorf("type of response %d does not match the query", idx)
 		}
+		switch resp := pcr.Response.(type) {
+		case *SolanaAccountQueryResponse:
+			req := queryRequest.PerChainQueries[idx].Query.(*SolanaAccountQueryRequest)
+			if len(resp.Results) > len(req.Accounts) {
+				return fmt.Errorf("response %d contains %d results but only %d accounts were queried", idx, len(resp.Results), len(req.Accounts))
+			}
+			if err := resp.ValidateDataSlice(req); err != nil {
+				return fmt.Errorf("response %d does not match the query: %w", idx, err)
+			}
+		case *SolanaPdaQueryResponse:
+			req := queryRequest.PerChainQueries[idx].Query.(*SolanaPdaQueryRequest)
+			if len(resp.Results) > len(req.PDAs) {
+				return fmt.Errorf("response %d contains %d results but only %d PDAs were queried", idx, len(resp.Results), len(req.PDAs))
+			}
+			if err := resp.ValidateDataSlice(req); err != nil {
+				return fmt.Errorf("response %d does not match the query: %w", idx, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ResultDataSize returns the total number of bytes of result data across all of the per chain responses.
+func (msg *QueryResponsePublication) ResultDataSize() int {
+	size := 0
+	for _, pcr := range msg.PerChainResponses {
+		switch resp := pcr.Response.(type) {
+		case *EthCallQueryResponse:
+			for _, result := range resp.Results {
+				size += len(result)
+			}
+		case *EthCallByTimestampQueryResponse:
+			for _, result := range resp.Results {
+				size += len(result)
+			}
+		case *EthCallWithFinalityQueryResponse:
+			for _, result := range resp.Results {
+				size += len(result)
+			}
+		case *SolanaAccountQueryResponse:
+			for _, result := range resp.Results {
+				size += len(result.Data)
+			}
+		case *SolanaPdaQueryResponse:
+			for _, result := range resp.Results {
+				size += len(result.Data)
+			}
+		case *SolanaTokenAccountsByOwnerQueryResponse:
+			for _, result := range resp.Results {
+				size += len(result.Data)
+			}
+		}
+	}
+	return size
+}
+
+// ValidateResultDataSize returns a *ResponseTruncatedError if the aggregate result data exceeds maxSize. Zero means unlimited.
+func (msg *QueryResponsePublication) ValidateResultDataSize(maxSize int) error {
+	if maxSize <= 0 {
+		return nil
+	}
+	if size := msg.ResultDataSize(); size > maxSize {
+		return &ResponseTruncatedError{Size: size, MaxSize: maxSize}
+	}
+	return nil
+}
+
+// ValidateResultFreshness returns an error if any Solana per chain response was read at a block older than maxAge. Zero means
+// unlimited. This is a client side check, so a response is rejected even if it is validly signed.
+func (msg *QueryResponsePublication) ValidateResultFreshness(maxAge time.Duration) error {
+	if maxAge <= 0 {
+		return nil
+	}
+	for idx, pcr := range msg.PerChainResponses {
+		var err error
+		switch resp := pcr.Response.(type) {
+		case *SolanaAccountQueryResponse:
+			err = resp.ValidateResultFreshness(maxAge)
+		case *SolanaPdaQueryResponse:
+			err = resp.ValidateResultFreshness(maxAge)
+		case *SolanaTokenAccountsByOwnerQueryResponse:
+			err = resp.ValidateResultFreshness(maxAge)
+		}
+		if err != nil {
+			return fmt.Errorf("response %d: %w", idx, err)
+		}
 	}
 	return nil
 }
@@ -456,6 +607,12 @@ func (perChainResponse *PerChainQueryResponse) UnmarshalFromReader(reader *bytes
 			return fmt.Errorf("failed to unmarshal sol_account response: %w", err)
 		}
 		perChainResponse.Response = &r
+	case SolanaTokenAccountsByOwnerQueryRequestType:
+		r := SolanaTokenAccountsByOwnerQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal sol_token_accounts_by_owner response: %w", err)
+		}
+		perChainResponse.Response = &r
 	default:
 		return fmt.Errorf("unsupported query type: %d", queryType)
 	}
@@ -539,6 +696,13 @@ func (left *PerChainQueryResponse) Equal(right *PerChainQueryResponse) bool {
 		default:
 			panic("unsupported query type on right") // We checked this above!
 		}
+	case *SolanaTokenAccountsByOwnerQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *SolanaTokenAccountsByOwnerQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
 	default:
 		panic("unsupported query type on left") // We checked this above!
 	}
@@ -1053,7 +1217,7 @@ func (sar *SolanaAccountQueryResponse) Validate() error {
 	if len(sar.Results) <= 0 {
 		return fmt.Errorf("does not contain any results")
 	}
-	if len(sar.Results) > math.MaxUint8 {
+	if len(sar.Results) > SolanaMaxAccountsPerQuery {
 		return fmt.Errorf("too many results")
 	}
 	for _, result := range sar.Results {
@@ -1061,7 +1225,7 @@ func (sar *SolanaAccountQueryResponse) Validate() error {
 		if len(result.Owner) != SolanaPublicKeyLength {
 			return fmt.Errorf("invalid owner length")
 		}
-		if len(result.Data) > math.MaxUint32 {
+		if len(result.Data) > SolanaMaxAccountDataLength {
 			return fmt.Errorf("data too long")
 		}
 	}
@@ -1069,6 +1233,26 @@ func (sar *SolanaAccountQueryResponse) Validate() error {
 	return nil
 }
 
+// ValidateResultFreshness returns an error if the slot the results were read at is older than maxAge.
+// This allows clients to reject stale attestations.
+func (sar *SolanaAccountQueryResponse) ValidateResultFreshness(maxAge time.Duration) error {
+	return validateSolanaResultFreshness(sar.SlotNumber, sar.BlockTime, maxAge)
+}
+
+// ValidateDataSlice returns an error if any result contains more data than the data slice requested by the query.
+// This prevents extra bytes from being smuggled into a response.
+func (sar *SolanaAccountQueryResponse) ValidateDataSlice(req *SolanaAccountQueryRequest) error {
+	if req.DataSliceLength == 0 {
+		return nil
+	}
+	for idx, result := range sar.Results {
+		if uint64(len(result.Data)) > req.DataSliceLength {
+			return fmt.Errorf("result %d contains %d bytes of data, requested data slice length is %d", idx, len(result.Data), req.DataSliceLength)
+		}
+	}
+	return nil
+}
+
 // Equal verifies that two Solana sol_account responses are equal.
 func (left *SolanaAccountQueryResponse) Equal(right *SolanaAccountQueryResponse) bool {
 	if left.SlotNumber != right.SlotNumber ||
@@ -1210,7 +1394,7 @@ func (sar *SolanaPdaQueryResponse) Validate() error {
 	if len(sar.Results) <= 0 {
 		return fmt.Errorf("does not contain any results")
 	}
-	if len(sar.Results) > math.MaxUint8 {
+	if len(sar.Results) > SolanaMaxAccountsPerQuery {
 		return fmt.Errorf("too many results")
 	}
 	for _, result := range sar.Results {
@@ -1222,7 +1406,7 @@ func (sar *SolanaPdaQueryResponse) Validate() error {
 		if len(result.Owner) != SolanaPublicKeyLength {
 			return fmt.Errorf("invalid owner length")
 		}
-		if len(result.Data) > math.MaxUint32 {
+		if len(result.Data) > SolanaMaxAccountDataLength {
 			return fmt.Errorf("data too long")
 		}
 	}
@@ -1230,6 +1414,26 @@ func (sar *SolanaPdaQueryResponse) Validate() error {
 	return nil
 }
 
+// ValidateResultFreshness returns an error if the slot the results were read at is older than maxAge.
+// This allows clients to reject stale attestations.
+func (sar *SolanaPdaQueryResponse) ValidateResultFreshness(maxAge time.Duration) error {
+	return validateSolanaResultFreshness(sar.SlotNumber, sar.BlockTime, maxAge)
+}
+
+// ValidateDataSlice returns an error if any result contains more data than the data slice requested by the query.
+// This prevents extra bytes from being smuggled into a response.
+func (sar *SolanaPdaQueryResponse) ValidateDataSlice(req *SolanaPdaQueryRequest) error {
+	if req.DataSliceLength == 0 {
+		return nil
+	}
+	for idx, result := range sar.Results {
+		if uint64(len(result.Data)) > req.DataSliceLength {
+			return fmt.Errorf("result %d contains %d bytes of data, requested data slice length is %d", idx, len(result.Data), req.DataSliceLength)
+		}
+	}
+	return nil
+}
+
 // Equal verifies that two Solana sol_pda responses are equal.
 func (left *SolanaPdaQueryResponse) Equal(right *SolanaPdaQueryResponse) bool {
 	if left.SlotNumber != right.SlotNumber ||
@@ -1255,3 +1459,164 @@ func (left *SolanaPdaQueryResponse) Equal(right *SolanaPdaQueryResponse) bool {
 
 	return true
 }
+
+//
+// Implementation of SolanaTokenAccountsByOwnerQueryResponse, which implements the ChainSpecificResponse for a Solana sol_token_accounts_by_owner query response.
+//
+
+func (star *SolanaTokenAccountsByOwnerQueryResponse) Type() ChainSpecificQueryType {
+	return SolanaTokenAccountsByOwnerQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Solana sol_token_accounts_by_owner response.
+// This method calls Validate() and relies on it to range check lengths, etc.
+func (star *SolanaTokenAccountsByOwnerQueryResponse) Marshal() ([]byte, error) {
+	if err := star.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, star.SlotNumber)
+	vaa.MustWrite(buf, binary.BigEndian, star.BlockTime.UnixMicro())
+	buf.Write(star.BlockHash[:])
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(star.Results)))
+	for _, res := range star.Results {
+		buf.Write(res.Account[:])
+		vaa.MustWrite(buf, binary.BigEndian, res.Lamports)
+		vaa.MustWrite(buf, binary.BigEndian, res.RentEpoch)
+		vaa.MustWrite(buf, binary.BigEndian, res.Executable)
+		buf.Write(res.Owner[:])
+
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(res.Data)))
+		buf.Write(res.Data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Solana sol_token_accounts_by_owner response from a byte array
+func (star *SolanaTokenAccountsByOwnerQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return star.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Solana sol_token_accounts_by_owner response from a byte array
+func (star *SolanaTokenAccountsByOwnerQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &star.SlotNumber); err != nil {
+		return fmt.Errorf("failed to read slot number: %w", err)
+	}
+
+	blockTime := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &blockTime); err != nil {
+		return fmt.Errorf("failed to read block time: %w", err)
+	}
+	star.BlockTime = time.UnixMicro(blockTime)
+	if n, err := reader.Read(star.BlockHash[:]); err != nil || n != SolanaPublicKeyLength {
+		return fmt.Errorf("failed to read block hash [%d]: %w", n, err)
+	}
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		var result SolanaTokenAccountResult
+
+		if n, err := reader.Read(result.Account[:]); err != nil || n != SolanaPublicKeyLength {
+			return fmt.Errorf("failed to read account [%d]: %w", n, err)
+		}
+
+		if err := binary.Read(reader, binary.BigEndian, &result.Lamports); err != nil {
+			return fmt.Errorf("failed to read lamports: %w", err)
+		}
+
+		if err := binary.Read(reader, binary.BigEndian, &result.RentEpoch); err != nil {
+			return fmt.Errorf("failed to read rent epoch: %w", err)
+		}
+
+		if err := binary.Read(reader, binary.BigEndian, &result.Executable); err != nil {
+			return fmt.Errorf("failed to read executable flag: %w", err)
+		}
+
+		if n, err := reader.Read(result.Owner[:]); err != nil || n != SolanaPublicKeyLength {
+			return fmt.Errorf("failed to read owner [%d]: %w", n, err)
+		}
+
+		len := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &len); err != nil {
+			return fmt.Errorf("failed to read data len: %w", err)
+		}
+		if len > SolanaMaxAccountDataLength {
+			return fmt.Errorf("data too long")
+		}
+		result.Data = make([]byte, len)
+		if n, err := reader.Read(result.Data[:]); err != nil || n != int(len) {
+			return fmt.Errorf("failed to read data [%d]: %w", n, err)
+		}
+
+		star.Results = append(star.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Solana sol_token_accounts_by_owner response.
+func (star *SolanaTokenAccountsByOwnerQueryResponse) Validate() error {
+	// The block hash is fixed length, so don't need to check for nil.
+	if len(star.BlockHash) != SolanaPublicKeyLength {
+		return fmt.Errorf("invalid block hash length")
+	}
+
+	// Unlike the other Solana queries, an empty result is valid, since the owner may not hold any token accounts.
+	if len(star.Results) > SolanaMaxAccountsPerQuery {
+		return fmt.Errorf("too many results")
+	}
+	for _, result := range star.Results {
+		if len(result.Data) > SolanaMaxAccountDataLength {
+			return fmt.Errorf("data too long")
+		}
+	}
+
+	return nil
+}
+
+// ValidateResultFreshness returns an error if the slot the results were read at is older than maxAge.
+func (star *SolanaTokenAccountsByOwnerQueryResponse) ValidateResultFreshness(maxAge time.Duration) error {
+	return validateSolanaResultFreshness(star.SlotNumber, star.BlockTime, maxAge)
+}
+
+// Equal verifies that two Solana sol_token_accounts_by_owner responses are equal.
+func (left *SolanaTokenAccountsByOwnerQueryResponse) Equal(right *SolanaTokenAccountsByOwnerQueryResponse) bool {
+	if left.SlotNumber != right.SlotNumber ||
+		left.BlockTime != right.BlockTime ||
+		!bytes.Equal(left.BlockHash[:], right.BlockHash[:]) {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if !bytes.Equal(left.Results[idx].Account[:], right.Results[idx].Account[:]) ||
+			left.Results[idx].Lamports != right.Results[idx].Lamports ||
+			left.Results[idx].RentEpoch != right.Results[idx].RentEpoch ||
+			left.Results[idx].Executable != right.Results[idx].Executable ||
+			!bytes.Equal(left.Results[idx].Owner[:], right.Results[idx].Owner[:]) ||
+			!bytes.Equal(left.Results[idx].Data, right.Results[idx].Data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateSolanaResultFreshness checks that the block time of a Solana response is no older than maxAge.
+func validateSolanaResultFreshness(slotNumber uint64, blockTime time.Time, maxAge time.Duration) error {
+	age := time.Since(blockTime)
+	if age > maxAge {
+		return fmt.Errorf("results for slot %d are stale, block time %s is %s old, maximum is %s", slotNumber, blockTime.UTC().Format(time.RFC3339), age.Round(time.Second), maxAge)
+	}
+	return nil
+}
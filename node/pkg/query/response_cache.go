@@ -0,0 +1,80 @@
+package query
+
+import (
+	"fmt"
+	"time"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// ResponseCacheSize is the maximum number of query responses held in the response cache.
+const ResponseCacheSize = 1000
+
+type (
+	// responseCache is an LRU cache of completed query responses keyed by responseCacheKey. Entries expire after the TTL
+	// so that results for block tags like "latest" or "finalized" are never served once they may have gone stale.
+	responseCache struct {
+		ttl   time.Duration
+		cache *lru.Cache
+	}
+
+	// responseCacheEntry is a single entry in the response cache.
+	responseCacheEntry struct {
+		responses []*PerChainQueryResponse
+		expiry    time.Time
+	}
+)
+
+// newResponseCache creates a response cache. It returns nil if the TTL is zero, which disables caching.
+func newResponseCache(size int, ttl time.Duration) (*responseCache, error) {
+	if ttl <= 0 {
+		return nil, nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query response cache: %w", err)
+	}
+
+	return &responseCache{ttl: ttl, cache: cache}, nil
+}
+
+// responseCacheKey returns the response cache key of a query request. It covers the per chain queries but not the nonce,
+// so that identical queries are answered from the cache even if the client picks a new nonce for every request.
+func responseCacheKey(queryRequest *QueryRequest) (string, error) {
+	b, err := (&QueryRequest{PerChainQueries: queryRequest.PerChainQueries}).Marshal()
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal per chain queries: %w", err)
+	}
+	return ethCrypto.Keccak256Hash(b).Hex(), nil
+}
+
+// get returns the cached per chain responses for the key, or nil if there is no unexpired entry. It is safe to call on a nil cache.
+func (rc *responseCache) get(key string, now time.Time) []*PerChainQueryResponse {
+	if rc == nil {
+		return nil
+	}
+
+	val, exists := rc.cache.Get(key)
+	if !exists {
+		return nil
+	}
+
+	entry, ok := val.(*responseCacheEntry)
+	if !ok || !now.Before(entry.expiry) {
+		rc.cache.Remove(key)
+		return nil
+	}
+
+	return entry.responses
+}
+
+// add stores the per chain responses for the key. It is safe to call on a nil cache.
+func (rc *responseCache) add(key string, responses []*PerChainQueryResponse, now time.Time) {
+	if rc == nil {
+		return
+	}
+
+	rc.cache.Add(key, &responseCacheEntry{responses: responses, expiry: now.Add(rc.ttl)})
+}
@@ -105,6 +105,25 @@ func TestQueryResponseMarshalUnmarshal(t *testing.T) {
 	assert.True(t, respPub.Equal(&respPub2))
 }
 
+func TestQueryResponseValidateResultDataSize(t *testing.T) {
+	queryRequest := createQueryRequestForTesting(t, vaa.ChainIDPolygon)
+	respPub := createQueryResponseFromRequest(t, queryRequest)
+
+	size := respPub.ResultDataSize()
+	require.NotZero(t, size)
+
+	require.NoError(t, respPub.ValidateResultDataSize(0))
+	require.NoError(t, respPub.ValidateResultDataSize(size))
+
+	err := respPub.ValidateResultDataSize(size - 1)
+	require.ErrorIs(t, err, ErrResponseDataTooLarge)
+	assert.ErrorContains(t, err, fmt.Sprintf("%d bytes of result data, maximum is %d", size, size-1))
+
+	var truncatedErr *ResponseTruncatedError
+	require.ErrorAs(t, err, &truncatedErr)
+	assert.Equal(t, ResponseTruncatedError{Size: size, MaxSize: size - 1}, *truncatedErr)
+}
+
 func TestQueryResponseUnmarshalWithExtraBytesShouldFail(t *testing.T) {
 	queryRequest := createQueryRequestForTesting(t, vaa.ChainIDPolygon)
 	respPub := createQueryResponseFromRequest(t, queryRequest)
@@ -325,6 +344,46 @@ func TestSolanaAccountQueryResponseMarshalUnmarshal(t *testing.T) {
 	assert.True(t, respPub.Equal(&respPub2))
 }
 
+func TestSolanaAccountQueryResponseValidateResultFreshness(t *testing.T) {
+	resp := &SolanaAccountQueryResponse{
+		SlotNumber: 1000,
+		BlockTime:  time.Now().Add(-30 * time.Second),
+	}
+
+	require.NoError(t, resp.ValidateResultFreshness(time.Minute))
+	assert.ErrorContains(t, resp.ValidateResultFreshness(10*time.Second), "results for slot 1000 are stale")
+}
+
+func TestQueryResponsePublicationValidateResultFreshness(t *testing.T) {
+	queryRequest := createSolanaAccountQueryRequestForTesting(t)
+
+	fresh := createSolanaAccountQueryResponseFromRequest(t, queryRequest)
+	require.NoError(t, fresh.ValidateResultFreshness(time.Minute))
+
+	stale := createSolanaAccountQueryResponseFromRequest(t, queryRequest)
+	stale.PerChainResponses[0].Response.(*SolanaAccountQueryResponse).BlockTime = time.Now().Add(-5 * time.Minute)
+	require.NoError(t, stale.ValidateResultFreshness(0))
+	assert.ErrorContains(t, stale.ValidateResultFreshness(time.Minute), "response 0: results for slot 1000 are stale")
+}
+
+func TestSolanaAccountQueryResponseValidateDataSlice(t *testing.T) {
+	queryRequest := createSolanaAccountQueryRequestForTesting(t)
+	respPub := createSolanaAccountQueryResponseFromRequest(t, queryRequest)
+
+	// With no data slice requested, any amount of data is allowed.
+	require.NoError(t, respPub.Validate())
+
+	// The test responses contain eight bytes of data, so a request for exactly that much is fine.
+	queryRequest.PerChainQueries[0].Query.(*SolanaAccountQueryRequest).DataSliceLength = 8
+	respPub = createSolanaAccountQueryResponseFromRequest(t, queryRequest)
+	require.NoError(t, respPub.Validate())
+
+	// But anything shorter should be rejected.
+	queryRequest.PerChainQueries[0].Query.(*SolanaAccountQueryRequest).DataSliceLength = 4
+	respPub = createSolanaAccountQueryResponseFromRequest(t, queryRequest)
+	assert.ErrorContains(t, respPub.Validate(), "response 0 does not match the query: result 0 contains 8 bytes of data, requested data slice length is 4")
+}
+
 ///////////// Solana PDA Query tests /////////////////////////////////
 
 func createSolanaPdaQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
@@ -389,4 +448,154 @@ func TestSolanaPdaQueryResponseMarshalUnmarshal(t *testing.T) {
 	assert.True(t, respPub.Equal(&respPub2))
 }
 
+func TestSolanaPdaQueryResponseValidate(t *testing.T) {
+	queryRequest := createSolanaPdaQueryRequestForTesting(t)
+
+	tests := []struct {
+		name   string
+		modify func(respPub *QueryResponsePublication)
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			modify: func(respPub *QueryResponsePublication) {},
+		},
+		{
+			name: "wrong chain ID",
+			modify: func(respPub *QueryResponsePublication) {
+				respPub.PerChainResponses[0].ChainId = vaa.ChainIDPythNet
+			},
+			errMsg: "chain ID of response 0 does not match the query",
+		},
+		{
+			name: "no results",
+			modify: func(respPub *QueryResponsePublication) {
+				respPub.PerChainResponses[0].Response.(*SolanaPdaQueryResponse).Results = nil
+			},
+			errMsg: "does not contain any results",
+		},
+		{
+			name: "more results than PDAs",
+			modify: func(respPub *QueryResponsePublication) {
+				resp := respPub.PerChainResponses[0].Response.(*SolanaPdaQueryResponse)
+				resp.Results = append(resp.Results, resp.Results[0])
+			},
+			errMsg: "response 0 contains 2 results but only 1 PDAs were queried",
+		},
+		{
+			name: "data too long",
+			modify: func(respPub *QueryResponsePublication) {
+				respPub.PerChainResponses[0].Response.(*SolanaPdaQueryResponse).Results[0].Data = make([]byte, SolanaMaxAccountDataLength+1)
+			},
+			errMsg: "data too long",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			respPub := createSolanaPdaQueryResponseFromRequest(t, queryRequest)
+			tc.modify(respPub)
+			err := respPub.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
 ///////////// End of Solana PDA Query tests ///////////////////////////
+
+///////////// Solana Token Accounts By Owner Query tests /////////////////////////////////
+
+func createSolanaTokenAccountsByOwnerQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest, numResults int) *QueryResponsePublication {
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	sig := [65]byte{}
+	signedQueryRequest := &gossipv1.SignedQueryRequest{
+		QueryRequest: queryRequestBytes,
+		Signature:    sig[:],
+	}
+
+	perChainResponses := []*PerChainQueryResponse{}
+	for idx, pcr := range queryRequest.PerChainQueries {
+		switch req := pcr.Query.(type) {
+		case *SolanaTokenAccountsByOwnerQueryRequest:
+			results := []SolanaTokenAccountResult{}
+			for idx := 0; idx < numResults; idx++ {
+				results = append(results, SolanaTokenAccountResult{
+					Account:    ethCommon.HexToHash(fmt.Sprintf("0x4fa9188b339cfd573a0778c5deaeeee94d4bcfb12b345bf8e417e5119dae77%02x", idx)),
+					Lamports:   uint64(2000 + idx),
+					RentEpoch:  uint64(3000 + idx),
+					Executable: false,
+					Owner:      req.ProgramId,
+					Data:       []byte(fmt.Sprintf("Token account %d", idx)),
+				})
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &SolanaTokenAccountsByOwnerQueryResponse{
+					SlotNumber: uint64(1000 + idx),
+					BlockTime:  timeForTest(t, time.Now()),
+					BlockHash:  ethCommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e3"),
+					Results:    results,
+				},
+			})
+		default:
+			panic("invalid query type!")
+		}
+	}
+
+	return &QueryResponsePublication{
+		Request:           signedQueryRequest,
+		PerChainResponses: perChainResponses,
+	}
+}
+
+func TestSolanaTokenAccountsByOwnerQueryResponseMarshalUnmarshal(t *testing.T) {
+	for _, withMint := range []bool{false, true} {
+		// The owner may not hold any token accounts, so an empty result is valid.
+		for _, numResults := range []int{0, 2} {
+			queryRequest := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, withMint)
+			respPub := createSolanaTokenAccountsByOwnerQueryResponseFromRequest(t, queryRequest, numResults)
+
+			respPubBytes, err := respPub.Marshal()
+			require.NoError(t, err)
+
+			var respPub2 QueryResponsePublication
+			err = respPub2.Unmarshal(respPubBytes)
+			require.NoError(t, err)
+
+			assert.True(t, respPub.Equal(&respPub2))
+			assert.Equal(t, numResults, len(respPub2.PerChainResponses[0].Response.(*SolanaTokenAccountsByOwnerQueryResponse).Results))
+		}
+	}
+}
+
+func TestSolanaTokenAccountsByOwnerQueryResponseValidate(t *testing.T) {
+	queryRequest := createSolanaTokenAccountsByOwnerQueryRequestForTesting(t, true)
+
+	respPub := createSolanaTokenAccountsByOwnerQueryResponseFromRequest(t, queryRequest, SolanaMaxAccountsPerQuery)
+	require.NoError(t, respPub.Validate())
+
+	respPub = createSolanaTokenAccountsByOwnerQueryResponseFromRequest(t, queryRequest, SolanaMaxAccountsPerQuery+1)
+	assert.ErrorContains(t, respPub.Validate(), "too many results")
+
+	respPub = createSolanaTokenAccountsByOwnerQueryResponseFromRequest(t, queryRequest, 1)
+	respPub.PerChainResponses[0].Response.(*SolanaTokenAccountsByOwnerQueryResponse).Results[0].Data = make([]byte, SolanaMaxAccountDataLength+1)
+	assert.ErrorContains(t, respPub.Validate(), "data too long")
+}
+
+///////////// End of Solana Token Accounts By Owner Query tests ///////////////////////////
+
+func TestSolanaPdaQueryResponseValidateResultFreshness(t *testing.T) {
+	resp := &SolanaPdaQueryResponse{
+		SlotNumber: 1000,
+		BlockTime:  time.Now().Add(-30 * time.Second),
+	}
+
+	require.NoError(t, resp.ValidateResultFreshness(time.Minute))
+	assert.ErrorContains(t, resp.ValidateResultFreshness(10*time.Second), "results for slot 1000 are stale")
+}
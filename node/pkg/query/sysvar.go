@@ -0,0 +1,144 @@
+package query
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Sizes of the bincode serialized sysvar accounts.
+const (
+	solanaClockSysvarLength         = 40
+	solanaRentSysvarLength          = 17
+	solanaEpochScheduleSysvarLength = 33
+)
+
+// SolanaClockSysvar is the decoded contents of the Solana Clock sysvar account.
+type SolanaClockSysvar struct {
+	Slot                uint64 `json:"slot"`
+	EpochStartTimestamp int64  `json:"epochStartTimestamp"`
+	Epoch               uint64 `json:"epoch"`
+	LeaderScheduleEpoch uint64 `json:"leaderScheduleEpoch"`
+	UnixTimestamp       int64  `json:"unixTimestamp"`
+}
+
+// SolanaRentSysvar is the decoded contents of the Solana Rent sysvar account.
+type SolanaRentSysvar struct {
+	LamportsPerByteYear uint64  `json:"lamportsPerByteYear"`
+	ExemptionThreshold  float64 `json:"exemptionThreshold"`
+	BurnPercent         uint8   `json:"burnPercent"`
+}
+
+// SolanaEpochScheduleSysvar is the decoded contents of the Solana EpochSchedule sysvar account.
+type SolanaEpochScheduleSysvar struct {
+	SlotsPerEpoch            uint64 `json:"slotsPerEpoch"`
+	LeaderScheduleSlotOffset uint64 `json:"leaderScheduleSlotOffset"`
+	Warmup                   bool   `json:"warmup"`
+	FirstNormalEpoch         uint64 `json:"firstNormalEpoch"`
+	FirstNormalSlot          uint64 `json:"firstNormalSlot"`
+}
+
+// SolanaSysvar is a decoded sysvar account. Exactly one of the sysvar fields is set, depending on Name.
+type SolanaSysvar struct {
+	// QueryIndex is the index of the per chain query the account was returned by.
+	QueryIndex int `json:"queryIndex"`
+
+	// ResultIndex is the index of the account within the results of that query.
+	ResultIndex int `json:"resultIndex"`
+
+	// Name is the name of the sysvar, such as "clock".
+	Name string `json:"name"`
+
+	Clock         *SolanaClockSysvar         `json:"clock,omitempty"`
+	Rent          *SolanaRentSysvar          `json:"rent,omitempty"`
+	EpochSchedule *SolanaEpochScheduleSysvar `json:"epochSchedule,omitempty"`
+}
+
+// DecodeSolanaSysvar decodes the data of a recognized sysvar account. It returns nil if the account is not a recognized sysvar.
+// The data must be the complete account data.
+func DecodeSolanaSysvar(account [SolanaPublicKeyLength]byte, data []byte) (*SolanaSysvar, error) {
+	switch solana.PublicKey(account) {
+	case solana.SysVarClockPubkey:
+		if len(data) != solanaClockSysvarLength {
+			return nil, fmt.Errorf("invalid clock sysvar length, expected %d, got %d", solanaClockSysvarLength, len(data))
+		}
+		return &SolanaSysvar{
+			Name: "clock",
+			Clock: &SolanaClockSysvar{
+				Slot:                binary.LittleEndian.Uint64(data[0:8]),
+				EpochStartTimestamp: int64(binary.LittleEndian.Uint64(data[8:16])),
+				Epoch:               binary.LittleEndian.Uint64(data[16:24]),
+				LeaderScheduleEpoch: binary.LittleEndian.Uint64(data[24:32]),
+				UnixTimestamp:       int64(binary.LittleEndian.Uint64(data[32:40])),
+			},
+		}, nil
+	case solana.SysVarRentPubkey:
+		if len(data) != solanaRentSysvarLength {
+			return nil, fmt.Errorf("invalid rent sysvar length, expected %d, got %d", solanaRentSysvarLength, len(data))
+		}
+		return &SolanaSysvar{
+			Name: "rent",
+			Rent: &SolanaRentSysvar{
+				LamportsPerByteYear: binary.LittleEndian.Uint64(data[0:8]),
+				ExemptionThreshold:  math.Float64frombits(binary.LittleEndian.Uint64(data[8:16])),
+				BurnPercent:         data[16],
+			},
+		}, nil
+	case solana.SysVarEpochSchedulePubkey:
+		if len(data) != solanaEpochScheduleSysvarLength {
+			return nil, fmt.Errorf("invalid epoch schedule sysvar length, expected %d, got %d", solanaEpochScheduleSysvarLength, len(data))
+		}
+		return &SolanaSysvar{
+			Name: "epochSchedule",
+			EpochSchedule: &SolanaEpochScheduleSysvar{
+				SlotsPerEpoch:            binary.LittleEndian.Uint64(data[0:8]),
+				LeaderScheduleSlotOffset: binary.LittleEndian.Uint64(data[8:16]),
+				Warmup:                   data[16] != 0,
+				FirstNormalEpoch:         binary.LittleEndian.Uint64(data[17:25]),
+				FirstNormalSlot:          binary.LittleEndian.Uint64(data[25:33]),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// DecodeSolanaSysvars decodes every recognized sysvar account returned by the sol_account queries in a response.
+// Queries that request a data slice are skipped, since the complete account data is needed to decode a sysvar.
+// This is purely informational and has no effect on the signed response.
+func DecodeSolanaSysvars(queryRequest *QueryRequest, perChainResponses []*PerChainQueryResponse) ([]*SolanaSysvar, error) {
+	if len(perChainResponses) != len(queryRequest.PerChainQueries) {
+		return nil, fmt.Errorf("received incorrect number of responses, expected %d, got %d", len(queryRequest.PerChainQueries), len(perChainResponses))
+	}
+
+	var sysvars []*SolanaSysvar
+	for idx, pcr := range perChainResponses {
+		req, ok := queryRequest.PerChainQueries[idx].Query.(*SolanaAccountQueryRequest)
+		if !ok || req.DataSliceLength != 0 {
+			continue
+		}
+		resp, ok := pcr.Response.(*SolanaAccountQueryResponse)
+		if !ok {
+			return nil, fmt.Errorf("response %d has an unexpected type %T", idx, pcr.Response)
+		}
+		if len(resp.Results) > len(req.Accounts) {
+			return nil, fmt.Errorf("response %d has more results than accounts requested", idx)
+		}
+
+		for resultIdx, result := range resp.Results {
+			sysvar, err := DecodeSolanaSysvar(req.Accounts[resultIdx], result.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode result %d of response %d: %w", resultIdx, idx, err)
+			}
+			if sysvar != nil {
+				sysvar.QueryIndex = idx
+				sysvar.ResultIndex = resultIdx
+				sysvars = append(sysvars, sysvar)
+			}
+		}
+	}
+
+	return sysvars, nil
+}
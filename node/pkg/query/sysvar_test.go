@@ -0,0 +1,90 @@
+package query
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Clock sysvar account data for slot 250000000 in epoch 578.
+const clockSysvarDataForTesting = "80b2e60e0000000000f153650000000042020000000000004302000000000000a077556500000000"
+
+func TestDecodeSolanaSysvarClock(t *testing.T) {
+	data, err := hex.DecodeString(clockSysvarDataForTesting)
+	require.NoError(t, err)
+
+	sysvar, err := DecodeSolanaSysvar(solana.SysVarClockPubkey, data)
+	require.NoError(t, err)
+	require.NotNil(t, sysvar)
+	assert.Equal(t, "clock", sysvar.Name)
+	assert.Nil(t, sysvar.Rent)
+	assert.Nil(t, sysvar.EpochSchedule)
+	assert.Equal(t, SolanaClockSysvar{
+		Slot:                250000000,
+		EpochStartTimestamp: 1700000000,
+		Epoch:               578,
+		LeaderScheduleEpoch: 579,
+		UnixTimestamp:       1700100000,
+	}, *sysvar.Clock)
+
+	// Truncated data cannot be decoded.
+	_, err = DecodeSolanaSysvar(solana.SysVarClockPubkey, data[:32])
+	assert.ErrorContains(t, err, "invalid clock sysvar length")
+
+	// Other accounts are not decoded.
+	sysvar, err = DecodeSolanaSysvar(solana.SystemProgramID, data)
+	require.NoError(t, err)
+	assert.Nil(t, sysvar)
+}
+
+func TestDecodeSolanaSysvars(t *testing.T) {
+	data, err := hex.DecodeString(clockSysvarDataForTesting)
+	require.NoError(t, err)
+
+	queryRequest := &QueryRequest{
+		Nonce: 1,
+		PerChainQueries: []*PerChainQueryRequest{
+			{
+				ChainId: 1,
+				Query: &SolanaAccountQueryRequest{
+					Commitment: "finalized",
+					Accounts:   [][SolanaPublicKeyLength]byte{solana.SystemProgramID, solana.SysVarClockPubkey},
+				},
+			},
+			{
+				ChainId: 1,
+				Query: &SolanaAccountQueryRequest{
+					Commitment:      "finalized",
+					DataSliceLength: 8,
+					Accounts:        [][SolanaPublicKeyLength]byte{solana.SysVarClockPubkey},
+				},
+			},
+		},
+	}
+
+	perChainResponses := []*PerChainQueryResponse{
+		{
+			ChainId: 1,
+			Response: &SolanaAccountQueryResponse{
+				Results: []SolanaAccountResult{{Data: []byte{}}, {Data: data}},
+			},
+		},
+		{
+			ChainId: 1,
+			Response: &SolanaAccountQueryResponse{
+				Results: []SolanaAccountResult{{Data: data[:8]}},
+			},
+		},
+	}
+
+	// The sliced query is skipped.
+	sysvars, err := DecodeSolanaSysvars(queryRequest, perChainResponses)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sysvars))
+	assert.Equal(t, 0, sysvars[0].QueryIndex)
+	assert.Equal(t, 1, sysvars[0].ResultIndex)
+	assert.Equal(t, uint64(250000000), sysvars[0].Clock.Slot)
+}
@@ -1,5 +1,5 @@
-// package readiness implements a minimal health-checking mechanism for use as k8s readiness probes. It will always
-// return a "ready" state after the conditions have been met for the first time - it's not meant for monitoring.
+// package readiness implements a minimal health-checking mechanism for use as k8s readiness probes. Most components
+// only ever transition to "ready" after the conditions have been met for the first time - it's not meant for monitoring.
 //
 // Uses a global singleton registry (similar to the Prometheus client's default behavior).
 package readiness
@@ -43,6 +43,22 @@ func SetReady(component Component) {
 	}
 }
 
+// SetNotReady marks a registered component as not ready. Unregistered components are ignored.
+func SetNotReady(component Component) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := registry[string(component)]; ok {
+		registry[string(component)] = false
+	}
+}
+
+// IsReady returns whether the given component is registered and ready.
+func IsReady(component Component) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return registry[string(component)]
+}
+
 // Handler returns a net/http handler for the readiness check. It returns 200 OK if all components are ready,
 // or 412 Precondition Failed otherwise. For operator convenience, a list of components and their states
 // is returned as plain text (not meant for machine consumption!).
@@ -0,0 +1,87 @@
+package readiness
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ValidateGracePeriod checks a startup grace period such as the value of --startupGracePeriod. Zero disables the
+// startup readiness check.
+func ValidateGracePeriod(gracePeriod time.Duration) error {
+	if gracePeriod < 0 {
+		return fmt.Errorf("grace period must not be negative: %s", gracePeriod)
+	}
+	return nil
+}
+
+// NotReady returns the sorted names of all registered components that are not ready.
+func NotReady() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	var components []string
+	for k, v := range registry {
+		if !v {
+			components = append(components, k)
+		}
+	}
+	sort.Strings(components)
+	return components
+}
+
+// WaitForReady polls the registry every pollInterval until all registered components are ready. It returns an error
+// naming the components that are still not ready if that does not happen within gracePeriod.
+func WaitForReady(ctx context.Context, gracePeriod time.Duration, pollInterval time.Duration) error {
+	return waitFor(ctx, gracePeriod, pollInterval, func(ctx context.Context) error {
+		if notReady := NotReady(); len(notReady) != 0 {
+			return fmt.Errorf("components not ready after %s: %s", gracePeriod, strings.Join(notReady, ", "))
+		}
+		return nil
+	})
+}
+
+// WaitForRemoteReady polls the readiness handler of another node at url every pollInterval until it reports ready. It
+// returns the last error if that does not happen within gracePeriod.
+func WaitForRemoteReady(ctx context.Context, url string, gracePeriod time.Duration, pollInterval time.Duration) error {
+	client := &http.Client{Timeout: pollInterval}
+	return waitFor(ctx, gracePeriod, pollInterval, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("%s not ready after %s: %w", url, gracePeriod, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s not ready after %s: status %d", url, gracePeriod, resp.StatusCode)
+		}
+		return nil
+	})
+}
+
+// waitFor calls check every pollInterval until it succeeds. If it has not succeeded within gracePeriod, the error of
+// the last check is returned.
+func waitFor(ctx context.Context, gracePeriod time.Duration, pollInterval time.Duration, check func(ctx context.Context) error) error {
+	timeout := time.NewTimer(gracePeriod)
+	defer timeout.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if check(ctx) == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			return check(ctx)
+		case <-ticker.C:
+		}
+	}
+}
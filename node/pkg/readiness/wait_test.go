@@ -0,0 +1,101 @@
+package readiness
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetRegistry(t *testing.T) {
+	t.Helper()
+	mu.Lock()
+	defer mu.Unlock()
+	registry = map[string]bool{}
+}
+
+func TestGracePeriodParsing(t *testing.T) {
+	parse := func(arg string) (time.Duration, error) {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		gracePeriod := fs.Duration("startupGracePeriod", 0, "")
+		if err := fs.Parse([]string{"--startupGracePeriod=" + arg}); err != nil {
+			return 0, err
+		}
+		return *gracePeriod, ValidateGracePeriod(*gracePeriod)
+	}
+
+	d, err := parse("90s")
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, d)
+
+	d, err = parse("0")
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, err = parse("-1s")
+	assert.ErrorContains(t, err, "must not be negative")
+
+	_, err = parse("soon")
+	assert.Error(t, err)
+}
+
+func TestWaitForReady_Ready(t *testing.T) {
+	resetRegistry(t)
+	RegisterComponent("a")
+	RegisterComponent("b")
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		SetReady("a")
+		SetReady("b")
+	}()
+
+	require.NoError(t, WaitForReady(context.Background(), time.Second, 5*time.Millisecond))
+}
+
+func TestWaitForReady_Timeout(t *testing.T) {
+	resetRegistry(t)
+	RegisterComponent("a")
+	RegisterComponent("b")
+	RegisterComponent("c")
+	SetReady("b")
+
+	err := WaitForReady(context.Background(), 50*time.Millisecond, 5*time.Millisecond)
+	assert.ErrorContains(t, err, "components not ready after 50ms: a, c")
+}
+
+func TestWaitForReady_Canceled(t *testing.T) {
+	resetRegistry(t)
+	RegisterComponent("a")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, WaitForReady(ctx, time.Second, 5*time.Millisecond), context.Canceled)
+}
+
+func TestWaitForRemoteReady(t *testing.T) {
+	var ready atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !ready.Load() {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	err := WaitForRemoteReady(context.Background(), srv.URL, 50*time.Millisecond, 5*time.Millisecond)
+	assert.ErrorContains(t, err, "status 412")
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		ready.Store(true)
+	}()
+	require.NoError(t, WaitForRemoteReady(context.Background(), srv.URL, time.Second, 5*time.Millisecond))
+}
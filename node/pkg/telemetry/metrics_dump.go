@@ -0,0 +1,37 @@
+package telemetry
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+// DumpMetrics writes all metrics collected by gatherer to the file at path in the Prometheus text exposition format,
+// overwriting the file if it exists. It is meant to capture the final metric state of the node on shutdown.
+func DumpMetrics(gatherer prometheus.Gatherer, path string) error {
+	families, err := gatherer.Gather()
+	if err != nil {
+		return fmt.Errorf("failed to gather metrics: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create metrics dump file: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, mf := range families {
+		if _, err := expfmt.MetricFamilyToText(w, mf); err != nil {
+			return fmt.Errorf("failed to write metric family %s: %w", mf.GetName(), err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write metrics dump file: %w", err)
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,52 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpMetricsOnShutdown(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "wormhole_test_dump_total",
+		Help: "Counter used to test dumping metrics",
+	})
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "wormhole_test_dump_delay_us",
+		Help: "Histogram used to test dumping metrics",
+	})
+	registry.MustRegister(counter, histogram)
+
+	counter.Add(3)
+	histogram.Observe(42)
+
+	path := filepath.Join(t.TempDir(), "metrics.txt")
+
+	// Simulate the node shutting down and dumping its metrics once the root context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		done <- DumpMetrics(registry, path)
+	}()
+	cancel()
+	require.NoError(t, <-done)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	dump := string(b)
+	assert.Contains(t, dump, "# TYPE wormhole_test_dump_total counter")
+	assert.Contains(t, dump, "wormhole_test_dump_total 3")
+	assert.Contains(t, dump, "wormhole_test_dump_delay_us_count 1")
+}
+
+func TestDumpMetricsInvalidPath(t *testing.T) {
+	err := DumpMetrics(prometheus.NewRegistry(), filepath.Join(t.TempDir(), "missing", "metrics.txt"))
+	assert.ErrorContains(t, err, "failed to create metrics dump file")
+}
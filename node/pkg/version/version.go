@@ -1,5 +1,7 @@
 package version
 
+import "runtime/debug"
+
 // Wormhole release version injected by the compiler.
 var version = "development"
 
@@ -9,3 +11,20 @@ func Version() string {
 	}
 	return version
 }
+
+// GitCommit returns the git revision the binary was built from, as recorded by the go toolchain.
+// Returns "unknown" if the binary was built without VCS information.
+func GitCommit() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && setting.Value != "" {
+			return setting.Value
+		}
+	}
+
+	return "unknown"
+}
@@ -677,10 +677,7 @@ func (w *Watcher) fetchAndUpdateGuardianSet(
 	w.currentGuardianSet = &idx
 
 	if w.setC != nil {
-		w.setC <- &common.GuardianSet{
-			Keys:  gs.Keys,
-			Index: idx,
-		}
+		w.setC <- common.NewGuardianSet(gs.Keys, idx)
 	}
 
 	return nil
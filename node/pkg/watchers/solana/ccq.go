@@ -1,14 +1,18 @@
 package solana
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
 
 	"github.com/certusone/wormhole/node/pkg/query"
@@ -29,8 +33,23 @@ const (
 	CCQ_FAST_RETRY_INTERVAL = 200 * time.Millisecond
 )
 
+var (
+	ccqSolanaRpcDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_ccq_solana_rpc_duration_seconds",
+			Help:    "Time the query handler spends in Solana RPC calls",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0},
+		}, []string{"method"})
+)
+
+// ccqObserveRpcDuration records the time spent in a Solana RPC call made on behalf of a query.
+func ccqObserveRpcDuration(method string, start time.Time) {
+	ccqSolanaRpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
 // ccqStart starts up CCQ query processing.
 func (w *SolanaWatcher) ccqStart(ctx context.Context) {
+	w.ccqLogger.Info("starting query handler", zap.String("rpc", w.ccqRpcUrl))
 	query.StartWorkers(ctx, w.ccqLogger, w.errC, w, w.queryReqC, w.ccqConfig, w.chainID.String())
 }
 
@@ -66,6 +85,8 @@ func (w *SolanaWatcher) QueryHandler(ctx context.Context, queryRequest *query.Pe
 		w.ccqHandleSolanaAccountQueryRequest(ctx, queryRequest, req, giveUpTime)
 	case *query.SolanaPdaQueryRequest:
 		w.ccqHandleSolanaPdaQueryRequest(ctx, queryRequest, req, giveUpTime)
+	case *query.SolanaTokenAccountsByOwnerQueryRequest:
+		w.ccqHandleSolanaTokenAccountsByOwnerQueryRequest(ctx, queryRequest, req)
 	default:
 		w.ccqLogger.Warn("received unsupported request type",
 			zap.Uint8("payload", uint8(queryRequest.Request.Query.Type())),
@@ -140,12 +161,14 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 
 	// Read the block for this slot to get the block time.
 	maxSupportedTransactionVersion := uint64(0)
-	block, err := w.rpcClient.GetBlockWithOpts(rCtx, info.Context.Slot, &rpc.GetBlockOpts{
+	blockStart := time.Now()
+	block, err := w.ccqRpcClient.GetBlockWithOpts(rCtx, info.Context.Slot, &rpc.GetBlockOpts{
 		Encoding:                       solana.EncodingBase64,
 		Commitment:                     params.Commitment,
 		TransactionDetails:             rpc.TransactionDetailsNone,
 		MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
 	})
+	ccqObserveRpcDuration("getBlock", blockStart)
 	if err != nil {
 		w.ccqLogger.Error(fmt.Sprintf("failed to read block time for %s query request", tag),
 			zap.String("requestId", requestId),
@@ -490,8 +513,155 @@ func (pub ccqPdaPublisher) publish(pcrResp *query.PerChainQueryResponseInternal,
 	pub.w.ccqSendQueryResponse(query.CreatePerChainQueryResponseInternal(pub.queryRequest.RequestID, pub.queryRequest.RequestIdx, pub.queryRequest.Request.ChainId, query.QuerySuccess, resp))
 }
 
+// ccqHandleSolanaTokenAccountsByOwnerQueryRequest is the query handler for a sol_token_accounts_by_owner request.
+func (w *SolanaWatcher) ccqHandleSolanaTokenAccountsByOwnerQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.SolanaTokenAccountsByOwnerQueryRequest) {
+	requestId := "sol_token_accounts_by_owner:" + queryRequest.ID()
+	w.ccqLogger.Info("received a sol_token_accounts_by_owner query",
+		zap.String("owner", solana.PublicKeyFromBytes(req.Owner[:]).String()),
+		zap.Bool("hasMint", req.Mint != nil),
+		zap.String("programId", solana.PublicKeyFromBytes(req.ProgramId[:]).String()),
+		zap.Uint64("minContextSlot", req.MinContextSlot),
+		zap.String("requestId", requestId),
+	)
+
+	rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	// The RPC accepts either a mint or a program ID. When a mint is specified, the program ID is enforced on the results below.
+	programId := solana.PublicKeyFromBytes(req.ProgramId[:])
+	conf := &rpc.GetTokenAccountsConfig{ProgramId: &programId}
+	if req.Mint != nil {
+		mint := solana.PublicKeyFromBytes(req.Mint[:])
+		conf = &rpc.GetTokenAccountsConfig{Mint: &mint}
+	}
+
+	info, err := w.getTokenAccountsByOwnerWithOpts(rCtx, solana.PublicKeyFromBytes(req.Owner[:]), conf, rpc.CommitmentType(req.Commitment), req.MinContextSlot)
+	if err != nil {
+		// Unlike sol_account queries, there are no fast retries if the minimum context slot has not been reached yet.
+		if isMinContext, currentSlot := ccqIsMinContextSlotError(err); isMinContext {
+			w.ccqLogger.Info("minimum context slot has not been reached for sol_token_accounts_by_owner query request, requesting retry",
+				zap.String("requestId", requestId),
+				zap.Uint64("currentSlot", currentSlot),
+				zap.Uint64("minContextSlot", req.MinContextSlot),
+			)
+		} else {
+			w.ccqLogger.Error("read failed for sol_token_accounts_by_owner query request", zap.String("requestId", requestId), zap.Error(err))
+		}
+		w.ccqSendErrorResponse(queryRequest, query.QueryRetryNeeded)
+		return
+	}
+
+	if info == nil {
+		w.ccqLogger.Error("read for sol_token_accounts_by_owner query request returned nil info", zap.String("requestId", requestId))
+		w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+		return
+	}
+
+	results := make([]query.SolanaTokenAccountResult, 0, len(info.Value))
+	for _, val := range info.Value {
+		if val == nil || val.Account.Data == nil {
+			w.ccqLogger.Error("read of token account for sol_token_accounts_by_owner query request failed, data is nil", zap.String("requestId", requestId))
+			w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+			return
+		}
+		if val.Account.Owner != programId {
+			continue
+		}
+		results = append(results, query.SolanaTokenAccountResult{
+			Account:    val.Pubkey,
+			Lamports:   val.Account.Lamports,
+			RentEpoch:  val.Account.RentEpoch,
+			Executable: val.Account.Executable,
+			Owner:      val.Account.Owner,
+			Data:       val.Account.Data.GetBinary(),
+		})
+	}
+
+	// The RPC does not guarantee any order, but every guardian must return the same response.
+	sort.Slice(results, func(i, j int) bool {
+		return bytes.Compare(results[i].Account[:], results[j].Account[:]) < 0
+	})
+
+	// The results are not paginated, see SolanaTokenAccountsByOwnerQueryRequest.
+	if len(results) > query.SolanaMaxAccountsPerQuery {
+		w.ccqLogger.Error("sol_token_accounts_by_owner query request returned too many token accounts",
+			zap.String("requestId", requestId),
+			zap.Int("numResults", len(results)),
+		)
+		w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+		return
+	}
+
+	// Read the block for this slot to get the block time.
+	maxSupportedTransactionVersion := uint64(0)
+	blockStart := time.Now()
+	block, err := w.ccqRpcClient.GetBlockWithOpts(rCtx, info.Context.Slot, &rpc.GetBlockOpts{
+		Encoding:                       solana.EncodingBase64,
+		Commitment:                     rpc.CommitmentType(req.Commitment),
+		TransactionDetails:             rpc.TransactionDetailsNone,
+		MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
+	})
+	ccqObserveRpcDuration("getBlock", blockStart)
+	if err != nil || block == nil || block.BlockTime == nil {
+		w.ccqLogger.Error("failed to read block time for sol_token_accounts_by_owner query request",
+			zap.String("requestId", requestId),
+			zap.Uint64("slotNumber", info.Context.Slot),
+			zap.Error(err),
+		)
+		w.ccqSendErrorResponse(queryRequest, query.QueryRetryNeeded)
+		return
+	}
+
+	resp := &query.SolanaTokenAccountsByOwnerQueryResponse{
+		SlotNumber: info.Context.Slot,
+		BlockTime:  time.Unix(int64(*block.BlockTime), 0),
+		BlockHash:  block.Blockhash,
+		Results:    results,
+	}
+
+	w.ccqLogger.Info("sol_token_accounts_by_owner query succeeded",
+		zap.String("requestId", requestId),
+		zap.Uint64("slotNumber", info.Context.Slot),
+		zap.Int("numResults", len(results)),
+	)
+
+	w.ccqSendQueryResponse(query.CreatePerChainQueryResponseInternal(queryRequest.RequestID, queryRequest.RequestIdx, queryRequest.Request.ChainId, query.QuerySuccess, resp))
+}
+
 type M map[string]interface{}
 
+// getTokenAccountsByOwnerWithOpts is a work-around for the fact that the library call doesn't support MinContextSlot.
+func (w *SolanaWatcher) getTokenAccountsByOwnerWithOpts(
+	ctx context.Context,
+	owner solana.PublicKey,
+	conf *rpc.GetTokenAccountsConfig,
+	commitment rpc.CommitmentType,
+	minContextSlot uint64,
+) (out *rpc.GetTokenAccountsResult, err error) {
+	confObj := M{}
+	if conf.Mint != nil {
+		confObj["mint"] = conf.Mint
+	}
+	if conf.ProgramId != nil {
+		confObj["programId"] = conf.ProgramId
+	}
+
+	optsObj := M{
+		"encoding": solana.EncodingBase64,
+	}
+	if commitment != "" {
+		optsObj["commitment"] = commitment
+	}
+	if minContextSlot != 0 {
+		optsObj["minContextSlot"] = minContextSlot
+	}
+
+	start := time.Now()
+	err = w.ccqRpcClient.RPCCallForInto(ctx, &out, "getTokenAccountsByOwner", []interface{}{owner, confObj, optsObj})
+	ccqObserveRpcDuration("getTokenAccountsByOwner", start)
+	return
+}
+
 // getMultipleAccountsWithOpts is a work-around for the fact that the library call doesn't honor MinContextSlot.
 // Opened the following issue against the library: https://github.com/gagliardetto/solana-go/issues/170
 func (w *SolanaWatcher) getMultipleAccountsWithOpts(
@@ -526,7 +696,9 @@ func (w *SolanaWatcher) getMultipleAccountsWithOpts(
 		}
 	}
 
-	err = w.rpcClient.RPCCallForInto(ctx, &out, "getMultipleAccounts", params)
+	start := time.Now()
+	err = w.ccqRpcClient.RPCCallForInto(ctx, &out, "getMultipleAccounts", params)
+	ccqObserveRpcDuration("getMultipleAccounts", start)
 	if err != nil {
 		return nil, err
 	}
@@ -1,12 +1,22 @@
 package solana
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -101,3 +111,69 @@ func TestCcqIsMinContextSlotErrorContextSlotIsNotUint64(t *testing.T) {
 	require.True(t, isMinContext)
 	assert.Equal(t, uint64(0), currentSlot)
 }
+
+// newCountingRpcServer returns a JSON RPC server that answers every request with an empty getMultipleAccounts result.
+func newCountingRpcServer(t *testing.T, count *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"context":{"slot":1},"value":[]}}`, req.ID)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCcqRpcOverride(t *testing.T) {
+	var watcherCount, ccqCount atomic.Int32
+	watcherSrv := newCountingRpcServer(t, &watcherCount)
+	ccqSrv := newCountingRpcServer(t, &ccqCount)
+
+	// Queries use the override when one is provided.
+	w := NewSolanaWatcher(watcherSrv.URL, nil, solana.PublicKey{}, "", nil, nil, rpc.CommitmentFinalized, vaa.ChainIDSolana, nil, nil, ccqSrv.URL, rpc.CommitmentFinalized)
+	assert.Equal(t, ccqSrv.URL, w.ccqRpcUrl)
+	_, err := w.getMultipleAccountsWithOpts(context.Background(), []solana.PublicKey{solana.SystemProgramID}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), watcherCount.Load())
+	assert.Equal(t, int32(1), ccqCount.Load())
+
+	// Otherwise they fall back to the watcher RPC.
+	w = NewSolanaWatcher(watcherSrv.URL, nil, solana.PublicKey{}, "", nil, nil, rpc.CommitmentFinalized, vaa.ChainIDSolana, nil, nil, "", rpc.CommitmentFinalized)
+	assert.Equal(t, watcherSrv.URL, w.ccqRpcUrl)
+	_, err = w.getMultipleAccountsWithOpts(context.Background(), []solana.PublicKey{solana.SystemProgramID}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), watcherCount.Load())
+	assert.Equal(t, int32(1), ccqCount.Load())
+}
+
+func getCcqSolanaRpcDuration(t *testing.T, method string) (uint64, float64) {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, ccqSolanaRpcDuration.WithLabelValues(method).(prometheus.Histogram).Write(m))
+	return m.Histogram.GetSampleCount(), m.Histogram.GetSampleSum()
+}
+
+func TestCcqSolanaRpcDurationMetric(t *testing.T) {
+	const delay = 50 * time.Millisecond
+	var count atomic.Int32
+	srv := newCountingRpcServer(t, &count)
+	slowSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(slowSrv.Close)
+
+	w := NewSolanaWatcher(slowSrv.URL, nil, solana.PublicKey{}, "", nil, nil, rpc.CommitmentFinalized, vaa.ChainIDSolana, nil, nil, "", rpc.CommitmentFinalized)
+
+	countBefore, sumBefore := getCcqSolanaRpcDuration(t, "getMultipleAccounts")
+	_, err := w.getMultipleAccountsWithOpts(context.Background(), []solana.PublicKey{solana.SystemProgramID}, nil)
+	require.NoError(t, err)
+	countAfter, sumAfter := getCcqSolanaRpcDuration(t, "getMultipleAccounts")
+
+	assert.Equal(t, countBefore+1, countAfter)
+	assert.GreaterOrEqual(t, sumAfter-sumBefore, delay.Seconds())
+}
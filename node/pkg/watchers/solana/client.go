@@ -69,6 +69,13 @@ type (
 
 		ccqConfig query.PerChainConfig
 		ccqLogger *zap.Logger
+
+		// ccqRpcUrl and ccqRpcClient are used to serve queries. They default to rpcUrl and rpcClient.
+		ccqRpcUrl    string
+		ccqRpcClient *rpc.Client
+
+		// startupCommitment is the commitment used to read the slot the watcher starts from.
+		startupCommitment rpc.CommitmentType
 	}
 
 	EventSubscriptionError struct {
@@ -176,6 +183,30 @@ func (c ConsistencyLevel) Commitment() (rpc.CommitmentType, error) {
 	}
 }
 
+// ParseStartupCommitment parses the commitment used by the watchers to read the slot they start from.
+// An empty string selects the default, which is finalized.
+func ParseStartupCommitment(s string) (rpc.CommitmentType, error) {
+	switch s {
+	case "", string(rpc.CommitmentFinalized):
+		return rpc.CommitmentFinalized, nil
+	case string(rpc.CommitmentConfirmed):
+		return rpc.CommitmentConfirmed, nil
+	default:
+		return "", fmt.Errorf("unsupported startup commitment: %q, must be %q or %q", s, rpc.CommitmentFinalized, rpc.CommitmentConfirmed)
+	}
+}
+
+// slotCommitment returns the commitment used to read the current slot. Before the first slot has been processed, the
+// startup commitment is used instead of the watcher commitment, unless that would start the watcher ahead of its own
+// commitment level. With a finalized startup commitment, the confirmed watcher therefore starts at the latest finalized
+// slot and catches up to the confirmed slot on the next poll.
+func (s *SolanaWatcher) slotCommitment() rpc.CommitmentType {
+	if s.lastSlot == 0 && s.startupCommitment == rpc.CommitmentFinalized {
+		return rpc.CommitmentFinalized
+	}
+	return s.commitment
+}
+
 func accountConsistencyLevelToCommitment(c uint8) (rpc.CommitmentType, error) {
 	switch c {
 	case 1:
@@ -214,7 +245,21 @@ func NewSolanaWatcher(
 	chainID vaa.ChainID,
 	queryReqC <-chan *query.PerChainQueryInternal,
 	queryResponseC chan<- *query.PerChainQueryResponseInternal,
+	ccqRpcUrl string,
+	startupCommitment rpc.CommitmentType,
 ) *SolanaWatcher {
+	if startupCommitment == "" {
+		startupCommitment = rpc.CommitmentFinalized
+	}
+
+	rpcClient := rpc.New(rpcUrl)
+	ccqRpcClient := rpcClient
+	if ccqRpcUrl == "" {
+		ccqRpcUrl = rpcUrl
+	} else {
+		ccqRpcClient = rpc.New(ccqRpcUrl)
+	}
+
 	return &SolanaWatcher{
 		rpcUrl:         rpcUrl,
 		wsUrl:          wsUrl,
@@ -223,13 +268,17 @@ func NewSolanaWatcher(
 		msgC:           msgC,
 		obsvReqC:       obsvReqC,
 		commitment:     commitment,
-		rpcClient:      rpc.New(rpcUrl),
+		rpcClient:      rpcClient,
 		readinessSync:  common.MustConvertChainIdToReadinessSyncing(chainID),
 		chainID:        chainID,
 		networkName:    chainID.String(),
 		queryReqC:      queryReqC,
 		queryResponseC: queryResponseC,
 		ccqConfig:      query.GetPerChainConfig(chainID),
+		ccqRpcUrl:      ccqRpcUrl,
+		ccqRpcClient:   ccqRpcClient,
+
+		startupCommitment: startupCommitment,
 	}
 }
 
@@ -368,7 +417,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 				// Get current slot height
 				rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 				start := time.Now()
-				slot, err := s.rpcClient.GetSlot(rCtx, s.commitment)
+				slot, err := s.rpcClient.GetSlot(rCtx, s.slotCommitment())
 				cancel()
 				queryLatency.WithLabelValues(s.networkName, "get_slot", string(s.commitment)).Observe(time.Since(start).Seconds())
 				if err != nil {
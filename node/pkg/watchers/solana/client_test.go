@@ -0,0 +1,58 @@
+package solana
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseStartupCommitment(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected rpc.CommitmentType
+		err      bool
+	}{
+		{input: "", expected: rpc.CommitmentFinalized},
+		{input: "finalized", expected: rpc.CommitmentFinalized},
+		{input: "confirmed", expected: rpc.CommitmentConfirmed},
+		{input: "processed", err: true},
+		{input: "Finalized", err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			commitment, err := ParseStartupCommitment(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, commitment)
+		})
+	}
+}
+
+func TestSlotCommitment(t *testing.T) {
+	// The confirmed watcher starts from the finalized slot.
+	w := &SolanaWatcher{commitment: rpc.CommitmentConfirmed, startupCommitment: rpc.CommitmentFinalized}
+	assert.Equal(t, rpc.CommitmentFinalized, w.slotCommitment())
+	w.lastSlot = 1000
+	assert.Equal(t, rpc.CommitmentConfirmed, w.slotCommitment())
+
+	// A confirmed startup commitment keeps the previous behavior.
+	w = &SolanaWatcher{commitment: rpc.CommitmentConfirmed, startupCommitment: rpc.CommitmentConfirmed}
+	assert.Equal(t, rpc.CommitmentConfirmed, w.slotCommitment())
+
+	// The finalized watcher never starts ahead of its own commitment.
+	w = &SolanaWatcher{commitment: rpc.CommitmentFinalized, startupCommitment: rpc.CommitmentConfirmed}
+	assert.Equal(t, rpc.CommitmentFinalized, w.slotCommitment())
+
+	// An unset startup commitment defaults to finalized, like the flag.
+	w = NewSolanaWatcher("http://localhost:8899", nil, solana.PublicKey{}, "", nil, nil, rpc.CommitmentConfirmed, vaa.ChainIDSolana, nil, nil, "", "")
+	assert.Equal(t, rpc.CommitmentFinalized, w.startupCommitment)
+	assert.Equal(t, rpc.CommitmentFinalized, w.slotCommitment())
+}
@@ -20,6 +20,10 @@ type WatcherConfig struct {
 	Websocket     string             // Websocket URL
 	Contract      string             // hex representation of the contract address
 	Commitment    solana_rpc.CommitmentType
+	CcqRpc        string // optional RPC URL used to serve cross chain queries, defaults to Rpc
+	// StartupCommitment is the commitment used to read the slot the watcher starts from, defaults to finalized like the
+	// --solanaStartupCommitment flag
+	StartupCommitment solana_rpc.CommitmentType
 }
 
 func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
@@ -55,7 +59,7 @@ func (wc *WatcherConfig) Create(
 		obsvReqC = nil
 	}
 
-	watcher := NewSolanaWatcher(wc.Rpc, &wc.Websocket, solAddress, wc.Contract, msgC, obsvReqC, wc.Commitment, wc.ChainID, queryReqC, queryResponseC)
+	watcher := NewSolanaWatcher(wc.Rpc, &wc.Websocket, solAddress, wc.Contract, msgC, obsvReqC, wc.Commitment, wc.ChainID, queryReqC, queryResponseC, wc.CcqRpc, wc.StartupCommitment)
 
 	return watcher, watcher.Run, nil
 }
@@ -0,0 +1,37 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// RPCHealthCheckTimeout is how long CheckRPCHealth waits for the RPC node to respond.
+const RPCHealthCheckTimeout = 10 * time.Second
+
+// CheckRPCHealth calls getHealth on the Solana RPC node at rpcUrl and returns an error if the node cannot be reached or does
+// not report itself as healthy. It is used at startup to catch a misconfigured RPC before the watchers start.
+func CheckRPCHealth(ctx context.Context, rpcUrl string) error {
+	ctx, cancel := context.WithTimeout(ctx, RPCHealthCheckTimeout)
+	defer cancel()
+
+	health, err := rpc.New(rpcUrl).GetHealth(ctx)
+	if err != nil {
+		return fmt.Errorf("getHealth failed: %w", err)
+	}
+	if health != rpc.HealthOk {
+		return fmt.Errorf("node reported unexpected health status %q", health)
+	}
+	return nil
+}
+
+// IsRPCNodeBehindError returns true if err is the "Node is behind" error (-32005) a Solana RPC node returns from getHealth while it
+// catches up. The node is reachable in that case, so callers should not treat it like a connection failure.
+func IsRPCNodeBehindError(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == -32005 // NODE_UNHEALTHY
+}
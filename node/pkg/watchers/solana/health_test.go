@@ -0,0 +1,56 @@
+package solana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHealthServer returns a JSON RPC server that answers getHealth with the given result or error object.
+func newHealthServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "getHealth", req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,%s}`, req.ID, body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCheckRPCHealth(t *testing.T) {
+	healthy := newHealthServer(t, `"result":"ok"`)
+	require.NoError(t, CheckRPCHealth(context.Background(), healthy.URL))
+
+	unhealthy := newHealthServer(t, `"error":{"code":-32005,"message":"Node is behind by 42 slots","data":{"numSlotsBehind":42}}`)
+	err := CheckRPCHealth(context.Background(), unhealthy.URL)
+	assert.ErrorContains(t, err, "Node is behind by 42 slots")
+	assert.True(t, IsRPCNodeBehindError(err))
+
+	unexpected := newHealthServer(t, `"result":"unknown"`)
+	err = CheckRPCHealth(context.Background(), unexpected.URL)
+	assert.ErrorContains(t, err, `unexpected health status "unknown"`)
+	assert.False(t, IsRPCNodeBehindError(err))
+
+	otherRPCError := newHealthServer(t, `"error":{"code":-32601,"message":"Method not found"}`)
+	err = CheckRPCHealth(context.Background(), otherRPCError.URL)
+	assert.ErrorContains(t, err, "Method not found")
+	assert.False(t, IsRPCNodeBehindError(err))
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+	err = CheckRPCHealth(context.Background(), unreachable.URL)
+	assert.Error(t, err)
+	assert.False(t, IsRPCNodeBehindError(err))
+}
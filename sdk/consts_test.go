@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestBuildEmitterMap(t *testing.T) {
+	emitters := buildEmitterMap(map[vaa.ChainID]string{
+		vaa.ChainIDSolana: "ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5",
+	})
+	assert.Len(t, emitters[vaa.ChainIDSolana], 32)
+}
+
+func TestBuildEmitterMapRejectsShortEmitter(t *testing.T) {
+	assert.PanicsWithValue(t,
+		"Invalid emitter address 7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5 for chain solana: expected 32 bytes, got 31",
+		func() {
+			buildEmitterMap(map[vaa.ChainID]string{
+				vaa.ChainIDSolana: "7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5",
+			})
+		})
+}
+
+func TestBuildEmitterMapRejectsInvalidHex(t *testing.T) {
+	assert.Panics(t, func() {
+		buildEmitterMap(map[vaa.ChainID]string{
+			vaa.ChainIDSolana: "not hex",
+		})
+	})
+}
+
+func TestEmittersForChain(t *testing.T) {
+	emitters := EmittersForChain(vaa.ChainIDSolana)
+	assert.Equal(t, []EmitterInfo{
+		{ChainID: vaa.ChainIDSolana, Emitter: knownTokenbridgeEmitters[vaa.ChainIDSolana], BridgeType: EmitterTokenBridge},
+		{ChainID: vaa.ChainIDSolana, Emitter: knownNFTBridgeEmitters[vaa.ChainIDSolana], BridgeType: EmitterNFTBridge},
+	}, emitters)
+
+	assert.Empty(t, EmittersForChain(vaa.ChainIDUnset))
+}
+
+// TestPublicRPCEndpointsNotDuplicated makes sure that no other Go source in the repository hard codes the public RPC endpoints,
+// since copies tend to go stale. It is skipped if the sdk is not checked out as part of the wormhole repository.
+func TestPublicRPCEndpointsNotDuplicated(t *testing.T) {
+	repoRoot := ".."
+	if _, err := os.Stat(filepath.Join(repoRoot, "node", "go.mod")); err != nil {
+		t.Skip("not running in the wormhole repository")
+	}
+
+	definition, err := filepath.Abs("mainnet_consts.go")
+	require.NoError(t, err)
+
+	err = filepath.WalkDir(repoRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case ".git", "node_modules", "vendor":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+		if abs, err := filepath.Abs(path); err != nil || abs == definition {
+			return err
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, endpoint := range PublicRPCEndpoints {
+			assert.NotContains(t, string(b), `"`+endpoint+`"`, "%s duplicates the public RPC endpoints, use sdk.PublicRPCEndpoints instead", path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
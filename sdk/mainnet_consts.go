@@ -3,6 +3,7 @@ package sdk
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -10,7 +11,8 @@ import (
 // PublicRPCEndpoints is a list of known public RPC endpoints for mainnet, operated by
 // Wormhole guardian nodes.
 //
-// This list is duplicated a couple times across the codebase - make to to update all copies!
+// This is the only copy of the list in Go code, everything else should import it. TestPublicRPCEndpointsNotDuplicated
+// guards against reintroducing copies. Scripts in other languages keep their own lists.
 var PublicRPCEndpoints = []string{
 	"https://wormhole-v2-mainnet-api.certus.one",
 	"https://wormhole.inotel.ro",
@@ -85,7 +87,10 @@ func buildEmitterMap(hexmap map[vaa.ChainID]string) map[vaa.ChainID][]byte {
 	for id, emitter := range hexmap {
 		e, err := hex.DecodeString(emitter)
 		if err != nil {
-			panic(fmt.Sprintf("Failed to decode emitter address %v: %v", emitter, err))
+			panic(fmt.Sprintf("Failed to decode emitter address %v for chain %v: %v", emitter, id, err))
+		}
+		if len(e) != len(vaa.Address{}) {
+			panic(fmt.Sprintf("Invalid emitter address %v for chain %v: expected %d bytes, got %d", emitter, id, len(vaa.Address{}), len(e)))
 		}
 		out[id] = e
 	}
@@ -161,6 +166,23 @@ func GetEmitterAddressForChain(chainID vaa.ChainID, emitterType EmitterType) (va
 	return vaa.Address{}, fmt.Errorf("lookup failed")
 }
 
+// EmittersForChain returns all of the known mainnet emitters of a chain, ordered by bridge type. It returns an empty
+// slice if there are none.
+func EmittersForChain(chainID vaa.ChainID) []EmitterInfo {
+	out := []EmitterInfo{}
+	for _, emitter := range KnownEmitters {
+		if emitter.ChainID == chainID {
+			out = append(out, emitter)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].BridgeType < out[j].BridgeType
+	})
+
+	return out
+}
+
 // KnownAutomaticRelayerEmitters is a list of well-known mainnet emitters for the Automatic Relayers.
 // It is based on this: https://github.com/wormhole-foundation/wormhole/blob/2c9703670eadc48a7dc8967e81ed2823affcc679/sdk/js/src/relayer/consts.ts#L95
 // Note that the format of this is different from the other maps because we don't want to limit it to one per chain.
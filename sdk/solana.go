@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// solanaEmitterSeed is the seed of the PDA that Wormhole integrations on Solana use as their emitter account.
+const solanaEmitterSeed = "emitter"
+
+// solanaPDAMarker is appended to the seeds when hashing a program derived address, as in the Solana runtime.
+const solanaPDAMarker = "ProgramDerivedAddress"
+
+// ErrNoValidSolanaPDA is returned if no bump seed yields an address off the ed25519 curve. This is practically impossible.
+var ErrNoValidSolanaPDA = errors.New("unable to find a valid program derived address")
+
+var (
+	// ed25519P is the field prime 2^255 - 19.
+	ed25519P, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+	// ed25519D is the curve constant -121665/121666 mod p.
+	ed25519D, _ = new(big.Int).SetString("52036cee2b6ffe738cc740797779e89800700a4d4141d8ab75eb4dca135978a3", 16)
+)
+
+// SolanaEmitterAddress returns the Wormhole emitter address of a Solana program, which is the program derived address
+// for the seed "emitter", as used by the token bridge, the NFT bridge and most other integrations.
+func SolanaEmitterAddress(programID [32]byte) (vaa.Address, error) {
+	return solanaFindProgramAddress([][]byte{[]byte(solanaEmitterSeed)}, programID)
+}
+
+// solanaFindProgramAddress implements Pubkey::find_program_address, trying bump seeds from 255 downwards until the
+// resulting address is not a valid ed25519 point.
+func solanaFindProgramAddress(seeds [][]byte, programID [32]byte) (vaa.Address, error) {
+	for bump := 255; bump >= 0; bump-- {
+		h := sha256.New()
+		for _, seed := range seeds {
+			h.Write(seed)
+		}
+		h.Write([]byte{byte(bump)})
+		h.Write(programID[:])
+		h.Write([]byte(solanaPDAMarker))
+
+		var addr vaa.Address
+		copy(addr[:], h.Sum(nil))
+		if !isOnEd25519Curve(addr) {
+			return addr, nil
+		}
+	}
+	return vaa.Address{}, ErrNoValidSolanaPDA
+}
+
+// isOnEd25519Curve returns true if b is the compressed form of a point on the ed25519 curve, which is the case if
+// x^2 = (y^2 - 1) / (d*y^2 + 1) has a solution for the encoded y.
+func isOnEd25519Curve(b [32]byte) bool {
+	// The encoding is little endian, with the top bit holding the sign of x.
+	le := make([]byte, 32)
+	for i := range b {
+		le[31-i] = b[i]
+	}
+	le[0] &= 0x7f
+	y := new(big.Int).SetBytes(le)
+	y.Mod(y, ed25519P)
+
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, ed25519P)
+
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, ed25519P)
+	v := new(big.Int).Mul(ed25519D, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, ed25519P)
+
+	x2 := new(big.Int).ModInverse(v, ed25519P)
+	x2.Mul(x2, u)
+	x2.Mod(x2, ed25519P)
+	if x2.Sign() == 0 {
+		return true
+	}
+
+	// Euler's criterion: x2 is a square iff x2^((p-1)/2) == 1.
+	exp := new(big.Int).Sub(ed25519P, big.NewInt(1))
+	exp.Rsh(exp, 1)
+	return new(big.Int).Exp(x2, exp, ed25519P).Cmp(big.NewInt(1)) == 0
+}
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestSolanaEmitterAddress(t *testing.T) {
+	tests := []struct {
+		label     string
+		programID string // Hex encoding of the base58 program ID.
+		emitter   []byte
+	}{
+		// wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb
+		{label: "TokenBridge", programID: "0e0a589e6488147a94dcfa592b90fdd41152bb2ca77bf6016758a6f4df9d21b4", emitter: KnownTokenbridgeEmitters[vaa.ChainIDSolana]},
+		// WnFt12ZrnzZrFZkt2xsNsaNWoQribnuQ5B5FrDbwDhD
+		{label: "NFTBridge", programID: "07a103e3e39777485a4050fd7adf6182dafc422d4072ae06b9c6d7f27312601c", emitter: KnownNFTBridgeEmitters[vaa.ChainIDSolana]},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.programID)
+			require.NoError(t, err)
+			var programID [32]byte
+			copy(programID[:], b)
+
+			emitter, err := SolanaEmitterAddress(programID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.emitter, emitter.Bytes())
+		})
+	}
+}
+
+func TestIsOnEd25519Curve(t *testing.T) {
+	// The ed25519 base point.
+	b, err := hex.DecodeString("5866666666666666666666666666666666666666666666666666666666666666")
+	require.NoError(t, err)
+	var basePoint [32]byte
+	copy(basePoint[:], b)
+	assert.True(t, isOnEd25519Curve(basePoint))
+
+	// The emitter is a program derived address, so it must not be on the curve.
+	var emitter [32]byte
+	copy(emitter[:], KnownTokenbridgeEmitters[vaa.ChainIDSolana])
+	assert.False(t, isOnEd25519Curve(emitter))
+}
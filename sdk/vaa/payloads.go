@@ -282,6 +282,38 @@ func (r BodyRecoverChainId) Serialize() []byte {
 	return buf.Bytes()
 }
 
+// bodyRecoverChainIdLength is the length of a serialized BodyRecoverChainId: module, action, EVM chain id and new chain id.
+const bodyRecoverChainIdLength = 32 + 1 + 32 + 2
+
+// ParseBodyRecoverChainId parses a BodyRecoverChainId as serialized by BodyRecoverChainId.Serialize. The module must be
+// either "Core" or "TokenBridge" and the action must be the recover chain id action of that module.
+func ParseBodyRecoverChainId(data []byte) (*BodyRecoverChainId, error) {
+	if len(data) != bodyRecoverChainIdLength {
+		return nil, fmt.Errorf("incorrect payload length, should be %d, is %d", bodyRecoverChainIdLength, len(data))
+	}
+
+	module := string(bytes.TrimLeft(data[0:32], "\x00"))
+	action := GovernanceAction(data[32])
+	switch module {
+	case "Core":
+		if action != ActionCoreRecoverChainId {
+			return nil, fmt.Errorf("invalid action %d for module %s, should be %d", action, module, ActionCoreRecoverChainId)
+		}
+	case "TokenBridge":
+		if action != ActionTokenBridgeRecoverChainId {
+			return nil, fmt.Errorf("invalid action %d for module %s, should be %d", action, module, ActionTokenBridgeRecoverChainId)
+		}
+	default:
+		return nil, fmt.Errorf("invalid module %q, should be Core or TokenBridge", module)
+	}
+
+	return &BodyRecoverChainId{
+		Module:     module,
+		EvmChainID: new(uint256.Int).SetBytes32(data[33:65]),
+		NewChainID: ChainID(binary.BigEndian.Uint16(data[65:67])),
+	}, nil
+}
+
 func (r BodyAccountantModifyBalance) Serialize() []byte {
 	payload := &bytes.Buffer{}
 	MustWrite(payload, binary.BigEndian, r.Sequence)
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var addr = Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}
@@ -108,6 +109,18 @@ func TestBodyTokenBridgeUpgradeContractSerialize(t *testing.T) {
 	assert.Equal(t, expected, hex.EncodeToString(serializedBodyTokenBridgeUpgradeContract))
 }
 
+func TestNFTBridgeRegisterChainSerialize(t *testing.T) {
+	bodyNFTBridgeRegisterChain := BodyTokenBridgeRegisterChain{Module: "NFTBridge", ChainID: 1, EmitterAddress: addr}
+	expected := "00000000000000000000000000000000000000000000004e465442726964676501000000010000000000000000000000000000000000000000000000000000000000000004"
+	assert.Equal(t, expected, hex.EncodeToString(bodyNFTBridgeRegisterChain.Serialize()))
+}
+
+func TestNFTBridgeUpgradeContractSerialize(t *testing.T) {
+	bodyNFTBridgeUpgradeContract := BodyTokenBridgeUpgradeContract{Module: "NFTBridge", TargetChainID: 1, NewContract: addr}
+	expected := "00000000000000000000000000000000000000000000004e46544272696467650200010000000000000000000000000000000000000000000000000000000000000004"
+	assert.Equal(t, expected, hex.EncodeToString(bodyNFTBridgeUpgradeContract.Serialize()))
+}
+
 func TestBodyWormchainStoreCodeSerialize(t *testing.T) {
 	expected := "0000000000000000000000000000000000000000005761736d644d6f64756c65010c200102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"
 	bodyWormchainStoreCode := BodyWormchainStoreCode{WasmHash: dummyBytes}
@@ -238,6 +251,65 @@ func TestBodyCoreRecoverChainIdSerialize(t *testing.T) {
 	assert.Equal(t, expected, hex.EncodeToString(BodyRecoverChainId.Serialize()))
 }
 
+func TestParseBodyRecoverChainId(t *testing.T) {
+	tests := []struct {
+		module  string
+		payload string
+	}{
+		{"Core", "00000000000000000000000000000000000000000000000000000000436f72650500000000000000000000000000000000000000000000000000000000000000010fa0"},
+		{"TokenBridge", "000000000000000000000000000000000000000000546f6b656e4272696467650300000000000000000000000000000000000000000000000000000000000000010fa0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.module, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.payload)
+			require.NoError(t, err)
+
+			body, err := ParseBodyRecoverChainId(data)
+			require.NoError(t, err)
+			assert.Equal(t, &BodyRecoverChainId{
+				Module:     tc.module,
+				EvmChainID: uint256.NewInt(1),
+				NewChainID: 4000,
+			}, body)
+			assert.Equal(t, data, body.Serialize())
+		})
+	}
+}
+
+func TestParseBodyRecoverChainIdRoundTrip(t *testing.T) {
+	maxEvmChainId := new(uint256.Int).Not(uint256.NewInt(0))
+	body := BodyRecoverChainId{
+		Module:     "Core",
+		EvmChainID: maxEvmChainId,
+		NewChainID: ChainIDEthereum,
+	}
+
+	parsed, err := ParseBodyRecoverChainId(body.Serialize())
+	require.NoError(t, err)
+	assert.Equal(t, &body, parsed)
+}
+
+func TestParseBodyRecoverChainIdInvalid(t *testing.T) {
+	valid := BodyRecoverChainId{Module: "Core", EvmChainID: uint256.NewInt(1), NewChainID: 4000}.Serialize()
+
+	_, err := ParseBodyRecoverChainId(valid[:len(valid)-1])
+	assert.ErrorContains(t, err, "incorrect payload length")
+
+	_, err = ParseBodyRecoverChainId(append(valid, 0))
+	assert.ErrorContains(t, err, "incorrect payload length")
+
+	// Core with the TokenBridge action.
+	wrongAction := bytes.Clone(valid)
+	wrongAction[32] = byte(ActionTokenBridgeRecoverChainId)
+	_, err = ParseBodyRecoverChainId(wrongAction)
+	assert.ErrorContains(t, err, "invalid action 3 for module Core")
+
+	// Serialize uses the TokenBridge action for any module other than Core, but only TokenBridge is valid.
+	_, err = ParseBodyRecoverChainId(BodyRecoverChainId{Module: "NFTBridge", EvmChainID: uint256.NewInt(1), NewChainID: 4000}.Serialize())
+	assert.ErrorContains(t, err, `invalid module "NFTBridge"`)
+}
+
 func TestBodyTokenBridgeRecoverChainIdSerialize(t *testing.T) {
 	expected := "000000000000000000000000000000000000000000546f6b656e4272696467650300000000000000000000000000000000000000000000000000000000000000010fa0"
 	BodyRecoverChainId := BodyRecoverChainId{
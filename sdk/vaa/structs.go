@@ -518,6 +518,13 @@ const (
 	SupportedVAAVersion = 0x01
 )
 
+// Errors returned by Unmarshal. These may be wrapped, so use errors.Is to check for them.
+var (
+	ErrVAATooShort        = errors.New("VAA is too short")
+	ErrUnsupportedVersion = errors.New("unsupported VAA version")
+	ErrBadSignatureCount  = errors.New("signature count does not match the VAA length")
+)
+
 // UnmarshalBody deserializes the binary representation of a VAA's "BODY" properties
 // The BODY fields are common among multiple types of VAA - v1, v2, etc
 func UnmarshalBody(data []byte, reader *bytes.Reader, v *VAA) (*VAA, error) {
@@ -568,13 +575,13 @@ func UnmarshalBody(data []byte, reader *bytes.Reader, v *VAA) (*VAA, error) {
 // Unmarshal deserializes the binary representation of a VAA
 func Unmarshal(data []byte) (*VAA, error) {
 	if len(data) < minVAALength {
-		return nil, fmt.Errorf("VAA is too short")
+		return nil, ErrVAATooShort
 	}
 	v := &VAA{}
 
 	v.Version = data[0]
 	if v.Version != SupportedVAAVersion {
-		return nil, fmt.Errorf("unsupported VAA version: %d", v.Version)
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, v.Version)
 	}
 
 	reader := bytes.NewReader(data[1:])
@@ -588,6 +595,11 @@ func Unmarshal(data []byte) (*VAA, error) {
 		return nil, fmt.Errorf("failed to read signature length")
 	}
 
+	// Each signature is a one byte guardian index followed by the 65 byte signature, and the body must still fit after them.
+	if reader.Len() < int(lenSignatures)*66+minHeadlessVAALength {
+		return nil, fmt.Errorf("%w: %d signatures need %d bytes, only %d remain", ErrBadSignatureCount, lenSignatures, int(lenSignatures)*66+minHeadlessVAALength, reader.Len())
+	}
+
 	v.Signatures = make([]*Signature, lenSignatures)
 	for i := 0; i < int(lenSignatures); i++ {
 		index, err := reader.ReadByte()
@@ -436,6 +436,35 @@ func TestUnmarshalBigPayload(t *testing.T) {
 	assert.Equal(t, vaa, *vaa2)
 }
 
+func TestUnmarshalErrors(t *testing.T) {
+	vaaBytes := []byte{0x1, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x20, 0x61, 0x61, 0x61, 0x61, 0x61, 0x61}
+
+	wrongVersion := bytes.Clone(vaaBytes)
+	wrongVersion[0] = 0x2
+
+	// Claim one signature, but there are no signature bytes.
+	badSigCount := bytes.Clone(vaaBytes)
+	badSigCount[5] = 0x1
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "empty", data: []byte{}, err: ErrVAATooShort},
+		{name: "truncated", data: vaaBytes[:minVAALength-1], err: ErrVAATooShort},
+		{name: "wrong_version", data: wrongVersion, err: ErrUnsupportedVersion},
+		{name: "bad_signature_count", data: badSigCount, err: ErrBadSignatureCount},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Unmarshal(tc.data)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
 func FuzzUnmarshalBigPayload(f *testing.F) {
 	f.Add([]byte{})
 	f.Add([]byte{0x1, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x20})